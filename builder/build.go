@@ -0,0 +1,236 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package builder
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/sciabarracom/openwhisk-wskdeploy/wski18n"
+)
+
+// keys of the build section of an action
+const (
+	BUILD_COMMAND = "command"
+	BUILD_OUTPUT  = "output"
+)
+
+// BUILD_CACHE_DIR keeps, in the user cache directory, the digest of the inputs of the last build of each output
+const BUILD_CACHE_DIR = "wskdeploy/builds"
+
+// CacheDir overrides the directory of the digests, by default in the user cache directory
+var CacheDir string
+
+/*
+   Build is run before the function of an action is read, e.g.
+
+   build:
+     command: GOOS=linux go build -o exec .
+     dir: src/hello
+     inputs: [ "*.go", go.mod ]
+     output: exec
+
+   The directory is relative to the manifest, the inputs and the output to the directory.
+   The output is the function of the action unless it sets one. Without inputs, the build
+   always runs, otherwise it is skipped when the inputs did not change since the last build.
+*/
+type Build struct {
+	Command string   `yaml:"command,omitempty"`
+	Dir     string   `yaml:"dir,omitempty"`
+	Inputs  []string `yaml:"inputs,omitempty"`
+	Output  string   `yaml:"output,omitempty"`
+}
+
+// Merge returns the build with the keys it does not set taken from the defaults, either can be nil
+func (build *Build) Merge(defaults *Build) *Build {
+	if build == nil {
+		return defaults
+	}
+	if defaults == nil {
+		return build
+	}
+	merged := *build
+	if len(merged.Command) == 0 {
+		merged.Command = defaults.Command
+	}
+	if len(merged.Dir) == 0 {
+		merged.Dir = defaults.Dir
+	}
+	if merged.Inputs == nil {
+		merged.Inputs = defaults.Inputs
+	}
+	if len(merged.Output) == 0 {
+		merged.Output = defaults.Output
+	}
+	return &merged
+}
+
+// Job is the build of an action with its paths resolved
+type Job struct {
+	Action  string
+	Command string
+	Dir     string
+	Inputs  []string
+	Output  string
+
+	// when set, the command is run without a shell, with the additional environment
+	args []string
+	env  []string
+}
+
+// Job resolves the paths of the build of an action from the directory of the manifest
+func (build *Build) Job(action string, baseDir string) (*Job, error) {
+	for _, value := range [][]string{{BUILD_COMMAND, build.Command}, {BUILD_OUTPUT, build.Output}} {
+		if len(value[1]) == 0 {
+			return nil, errors.New(wski18n.T(wski18n.ID_ERR_BUILD_KEY_MISSING_X_key_X_action_X,
+				map[string]interface{}{
+					wski18n.KEY_KEY:    value[0],
+					wski18n.KEY_ACTION: action}))
+		}
+	}
+	dir := resolvePath(baseDir, build.Dir)
+	return &Job{
+		Action:  action,
+		Command: build.Command,
+		Dir:     dir,
+		Inputs:  build.Inputs,
+		Output:  resolvePath(dir, build.Output),
+	}, nil
+}
+
+// Key identifies the jobs building the same output with the same command, which run once
+func (job *Job) Key() string {
+	return job.Dir + "\x00" + job.Command + "\x00" + job.Output
+}
+
+// InputFiles returns the files matching the inputs, directories are walked, the output is not an input
+func (job *Job) InputFiles() ([]string, error) {
+	files := make(map[string]bool)
+	for _, pattern := range job.Inputs {
+		matches, err := filepath.Glob(resolvePath(job.Dir, pattern))
+		if err != nil {
+			return nil, err
+		}
+		for _, match := range matches {
+			err := filepath.Walk(match, func(path string, info os.FileInfo, err error) error {
+				if err != nil {
+					return err
+				}
+				if !info.IsDir() && path != job.Output {
+					files[path] = true
+				}
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	list := make([]string, 0, len(files))
+	for file := range files {
+		list = append(list, file)
+	}
+	sort.Strings(list)
+	return list, nil
+}
+
+// Digest hashes the command, the output and the names and content of the input files,
+// it is empty when the build declares no inputs
+func (job *Job) Digest() (string, error) {
+	if len(job.Inputs) == 0 {
+		return "", nil
+	}
+	files, err := job.InputFiles()
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.New()
+	io.WriteString(hash, job.Key()+"\x00")
+	for _, file := range files {
+		rel, _ := filepath.Rel(job.Dir, file)
+		io.WriteString(hash, filepath.ToSlash(rel)+"\x00")
+		f, err := os.Open(file)
+		if err != nil {
+			return "", err
+		}
+		_, err = io.Copy(hash, f)
+		f.Close()
+		if err != nil {
+			return "", err
+		}
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// UpToDate is true when the output exists and was built from inputs with the same digest
+func (job *Job) UpToDate(digest string) bool {
+	if len(digest) == 0 {
+		return false
+	}
+	if _, err := os.Stat(job.Output); err != nil {
+		return false
+	}
+	path, err := job.stampPath()
+	if err != nil {
+		return false
+	}
+	stamp, err := ioutil.ReadFile(path)
+	return err == nil && string(stamp) == digest
+}
+
+// saveDigest records the digest of the inputs of a successful build
+func (job *Job) saveDigest(digest string) error {
+	if len(digest) == 0 {
+		return nil
+	}
+	path, err := job.stampPath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, []byte(digest), 0644)
+}
+
+// the digest of an output is kept in a file named after the hash of its path
+func (job *Job) stampPath() (string, error) {
+	dir := CacheDir
+	if len(dir) == 0 {
+		cacheDir, err := os.UserCacheDir()
+		if err != nil {
+			return "", err
+		}
+		dir = filepath.Join(cacheDir, filepath.FromSlash(BUILD_CACHE_DIR))
+	}
+	name := sha256.Sum256([]byte(job.Output))
+	return filepath.Join(dir, hex.EncodeToString(name[:])), nil
+}
+
+func resolvePath(baseDir string, path string) string {
+	if filepath.IsAbs(path) {
+		return filepath.Clean(path)
+	}
+	return filepath.Join(baseDir, path)
+}
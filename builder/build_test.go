@@ -0,0 +1,120 @@
+// +build unit
+
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package builder
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// listen records the phases of the events emitted while running f
+func listen(f func()) []string {
+	var phases []string
+	var mutex sync.Mutex
+	Listener = func(event Event) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		phases = append(phases, event.Action+":"+event.Phase)
+	}
+	defer func() { Listener = PrintEvent }()
+	f()
+	return phases
+}
+
+func TestBuild_Merge(t *testing.T) {
+	defaults := &Build{Command: "make", Dir: "src", Inputs: []string{"*.go"}}
+	build := &Build{Output: "exec", Inputs: []string{"main.go"}}
+	assert.Equal(t, &Build{Command: "make", Dir: "src", Inputs: []string{"main.go"}, Output: "exec"}, build.Merge(defaults))
+	assert.Equal(t, defaults, (*Build)(nil).Merge(defaults))
+	assert.Equal(t, build, build.Merge(nil))
+}
+
+func TestBuild_Job(t *testing.T) {
+	job, err := (&Build{Command: "make", Dir: "src", Output: "exec"}).Job("pkg/hello", "manifest")
+	assert.Nil(t, err)
+	assert.Equal(t, filepath.Join("manifest", "src"), job.Dir)
+	assert.Equal(t, filepath.Join("manifest", "src", "exec"), job.Output)
+
+	_, err = (&Build{Output: "exec"}).Job("pkg/hello", "manifest")
+	assert.NotNil(t, err, "a build without command must be an error")
+	assert.Contains(t, err.Error(), BUILD_COMMAND)
+	_, err = (&Build{Command: "make"}).Job("pkg/hello", "manifest")
+	assert.NotNil(t, err, "a build without output must be an error")
+	assert.Contains(t, err.Error(), BUILD_OUTPUT)
+}
+
+func TestRun_SkipsUnchangedInputs(t *testing.T) {
+	dir := t.TempDir()
+	CacheDir = filepath.Join(dir, "cache")
+	defer func() { CacheDir = "" }()
+	input := filepath.Join(dir, "main.txt")
+	assert.Nil(t, ioutil.WriteFile(input, []byte("one"), 0644))
+
+	build := &Build{Command: "cat main.txt > $WSKDEPLOY_OUTPUT", Inputs: []string{"*.txt"}, Output: "out.txt"}
+	job, err := build.Job("hello", dir)
+	assert.Nil(t, err)
+
+	phases := listen(func() { assert.Nil(t, Run([]*Job{job})) })
+	assert.Equal(t, []string{"hello:" + EVENT_BUILD_STARTED, "hello:" + EVENT_BUILD_SUCCEEDED}, phases)
+	phases = listen(func() { assert.Nil(t, Run([]*Job{job})) })
+	assert.Equal(t, []string{"hello:" + EVENT_BUILD_SKIPPED}, phases, "the output is not an input")
+
+	assert.Nil(t, ioutil.WriteFile(input, []byte("two"), 0644))
+	phases = listen(func() { assert.Nil(t, Run([]*Job{job})) })
+	assert.Equal(t, []string{"hello:" + EVENT_BUILD_STARTED, "hello:" + EVENT_BUILD_SUCCEEDED}, phases)
+	output, _ := ioutil.ReadFile(filepath.Join(dir, "out.txt"))
+	assert.Equal(t, "two", string(output))
+}
+
+func TestRun_SharedBuildRunsOnce(t *testing.T) {
+	dir := t.TempDir()
+	build := &Build{Command: "echo built >> $WSKDEPLOY_OUTPUT", Output: "out.txt"}
+	first, _ := build.Job("first", dir)
+	second, _ := build.Job("second", dir)
+
+	phases := listen(func() { assert.Nil(t, Run([]*Job{second, first})) })
+	assert.Equal(t, []string{"first:" + EVENT_BUILD_STARTED, "first:" + EVENT_BUILD_SUCCEEDED}, phases)
+	output, _ := ioutil.ReadFile(filepath.Join(dir, "out.txt"))
+	assert.Equal(t, "built\n", string(output))
+}
+
+func TestRun_Failure(t *testing.T) {
+	dir := t.TempDir()
+	failing, _ := (&Build{Command: "echo broken; exit 1", Output: "out.txt"}).Job("failing", dir)
+	missing, _ := (&Build{Command: "true", Output: "missing.txt"}).Job("missing", dir)
+
+	var err error
+	phases := listen(func() { err = Run([]*Job{missing, failing}) })
+	assert.NotNil(t, err)
+	assert.True(t, strings.Contains(err.Error(), "broken"), "the error must include the log of the build")
+	assert.Contains(t, phases, "failing:"+EVENT_BUILD_FAILED)
+	assert.Contains(t, phases, "missing:"+EVENT_BUILD_FAILED, "all the builds complete")
+
+	err = Run([]*Job{missing})
+	assert.NotNil(t, err)
+	_, statErr := os.Stat(missing.Output)
+	assert.True(t, os.IsNotExist(statErr))
+}
@@ -0,0 +1,165 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package builder
+
+import (
+	"archive/zip"
+	"bytes"
+	"debug/elf"
+	"encoding/binary"
+	"go/parser"
+	"go/token"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/sciabarracom/openwhisk-wskdeploy/utils"
+)
+
+// NATIVE_EXEC is the executable run by the docker skeleton, at the root of the archive of a native action
+const NATIVE_EXEC = "exec"
+
+// the platform Go native actions are compiled for, GOARCH can be overridden in the environment
+const (
+	NATIVE_GOOS   = "linux"
+	NATIVE_GOARCH = "amd64"
+)
+
+// NATIVE_HEADER_SIZE is the length of the header of an executable read to tell its format
+const NATIVE_HEADER_SIZE = 64
+
+// IsGoMain is true for a Go source file, or a directory of Go source files, of package main
+func IsGoMain(path string) bool {
+	files := []string{path}
+	if utils.IsDirectory(path) {
+		files, _ = filepath.Glob(filepath.Join(path, "*.go"))
+	} else if filepath.Ext(path) != ".go" {
+		return false
+	}
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		source, err := parser.ParseFile(token.NewFileSet(), file, nil, parser.PackageClauseOnly)
+		if err == nil && source.Name.Name == "main" {
+			return true
+		}
+	}
+	return false
+}
+
+// CompileGo compiles the Go main package of a file or directory into a static Linux executable,
+// the compilation is reported as a build of the action
+func CompileGo(action string, src string, output string) error {
+	dir, target := src, "."
+	if !utils.IsDirectory(src) {
+		dir, target = filepath.Dir(src), filepath.Base(src)
+	}
+	goarch := os.Getenv("GOARCH")
+	if len(goarch) == 0 {
+		goarch = NATIVE_GOARCH
+	}
+	args := []string{"go", "build", "-o", output, target}
+	job := &Job{
+		Action:  action,
+		Command: strings.Join(args, " "),
+		Dir:     dir,
+		Output:  output,
+		args:    args,
+		env:     []string{"GOOS=" + NATIVE_GOOS, "GOARCH=" + goarch, "CGO_ENABLED=0"},
+	}
+	return job.run()
+}
+
+// ZipExecutable writes an archive with the executable as NATIVE_EXEC at its root, with executable permissions
+func ZipExecutable(executable string, archive string) error {
+	src, err := os.Open(executable)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	dest, err := os.Create(archive)
+	if err != nil {
+		return err
+	}
+	defer dest.Close()
+
+	writer := zip.NewWriter(dest)
+	entry, err := writer.CreateHeader(utils.ZipFileHeader(NATIVE_EXEC, utils.ZIP_EXEC_MODE))
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(entry, src); err != nil {
+		return err
+	}
+	return writer.Close()
+}
+
+// ReadHeader returns the first NATIVE_HEADER_SIZE bytes of an executable, or all of them if it is shorter
+func ReadHeader(r io.Reader) ([]byte, error) {
+	header := make([]byte, NATIVE_HEADER_SIZE)
+	n, err := io.ReadFull(r, header)
+	if err == io.ErrUnexpectedEOF || err == io.EOF {
+		err = nil
+	}
+	return header[:n], err
+}
+
+// ArchiveExecHeader returns the header of the NATIVE_EXEC at the root of the archive, if any
+func ArchiveExecHeader(archive string) ([]byte, bool, error) {
+	reader, err := zip.OpenReader(archive)
+	if err != nil {
+		return nil, false, err
+	}
+	defer reader.Close()
+	for _, file := range reader.File {
+		if strings.TrimPrefix(file.Name, "./") != NATIVE_EXEC {
+			continue
+		}
+		entry, err := file.Open()
+		if err != nil {
+			return nil, true, err
+		}
+		defer entry.Close()
+		header, err := ReadHeader(entry)
+		return header, true, err
+	}
+	return nil, false, nil
+}
+
+// IsLinuxExecutable tells from its header whether an executable runs on Linux, i.e. it is
+// an ELF executable or shared object for Linux or System V, or a script run by its interpreter
+func IsLinuxExecutable(header []byte) bool {
+	if bytes.HasPrefix(header, []byte("#!")) {
+		return true
+	}
+	if len(header) < 18 || !bytes.HasPrefix(header, []byte(elf.ELFMAG)) {
+		return false
+	}
+	abi := elf.OSABI(header[elf.EI_OSABI])
+	if abi != elf.ELFOSABI_NONE && abi != elf.ELFOSABI_LINUX {
+		return false
+	}
+	var order binary.ByteOrder = binary.LittleEndian
+	if elf.Data(header[elf.EI_DATA]) == elf.ELFDATA2MSB {
+		order = binary.BigEndian
+	}
+	kind := elf.Type(order.Uint16(header[16:18]))
+	return kind == elf.ET_EXEC || kind == elf.ET_DYN
+}
@@ -0,0 +1,102 @@
+// +build unit
+
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package builder
+
+import (
+	"archive/zip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const goMain = `package main
+
+func main() {}
+`
+
+// elfHeader returns the header of a little endian ELF file of the given ABI and type
+func elfHeader(abi byte, kind byte) []byte {
+	header := make([]byte, NATIVE_HEADER_SIZE)
+	copy(header, "\x7fELF\x02\x01\x01")
+	header[7] = abi
+	header[16] = kind
+	return header
+}
+
+func TestIsLinuxExecutable(t *testing.T) {
+	assert.True(t, IsLinuxExecutable(elfHeader(0, 2)), "System V executable")
+	assert.True(t, IsLinuxExecutable(elfHeader(3, 3)), "Linux shared object")
+	assert.True(t, IsLinuxExecutable([]byte("#!/bin/bash\necho {}")), "script")
+	assert.False(t, IsLinuxExecutable(elfHeader(9, 2)), "FreeBSD executable")
+	assert.False(t, IsLinuxExecutable(elfHeader(0, 1)), "relocatable object")
+	assert.False(t, IsLinuxExecutable([]byte("\xcf\xfa\xed\xfe\x07\x00\x00\x01")), "Mach-O executable")
+	assert.False(t, IsLinuxExecutable([]byte("MZ\x90\x00")), "Windows executable")
+	assert.False(t, IsLinuxExecutable(nil))
+}
+
+func TestIsGoMain(t *testing.T) {
+	dir := t.TempDir()
+	assert.False(t, IsGoMain(dir))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "lib.go"), []byte("package lib\n"), 0644))
+	assert.False(t, IsGoMain(dir))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "main.go"), []byte(goMain), 0644))
+	assert.True(t, IsGoMain(dir))
+	assert.True(t, IsGoMain(filepath.Join(dir, "main.go")))
+	assert.False(t, IsGoMain(filepath.Join(dir, "lib.go")))
+}
+
+func TestZipExecutable(t *testing.T) {
+	dir := t.TempDir()
+	script := filepath.Join(dir, "hello.sh")
+	assert.Nil(t, ioutil.WriteFile(script, []byte("#!/bin/sh\necho {}\n"), 0644))
+	archive := filepath.Join(dir, "exec.zip")
+	assert.Nil(t, ZipExecutable(script, archive))
+
+	reader, err := zip.OpenReader(archive)
+	assert.Nil(t, err)
+	defer reader.Close()
+	assert.Equal(t, 1, len(reader.File))
+	assert.Equal(t, NATIVE_EXEC, reader.File[0].Name)
+	assert.Equal(t, os.FileMode(0755), reader.File[0].Mode().Perm())
+
+	header, found, err := ArchiveExecHeader(archive)
+	assert.Nil(t, err)
+	assert.True(t, found)
+	assert.True(t, IsLinuxExecutable(header))
+}
+
+func TestCompileGo(t *testing.T) {
+	dir := t.TempDir()
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "main.go"), []byte(goMain), 0644))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "go.mod"), []byte("module hello\n"), 0644))
+	output := filepath.Join(dir, NATIVE_EXEC)
+
+	phases := listen(func() { assert.Nil(t, CompileGo("hello", dir, output)) })
+	assert.Equal(t, []string{"hello:" + EVENT_BUILD_STARTED, "hello:" + EVENT_BUILD_SUCCEEDED}, phases)
+	file, err := os.Open(output)
+	assert.Nil(t, err)
+	defer file.Close()
+	header, err := ReadHeader(file)
+	assert.Nil(t, err)
+	assert.True(t, IsLinuxExecutable(header), "the executable is compiled for Linux")
+}
@@ -0,0 +1,206 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package builder
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"os/exec"
+	"runtime"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/sciabarracom/openwhisk-wskdeploy/utils"
+	"github.com/sciabarracom/openwhisk-wskdeploy/wskderrors"
+	"github.com/sciabarracom/openwhisk-wskdeploy/wski18n"
+	"github.com/sciabarracom/openwhisk-wskdeploy/wskprint"
+)
+
+// variables set in the environment of the build command
+const (
+	ENV_BUILD_ACTION = "WSKDEPLOY_ACTION"
+	ENV_BUILD_OUTPUT = "WSKDEPLOY_OUTPUT"
+)
+
+// phases of a build reported to the Listener
+const (
+	EVENT_BUILD_STARTED   = "started"
+	EVENT_BUILD_SKIPPED   = "skipped"
+	EVENT_BUILD_SUCCEEDED = "succeeded"
+	EVENT_BUILD_FAILED    = "failed"
+)
+
+// Parallelism is the number of builds running at the same time
+var Parallelism = runtime.NumCPU()
+
+// Event reports a phase of the build of an action
+type Event struct {
+	Action   string `json:"action"`
+	Phase    string `json:"phase"`
+	Command  string `json:"command"`
+	Output   string `json:"output"`
+	Digest   string `json:"digest,omitempty"`
+	Log      string `json:"log,omitempty"`
+	Duration int64  `json:"durationMs,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+// Listener receives the events of the builds one at a time, by default they are
+// printed in verbose output and traced in JSON
+var Listener = PrintEvent
+
+var listenerLock sync.Mutex
+
+func emit(event Event) {
+	listenerLock.Lock()
+	defer listenerLock.Unlock()
+	Listener(event)
+}
+
+// PrintEvent prints the event in verbose output, with the log of the build, and traces it in JSON
+func PrintEvent(event Event) {
+	if content, err := json.Marshal(event); err == nil {
+		whisk.Debug(whisk.DbgInfo, "build event: %s\n", content)
+	}
+	var id string
+	switch event.Phase {
+	case EVENT_BUILD_STARTED:
+		id = wski18n.ID_MSG_BUILD_STARTED_X_action_X_cmd_X
+	case EVENT_BUILD_SKIPPED:
+		id = wski18n.ID_MSG_BUILD_SKIPPED_X_action_X_path_X
+	case EVENT_BUILD_SUCCEEDED:
+		id = wski18n.ID_MSG_BUILD_SUCCEEDED_X_action_X_path_X
+	default:
+		return
+	}
+	wskprint.PrintOpenWhiskVerbose(utils.Flags.Verbose, wski18n.T(id,
+		map[string]interface{}{
+			wski18n.KEY_ACTION: event.Action,
+			wski18n.KEY_CMD:    event.Command,
+			wski18n.KEY_PATH:   event.Output}))
+	if len(event.Log) != 0 {
+		wskprint.PrintlnOpenWhiskVerbose(utils.Flags.Verbose, event.Log)
+	}
+}
+
+// Run runs the jobs, at most Parallelism at a time, skipping the ones whose inputs did not change.
+// Jobs with the same key run once, as the first of them in order of action. All the jobs complete
+// before the error of the first failed one, in order of action, is returned.
+func Run(jobs []*Job) error {
+	sorted := append([]*Job(nil), jobs...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Action < sorted[j].Action })
+	unique := make([]*Job, 0, len(sorted))
+	keys := make(map[string]bool)
+	for _, job := range sorted {
+		if !keys[job.Key()] {
+			keys[job.Key()] = true
+			unique = append(unique, job)
+		}
+	}
+
+	parallelism := Parallelism
+	if parallelism < 1 {
+		parallelism = 1
+	}
+	errs := make([]error, len(unique))
+	slots := make(chan bool, parallelism)
+	var wg sync.WaitGroup
+	for i, job := range unique {
+		wg.Add(1)
+		go func(i int, job *Job) {
+			defer wg.Done()
+			slots <- true
+			defer func() { <-slots }()
+			errs[i] = job.run()
+		}(i, job)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (job *Job) run() error {
+	event := Event{Action: job.Action, Command: job.Command, Output: job.Output}
+	digest, err := job.Digest()
+	if err != nil {
+		return job.failed(event, err)
+	}
+	event.Digest = digest
+	if job.UpToDate(digest) {
+		event.Phase = EVENT_BUILD_SKIPPED
+		emit(event)
+		return nil
+	}
+
+	event.Phase = EVENT_BUILD_STARTED
+	emit(event)
+	start := time.Now()
+	cmd := shellCommand(job.Command)
+	if len(job.args) != 0 {
+		cmd = exec.Command(job.args[0], job.args[1:]...)
+	}
+	cmd.Dir = job.Dir
+	cmd.Env = append(os.Environ(), ENV_BUILD_ACTION+"="+job.Action, ENV_BUILD_OUTPUT+"="+job.Output)
+	cmd.Env = append(cmd.Env, job.env...)
+	log, err := cmd.CombinedOutput()
+	event.Log = string(log)
+	event.Duration = time.Since(start).Milliseconds()
+	if err != nil {
+		return job.failed(event, err)
+	}
+	if _, err := os.Stat(job.Output); err != nil {
+		return job.failed(event, errors.New(wski18n.T(wski18n.ID_ERR_BUILD_OUTPUT_MISSING_X_path_X,
+			map[string]interface{}{wski18n.KEY_PATH: job.Output})))
+	}
+	if err := job.saveDigest(digest); err != nil {
+		wskprint.PrintOpenWhiskWarning(wski18n.T(wski18n.ID_WARN_BUILD_DIGEST_X_path_X_err_X,
+			map[string]interface{}{
+				wski18n.KEY_PATH: job.Output,
+				wski18n.KEY_ERR:  err.Error()}))
+	}
+	event.Phase = EVENT_BUILD_SUCCEEDED
+	emit(event)
+	return nil
+}
+
+// failed reports the failure of the build, the error includes its log
+func (job *Job) failed(event Event, err error) error {
+	event.Phase = EVENT_BUILD_FAILED
+	event.Error = err.Error()
+	emit(event)
+	return wskderrors.NewCommandError(job.Command,
+		wski18n.T(wski18n.ID_ERR_BUILD_FAILED_X_action_X_err_X_log_X,
+			map[string]interface{}{
+				wski18n.KEY_ACTION: job.Action,
+				wski18n.KEY_ERR:    err.Error(),
+				wski18n.KEY_LOG:    event.Log}))
+}
+
+func shellCommand(command string) *exec.Cmd {
+	if runtime.GOOS == "windows" {
+		return exec.Command("cmd", "/C", command)
+	}
+	return exec.Command("sh", "-c", command)
+}
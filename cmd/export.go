@@ -20,6 +20,7 @@ package cmd
 import (
 	"encoding/base64"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -230,9 +231,9 @@ func exportProject(projectName string, targetManifest string) error {
 
 							// export feed input parameters
 							params := make(map[string]interface{})
-							params["authKey"] = client.Config.AuthToken
-							params["lifecycleEvent"] = "READ"
-							params["triggerName"] = "/" + client.Namespace + "/" + trg.Name
+							params[parsers.FEED_PARAM_AUTH_KEY] = client.Config.AuthToken
+							params[parsers.FEED_PARAM_LIFECYCLE_EVENT] = parsers.FEED_LIFECYCLE_READ
+							params[parsers.FEED_PARAM_TRIGGER_NAME] = "/" + client.Namespace + "/" + trg.Name
 							res, _, err := client.Actions.Invoke(feedname, params, true, true)
 							if err != nil {
 								return err
@@ -388,6 +389,9 @@ func exportProject(projectName string, targetManifest string) error {
 	manifestDir := filepath.Dir(utils.Flags.ManifestPath)
 	os.MkdirAll(manifestDir, os.ModePerm)
 
+	// factor the values shared by the actions of a package into its defaults
+	maniyaml.FactorDefaults(utils.Flags.DefaultsMinActions)
+
 	// export manifest to file
 	parsers.Write(maniyaml, targetManifest)
 	fmt.Println("Manifest exported to: " + targetManifest)
@@ -437,7 +441,7 @@ func ExportCmdImp(cmd *cobra.Command, args []string) error {
 	client, _ = deployers.CreateNewClient(config)
 
 	// Init supported runtimes and action files extensions maps
-	setSupportedRuntimes(config.Host)
+	setSupportedRuntimes(config)
 
 	return exportProject(utils.Flags.ProjectName, utils.Flags.ManifestPath)
 }
@@ -474,9 +478,10 @@ func saveCode(action whisk.Action, directory string) (string, error) {
 	}
 
 	var filename = ""
+	// binary code is decoded while it is written, without a decoded copy in memory
+	var reader io.Reader = strings.NewReader(code)
 	if *exec.Binary {
-		decoded, _ := base64.StdEncoding.DecodeString(code)
-		code = string(decoded)
+		reader = base64.NewDecoder(base64.StdEncoding, reader)
 
 		filename = action.Name + getBinaryKindExtension(runtime)
 	} else {
@@ -487,7 +492,13 @@ func saveCode(action whisk.Action, directory string) (string, error) {
 
 	path := filepath.Join(directory, filename)
 
-	if err := utils.WriteFile(path, code); err != nil {
+	file, err := os.Create(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	if _, err := io.Copy(file, reader); err != nil {
 		return "", err
 	}
 
@@ -496,4 +507,5 @@ func saveCode(action whisk.Action, directory string) (string, error) {
 
 func init() {
 	RootCmd.AddCommand(exportCmd)
+	exportCmd.Flags().IntVar(&utils.Flags.DefaultsMinActions, FLAG_DEFAULTS_MIN, parsers.DEFAULTS_MIN_ACTIONS, wski18n.T(wski18n.ID_CMD_FLAG_DEFAULTS_MIN_ACTIONS))
 }
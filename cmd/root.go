@@ -26,9 +26,11 @@ import (
 	"github.com/apache/openwhisk-client-go/whisk"
 	"github.com/sciabarracom/openwhisk-wskdeploy/dependencies"
 	"github.com/sciabarracom/openwhisk-wskdeploy/deployers"
+	"github.com/sciabarracom/openwhisk-wskdeploy/parsers"
 	"github.com/sciabarracom/openwhisk-wskdeploy/runtimes"
 	"github.com/sciabarracom/openwhisk-wskdeploy/utils"
 	"github.com/sciabarracom/openwhisk-wskdeploy/wskderrors"
+	"github.com/sciabarracom/openwhisk-wskdeploy/wskenv"
 	"github.com/sciabarracom/openwhisk-wskdeploy/wski18n"
 	"github.com/sciabarracom/openwhisk-wskdeploy/wskprint"
 	"github.com/spf13/cobra"
@@ -62,7 +64,10 @@ func Execute() {
 		os.Exit(-1)
 	}
 
-	if err = RootCmd.Execute(); err != nil {
+	err = RootCmd.Execute()
+	// the temporary files of the artifacts are removed whatever the command
+	utils.RemoveTempArtifacts()
+	if err != nil {
 		wskprint.PrintOpenWhiskFromError(err)
 		os.Exit(-1)
 	}
@@ -94,7 +99,16 @@ func init() {
 	RootCmd.PersistentFlags().StringVarP(&utils.Flags.ProjectName, FLAG_PROJECTNAME, "", "", wski18n.T(wski18n.ID_CMD_FLAG_PROJECTNAME))
 	RootCmd.PersistentFlags().BoolVarP(&utils.Flags.Trace, FLAG_TRACE, FLAG_TRACE_SHORT, false, wski18n.T(wski18n.ID_CMD_FLAG_TRACE))
 	RootCmd.PersistentFlags().StringSliceVarP(&utils.Flags.Param, FLAG_PARAM, "", []string{}, wski18n.T(wski18n.ID_CMD_FLAG_PARAM))
-	RootCmd.PersistentFlags().StringVarP(&utils.Flags.ParamFile, FLAG_PARAMFILE, FLAG_PARAMFILE_SHORT, "", wski18n.T(wski18n.ID_CMD_FLAG_PARAM_FILE))
+	RootCmd.PersistentFlags().StringArrayVarP(&utils.Flags.ParamFile, FLAG_PARAMFILE, FLAG_PARAMFILE_SHORT, []string{}, wski18n.T(wski18n.ID_CMD_FLAG_PARAM_FILE))
+	RootCmd.PersistentFlags().BoolVar(&utils.Flags.ExplainParams, FLAG_EXPLAIN_PARAMS, false, wski18n.T(wski18n.ID_CMD_FLAG_EXPLAIN_PARAMS))
+	RootCmd.PersistentFlags().BoolVar(&utils.Flags.ListZipContents, FLAG_LIST_ZIP, false, wski18n.T(wski18n.ID_CMD_FLAG_LIST_ZIP_CONTENTS))
+	RootCmd.PersistentFlags().IntVar(&utils.Flags.CodeSizeLimit, FLAG_CODE_SIZE_LIMIT, 0, wski18n.T(wski18n.ID_CMD_FLAG_CODE_SIZE_LIMIT))
+	RootCmd.PersistentFlags().IntVar(&utils.Flags.MemoryBudget, FLAG_MEMORY_BUDGET, utils.DEFAULT_ARTIFACT_MEMORY_BUDGET/(1024*1024), wski18n.T(wski18n.ID_CMD_FLAG_MEMORY_BUDGET))
+	RootCmd.PersistentFlags().StringVar(&utils.Flags.AlarmsPackage, FLAG_ALARMS_PACKAGE, parsers.SCHEDULE_ALARMS_PACKAGE, wski18n.T(wski18n.ID_CMD_FLAG_ALARMS_PACKAGE))
+	RootCmd.PersistentFlags().BoolVar(&utils.Flags.Offline, FLAG_OFFLINE, false, wski18n.T(wski18n.ID_CMD_FLAG_OFFLINE))
+	RootCmd.PersistentFlags().StringVar(&utils.Flags.CACert, FLAG_CACERT, "", wski18n.T(wski18n.ID_CMD_FLAG_CACERT))
+	RootCmd.PersistentFlags().BoolVar(&utils.Flags.Insecure, FLAG_INSECURE, false, wski18n.T(wski18n.ID_CMD_FLAG_INSECURE))
+	RootCmd.PersistentFlags().DurationVar(&utils.Flags.RuntimesTTL, FLAG_RUNTIMES_TTL, runtimes.DEFAULT_CATALOG_TTL, wski18n.T(wski18n.ID_CMD_FLAG_RUNTIMES_TTL))
 	RootCmd.PersistentFlags().MarkHidden(FLAG_TRACE)
 }
 
@@ -130,16 +144,46 @@ func initConfig() {
 	}
 }
 
+// setRuntimesTLS verifies the apihost serving the catalog of runtimes with the configured certificates,
+// the catalog is fetched without verification as the other requests unless a CA certificate is given
+func setRuntimesTLS(clientConfig *whisk.Config) {
+	runtimes.ClientTLS = runtimes.TLSOptions{
+		Cert:     clientConfig.Cert,
+		Key:      clientConfig.Key,
+		CACert:   utils.Flags.CACert,
+		Insecure: utils.Flags.Insecure || (clientConfig.Insecure && len(utils.Flags.CACert) == 0),
+	}
+}
+
 // TODO() add Trace of runtimes found at apihost
-func setSupportedRuntimes(apiHost string) error {
-	op, err := runtimes.ParseOpenWhisk(apiHost)
+func setSupportedRuntimes(clientConfig *whisk.Config) error {
+	setRuntimesTLS(clientConfig)
+	op, err := runtimes.ParseOpenWhisk(clientConfig.Host)
 	if err != nil {
 		return err
 	}
+	return setRuntimes(op)
+}
+
+// setRuntimes initializes the supported runtimes, action files extensions and limits from the catalog,
+// the file extensions are extended by the runtime mappings of the user config file
+func setRuntimes(op runtimes.OpenWhiskInfo) error {
 	runtimes.SupportedRunTimes = runtimes.ConvertToMap(op)
 	runtimes.DefaultRunTimes = runtimes.DefaultRuntimes(op)
 	runtimes.FileExtensionRuntimeKindMap = runtimes.FileExtensionRuntimes(op)
 	runtimes.FileRuntimeExtensionsMap = runtimes.FileRuntimeExtensions(op)
+	runtimes.FileExtensionDefaultKindMap = make(map[string]string)
+	utils.ActionLimitRanges = runtimes.ActionLimitRanges(op)
+	utils.CodeSizeLimit = runtimes.CodeSizeLimit(op)
+	if utils.Flags.CodeSizeLimit > 0 {
+		utils.CodeSizeLimit = utils.Flags.CodeSizeLimit * 1024 * 1024
+	}
+
+	mappings, err := runtimes.ReadRuntimeMappings()
+	if err != nil {
+		return err
+	}
+	runtimes.ApplyRuntimeMappings(mappings)
 	return nil
 }
 
@@ -183,18 +227,40 @@ func loadDefaultDeploymentFileFromProjectPath(command string, projectPath string
 	return nil
 }
 
+// loadDotenv makes the variables of the .env file of the project available to the
+// interpolation of expressions, variables set in the environment take precedence
+func loadDotenv(projectPath string) error {
+	path := filepath.Join(projectPath, wskenv.DOTENV_FILE)
+	loaded, err := wskenv.LoadDotenv(path)
+	if loaded {
+		wskprint.PrintlnOpenWhiskVerbose(utils.Flags.Verbose,
+			wski18n.T(wski18n.ID_MSG_DOTENV_LOADED_X_path_X,
+				map[string]interface{}{wski18n.KEY_PATH: path}))
+	}
+	return err
+}
+
 func Deploy(cmd *cobra.Command) error {
 
 	// Convey flags for verbose and trace to Go client
 	whisk.SetVerbose(utils.Flags.Verbose)
 	whisk.SetDebug(utils.Flags.Trace)
 
+	// bound the memory used by the code of the actions while they are deployed
+	if utils.Flags.MemoryBudget > 0 {
+		utils.ArtifactMemory.SetLimit(int64(utils.Flags.MemoryBudget) * 1024 * 1024)
+	}
+
 	project_Path := strings.TrimSpace(utils.Flags.ProjectPath)
 	if len(project_Path) == 0 {
 		project_Path = utils.DEFAULT_PROJECT_PATH
 	}
 	projectPath, _ := filepath.Abs(project_Path)
 
+	if err := loadDotenv(projectPath); err != nil {
+		return err
+	}
+
 	// If manifest filename is not provided, attempt to load default manifests from project path
 	// default manifests are manifest.yaml and manifest.yml
 	// return failure if none of the default manifest files were found
@@ -226,6 +292,7 @@ func Deploy(cmd *cobra.Command) error {
 		deployer.DeploymentPath = utils.Flags.DeploymentPath
 		deployer.Preview = utils.Flags.Preview
 		deployer.Report = utils.Flags.Report
+		deployer.ExplainParams = utils.Flags.ExplainParams
 
 		// master record of any dependency that has been downloaded
 		deployer.DependencyMaster = make(map[string]dependencies.DependencyRecord)
@@ -248,7 +315,7 @@ func Deploy(cmd *cobra.Command) error {
 		deployer.ClientConfig = clientConfig
 
 		// The auth, apihost and namespace have been chosen, so that we can check the supported runtimes here.
-		err := setSupportedRuntimes(clientConfig.Host)
+		err := setSupportedRuntimes(clientConfig)
 		if err != nil {
 			return err
 		}
@@ -300,7 +367,7 @@ func Undeploy(cmd *cobra.Command) error {
 		deployer.ClientConfig = clientConfig
 
 		// The auth, apihost and namespace have been chosen, so that we can check the supported runtimes here.
-		err := setSupportedRuntimes(clientConfig.Host)
+		err := setSupportedRuntimes(clientConfig)
 		if err != nil {
 			return err
 		}
@@ -319,6 +386,10 @@ func Undeploy(cmd *cobra.Command) error {
 	}
 	projectPath, _ := filepath.Abs(project_Path)
 
+	if err := loadDotenv(projectPath); err != nil {
+		return err
+	}
+
 	// If manifest filename is not provided, attempt to load default manifests from project path
 	if utils.Flags.ManifestPath == "" {
 		if err, returnRoot := loadDefaultManifestFileFromProjectPath(wski18n.CMD_UNDEPLOY, projectPath, cmd); err != nil {
@@ -357,7 +428,7 @@ func Undeploy(cmd *cobra.Command) error {
 		deployer.ClientConfig = clientConfig
 
 		// The auth, apihost and namespace have been chosen, so that we can check the supported runtimes here.
-		err := setSupportedRuntimes(clientConfig.Host)
+		err := setSupportedRuntimes(clientConfig)
 		if err != nil {
 			return err
 		}
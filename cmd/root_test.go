@@ -21,6 +21,8 @@ package cmd
 
 import (
 	"bytes"
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/sciabarracom/openwhisk-wskdeploy/runtimes"
 	"github.com/sciabarracom/openwhisk-wskdeploy/utils"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
@@ -149,3 +151,23 @@ func TestRootCommand(t *testing.T) {
 	checkValidAuthInfo(t, expected_auth_flags)
 	checkValidInputInfo(t, expected_input)
 }
+
+func TestSetRuntimesTLS(t *testing.T) {
+	defer func() { utils.Flags.CACert, utils.Flags.Insecure = "", false }()
+
+	// the catalog follows the verification of the whisk client
+	setRuntimesTLS(&whisk.Config{Insecure: true})
+	assert.True(t, runtimes.ClientTLS.Insecure)
+	setRuntimesTLS(&whisk.Config{Cert: "cert.pem", Key: "key.pem"})
+	assert.False(t, runtimes.ClientTLS.Insecure)
+
+	// unless a CA certificate is given to verify it
+	utils.Flags.CACert = "ca.pem"
+	setRuntimesTLS(&whisk.Config{Insecure: true})
+	assert.False(t, runtimes.ClientTLS.Insecure)
+	assert.Equal(t, "ca.pem", runtimes.ClientTLS.CACert)
+
+	utils.Flags.Insecure = true
+	setRuntimesTLS(&whisk.Config{Cert: "cert.pem", Key: "key.pem"})
+	assert.True(t, runtimes.ClientTLS.Insecure)
+}
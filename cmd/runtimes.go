@@ -0,0 +1,261 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/sciabarracom/openwhisk-wskdeploy/deployers"
+	"github.com/sciabarracom/openwhisk-wskdeploy/parsers"
+	"github.com/sciabarracom/openwhisk-wskdeploy/runtimes"
+	"github.com/sciabarracom/openwhisk-wskdeploy/utils"
+	"github.com/sciabarracom/openwhisk-wskdeploy/wskderrors"
+	"github.com/sciabarracom/openwhisk-wskdeploy/wski18n"
+	"github.com/sciabarracom/openwhisk-wskdeploy/wskprint"
+	"github.com/spf13/cobra"
+)
+
+// runtimesCmd represents the runtimes command
+var runtimesCmd = &cobra.Command{
+	Use:        "runtimes",
+	SuggestFor: []string{"kinds"},
+	Short:      wski18n.T(wski18n.ID_CMD_DESC_SHORT_RUNTIMES),
+	Long:       wski18n.T(wski18n.ID_CMD_DESC_LONG_RUNTIMES),
+	RunE:       RuntimesCmdImp,
+}
+
+// runtimesRefreshCmd represents the runtimes refresh command
+var runtimesRefreshCmd = &cobra.Command{
+	Use:        "refresh",
+	SuggestFor: []string{"update", "fetch"},
+	Short:      wski18n.T(wski18n.ID_CMD_DESC_SHORT_RUNTIMES_REFRESH),
+	RunE:       RuntimesRefreshCmdImp,
+}
+
+// RuntimesInfo is the output of the runtimes command
+type RuntimesInfo struct {
+	ApiHost    string                 `json:"apihost"`
+	Source     string                 `json:"source"`
+	Fetched    string                 `json:"fetched,omitempty"`
+	Kinds      []runtimes.RuntimeKind `json:"kinds"`
+	Extensions map[string]string      `json:"extensions"`
+	Limits     runtimes.Limit         `json:"limits"`
+}
+
+// RuntimesCheck is the output of the runtimes command with --check
+type RuntimesCheck struct {
+	Manifest string                 `json:"manifest"`
+	Issues   []parsers.RuntimeIssue `json:"issues"`
+}
+
+// RuntimesCmdImp lists the runtimes of the apihost or, with --check, the actions of the manifest
+// using a deprecated or unavailable runtime
+func RuntimesCmdImp(cmd *cobra.Command, args []string) error {
+	whisk.SetVerbose(utils.Flags.Verbose)
+	whisk.SetDebug(utils.Flags.Trace)
+
+	// the manifest is found first, since it can set the apihost
+	if utils.Flags.CheckRuntimes {
+		projectPath, _ := filepath.Abs(strings.TrimSpace(utils.Flags.ProjectPath))
+		if err := loadDotenv(projectPath); err != nil {
+			return err
+		}
+		if len(utils.Flags.ManifestPath) == 0 {
+			if err, _ := loadDefaultManifestFileFromProjectPath(wski18n.CMD_RUNTIMES, projectPath, nil); err != nil {
+				return err
+			}
+		}
+	}
+
+	clientConfig, err := deployers.NewWhiskConfig(utils.Flags.CfgFile, utils.Flags.DeploymentPath, utils.Flags.ManifestPath)
+	if err != nil {
+		return err
+	}
+	setRuntimesTLS(clientConfig)
+	catalog, err := runtimes.LoadCatalog(clientConfig.Host)
+	if err != nil {
+		return err
+	}
+	op, err := catalog.OpenWhiskInfo()
+	if err != nil {
+		return err
+	}
+	if err := setRuntimes(op); err != nil {
+		return err
+	}
+
+	// the runtime mappings of the manifest are in effect too
+	var manifest *parsers.YAML
+	if len(utils.Flags.ManifestPath) != 0 {
+		if manifest, err = parsers.NewYAMLParser().ParseManifest(utils.Flags.ManifestPath); err != nil {
+			return err
+		}
+		if err := parsers.ApplyRuntimeMappings(manifest); err != nil {
+			return err
+		}
+	}
+	if utils.Flags.CheckRuntimes {
+		return checkRuntimes(manifest, op)
+	}
+
+	info := RuntimesInfo{
+		ApiHost:    clientConfig.Host,
+		Source:     catalog.Source,
+		Kinds:      runtimes.ListOfKinds(op),
+		Extensions: runtimes.FileExtensionKinds(),
+		Limits:     op.Limits,
+	}
+	if !catalog.Fetched.IsZero() {
+		info.Fetched = catalog.Fetched.Local().Format(time.RFC3339)
+	}
+	if utils.Flags.JSON {
+		return printJSON(info)
+	}
+	printRuntimesInfo(info)
+	return nil
+}
+
+func checkRuntimes(manifest *parsers.YAML, op runtimes.OpenWhiskInfo) error {
+	check := RuntimesCheck{
+		Manifest: utils.Flags.ManifestPath,
+		Issues:   parsers.CheckManifestRuntimes(manifest, op),
+	}
+	if utils.Flags.JSON {
+		if err := printJSON(check); err != nil {
+			return err
+		}
+	} else if len(check.Issues) == 0 {
+		wskprint.PrintOpenWhiskSuccess(wski18n.T(wski18n.ID_MSG_RUNTIMES_CHECK_OK_X_path_X,
+			map[string]interface{}{wski18n.KEY_PATH: check.Manifest}))
+	} else {
+		// Note: no need to translate the column names
+		printTable([]string{"PACKAGE", "ACTION", "RUNTIME", "STATUS", "SUGGESTION"}, len(check.Issues), func(i int) []string {
+			issue := check.Issues[i]
+			return []string{issue.Package, issue.Action, issue.Runtime, issue.Status, issue.Suggestion}
+		})
+	}
+	if len(check.Issues) != 0 {
+		return wskderrors.NewCommandError(LONG_CMD+FLAG_CHECK,
+			wski18n.T(wski18n.ID_ERR_RUNTIMES_CHECK_X_count_X_path_X,
+				map[string]interface{}{
+					wski18n.KEY_COUNT: len(check.Issues),
+					wski18n.KEY_PATH:  check.Manifest}))
+	}
+	return nil
+}
+
+func printRuntimesInfo(info RuntimesInfo) {
+	source := info.Source
+	if len(info.Fetched) != 0 {
+		source += " (" + info.Fetched + ")"
+	}
+	wskprint.PrintlnOpenWhiskInfoTitle(wski18n.T(wski18n.ID_MSG_RUNTIMES_CATALOG_X_host_X_source_X,
+		map[string]interface{}{
+			wski18n.KEY_HOST:   info.ApiHost,
+			wski18n.KEY_SOURCE: source}))
+
+	// Note: no need to translate the column names
+	printTable([]string{"LANGUAGE", "KIND", "DEFAULT", "DEPRECATED"}, len(info.Kinds), func(i int) []string {
+		kind := info.Kinds[i]
+		return []string{kind.Language, kind.Kind, fmt.Sprint(kind.Default), fmt.Sprint(kind.Deprecated)}
+	})
+
+	extensions := make([]string, 0, len(info.Extensions))
+	for ext := range info.Extensions {
+		extensions = append(extensions, ext)
+	}
+	sort.Strings(extensions)
+	printTable([]string{"EXTENSION", "KIND"}, len(extensions), func(i int) []string {
+		return []string{"." + extensions[i], info.Extensions[extensions[i]]}
+	})
+
+	// the limits are listed with the names of /api/info, the ones it does not set are skipped
+	var limits map[string]interface{}
+	content, _ := json.Marshal(info.Limits)
+	json.Unmarshal(content, &limits)
+	names := make([]string, 0, len(limits))
+	for name, value := range limits {
+		if value != "" && value != float64(0) {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return
+	}
+	sort.Strings(names)
+	printTable([]string{"LIMIT", "VALUE"}, len(names), func(i int) []string {
+		return []string{names[i], fmt.Sprint(limits[names[i]])}
+	})
+}
+
+// printTable prints the rows returned by the row function in aligned columns
+func printTable(header []string, rows int, row func(int) []string) {
+	var sb strings.Builder
+	w := tabwriter.NewWriter(&sb, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, strings.Join(header, "\t"))
+	for i := 0; i < rows; i++ {
+		fmt.Fprintln(w, strings.Join(row(i), "\t"))
+	}
+	w.Flush()
+	wskprint.PrintlnOpenWhiskOutput(sb.String())
+}
+
+func printJSON(v interface{}) error {
+	content, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	wskprint.PrintlnOpenWhiskOutput(string(content))
+	return nil
+}
+
+// RuntimesRefreshCmdImp downloads the catalog of runtimes whatever the age of the cached one
+func RuntimesRefreshCmdImp(cmd *cobra.Command, args []string) error {
+	if utils.Flags.Offline {
+		return wskderrors.NewCommandError(LONG_CMD+FLAG_OFFLINE,
+			wski18n.T(wski18n.ID_ERR_RUNTIMES_REFRESH_OFFLINE))
+	}
+	clientConfig, err := deployers.NewWhiskConfig(utils.Flags.CfgFile, utils.Flags.DeploymentPath, utils.Flags.ManifestPath)
+	if err != nil {
+		return err
+	}
+	setRuntimesTLS(clientConfig)
+	catalog, err := runtimes.FetchCatalog(clientConfig.Host)
+	if err != nil {
+		return err
+	}
+	wskprint.PrintOpenWhiskSuccess(wski18n.T(wski18n.ID_MSG_RUNTIMES_REFRESHED_X_host_X_path_X,
+		map[string]interface{}{
+			wski18n.KEY_HOST: clientConfig.Host,
+			wski18n.KEY_PATH: catalog.Path}))
+	return nil
+}
+
+func init() {
+	runtimesCmd.Flags().BoolVar(&utils.Flags.JSON, FLAG_JSON, false, wski18n.T(wski18n.ID_CMD_FLAG_JSON))
+	runtimesCmd.Flags().BoolVar(&utils.Flags.CheckRuntimes, FLAG_CHECK, false, wski18n.T(wski18n.ID_CMD_FLAG_CHECK_RUNTIMES))
+	runtimesCmd.AddCommand(runtimesRefreshCmd)
+	RootCmd.AddCommand(runtimesCmd)
+}
@@ -18,13 +18,19 @@
 package cmd
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"path/filepath"
 	"strings"
 
+	"github.com/sciabarracom/openwhisk-wskdeploy/parsers"
+	"github.com/sciabarracom/openwhisk-wskdeploy/utils"
 	"github.com/sciabarracom/openwhisk-wskdeploy/wskderrors"
+	"github.com/sciabarracom/openwhisk-wskdeploy/wskenv"
 	"github.com/sciabarracom/openwhisk-wskdeploy/wski18n"
+	"gopkg.in/yaml.v2"
 )
 
 const (
@@ -57,12 +63,32 @@ const (
 	FLAG_PARAM            = "param"
 	FLAG_PARAMFILE        = "param-file"
 	FLAG_PARAMFILE_SHORT  = "P"
+	FLAG_EXPLAIN_PARAMS   = "explain-params"
+	FLAG_LIST_ZIP         = "list-zip-contents"
+	FLAG_CODE_SIZE_LIMIT  = "code-size-limit"
+	FLAG_MEMORY_BUDGET    = "memory-budget"
+	FLAG_DEFAULTS_MIN     = "defaults-min-actions"
+	FLAG_ALARMS_PACKAGE   = "alarms-package"
+	FLAG_OFFLINE          = "offline"
+	FLAG_CACERT           = "cacert"
+	FLAG_INSECURE         = "insecure"
+	FLAG_RUNTIMES_TTL     = "runtimes-ttl"
+	FLAG_JSON             = "json"
+	FLAG_CHECK            = "check"
 	SHORT_CMD             = "-"
 	LONG_CMD              = SHORT_CMD + SHORT_CMD
 )
 
+// extensions of the parameter files in YAML and dotenv format, any other file is read as JSON
+const (
+	PARAM_FILE_EXT_YAML = ".yaml"
+	PARAM_FILE_EXT_YML  = ".yml"
+	PARAM_FILE_EXT_ENV  = ".env"
+)
+
 // parse key value pairs from --param
-// read parameters from param JSON file specified in --param-file
+// read parameters from param JSON, YAML or dotenv files specified in --param-file
+// parameters are applied in the order they appear, the last value of a parameter wins
 func parseArgsForParams(args []string) ([]string, []string, error) {
 	var paramArgs []string
 	var err error
@@ -84,8 +110,13 @@ func parseArgsForParams(args []string) ([]string, []string, error) {
 			}
 			filename := paramArgs[len(paramArgs)-1]
 			// drop the argument (--param-file) and its value from the argument list after retrieving filename
-			// read file content as a single string and append it to the list of params
-			file, readErr := ioutil.ReadFile(filename)
+			// read file content as a JSON object and append it to the list of params
+			params, readErr := readParamFile(filename)
+			var file []byte
+			if readErr == nil {
+				// values JSON can not represent, e.g. a YAML .nan, are errors of the file
+				file, readErr = json.Marshal(params)
+			}
 			if readErr != nil {
 				err = wskderrors.NewCommandError(FLAG_PARAMFILE+"/"+FLAG_PARAMFILE_SHORT,
 					wski18n.T(wski18n.ID_ERR_INVALID_PARAM_FILE_X_file_X,
@@ -96,6 +127,9 @@ func parseArgsForParams(args []string) ([]string, []string, error) {
 				return nil, nil, err
 			}
 			paramArgs[len(paramArgs)-1] = string(file)
+			for name := range params {
+				utils.ParamSources[name] = parsers.ParameterSource(parsers.PARAM_SOURCE_PARAM_FILE, filename)
+			}
 			// --param can appear multiple times in a single invocation of whisk deploy
 			// for example, wskdeploy -m manifest.yaml --param key1 value1 --param key2 value2
 			// parse key value map for each --param from the argument list
@@ -141,6 +175,7 @@ func getKeyValueArgs(args []string, argIndex int, parsedArgs []string) ([]string
 		// append key/value pairs to list of parameters
 		// drop those pairs from the argument list
 		parsedArgs = append(parsedArgs, getFormattedJSON(key, value))
+		utils.ParamSources[key] = parsers.ParameterSource(parsers.PARAM_SOURCE_CLI, "")
 		args = append(args[:argIndex], args[argIndex+3:]...)
 	} else {
 		err = wskderrors.NewCommandError(args[argIndex],
@@ -172,3 +207,49 @@ func getEscapedJSON(value string) string {
 	value = strings.Replace(value, "\"", "\\\"", -1)
 	return value
 }
+
+// readParamFile reads the parameters of a file in YAML format (.yaml, .yml), in dotenv
+// format (.env, .env.*) or else in JSON format. JSON and YAML values keep their types,
+// dotenv values are strings like the values of --param.
+func readParamFile(filename string) (map[string]interface{}, error) {
+	content, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	base := strings.ToLower(filepath.Base(filename))
+	switch ext := filepath.Ext(base); {
+	case ext == PARAM_FILE_EXT_YAML || ext == PARAM_FILE_EXT_YML:
+		var params map[string]interface{}
+		if err := yaml.Unmarshal(content, &params); err != nil {
+			return nil, err
+		}
+		// nested YAML maps are converted to maps with string keys to be marshalled as JSON
+		for name, value := range params {
+			switch v := value.(type) {
+			case map[interface{}]interface{}, []interface{}:
+				params[name] = utils.ConvertInterfaceValue(v)
+			}
+		}
+		return params, nil
+	case ext == PARAM_FILE_EXT_ENV || strings.HasPrefix(base, PARAM_FILE_EXT_ENV+"."):
+		values, err := wskenv.ParseDotenv(filename, content)
+		if err != nil {
+			return nil, err
+		}
+		params := make(map[string]interface{}, len(values))
+		for name, value := range values {
+			params[name] = value
+		}
+		return params, nil
+	}
+
+	// numbers are kept as they are written in the file
+	var params map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+	if err := decoder.Decode(&params); err != nil {
+		return nil, err
+	}
+	return params, nil
+}
@@ -0,0 +1,112 @@
+// +build unit
+
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sciabarracom/openwhisk-wskdeploy/parsers"
+	"github.com/sciabarracom/openwhisk-wskdeploy/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadParamFile(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "paramfile")
+	defer os.RemoveAll(dir)
+
+	// JSON and YAML values keep their types
+	files := map[string]string{
+		"params.json":  `{"name": "Amy", "count": 5, "config": {"debug": true}}`,
+		"params.yaml":  "name: Amy\ncount: 5\nconfig:\n  debug: true\n",
+		"params.noext": `{"name": "Amy", "count": 5, "config": {"debug": true}}`,
+		"params.YML":   "name: Amy\ncount: 5\nconfig: {debug: true}\n",
+	}
+	for name, content := range files {
+		assertParamFile(t, filepath.Join(dir, name), content, `{"name": "Amy", "count": 5, "config": {"debug": true}}`)
+	}
+
+	// dotenv values are strings
+	files = map[string]string{
+		".env":           "name=Amy\ncount=5\nconfig={\"debug\":true}\n",
+		".env.staging":   "name=Amy\ncount=5\nconfig={\"debug\":true}\n",
+		"production.env": "export name=Amy\ncount=5\nconfig='{\"debug\":true}'\n",
+	}
+	for name, content := range files {
+		assertParamFile(t, filepath.Join(dir, name), content, `{"name": "Amy", "count": "5", "config": "{\"debug\":true}"}`)
+	}
+
+	path := filepath.Join(dir, "invalid.yaml")
+	ioutil.WriteFile(path, []byte("- not\n- a map\n"), 0644)
+	_, err := readParamFile(path)
+	assert.NotNil(t, err)
+}
+
+func assertParamFile(t *testing.T, path string, content string, expected string) {
+	ioutil.WriteFile(path, []byte(content), 0644)
+	params, err := readParamFile(path)
+	assert.Nil(t, err, path)
+	values, err := json.Marshal(params)
+	assert.Nil(t, err, path)
+	assert.JSONEq(t, expected, string(values), path)
+}
+
+func TestParseArgsForParamFiles(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "paramfile")
+	defer os.RemoveAll(dir)
+	defer func() { utils.ParamSources = make(map[string]string) }()
+
+	yamlFile := filepath.Join(dir, "params.yaml")
+	ioutil.WriteFile(yamlFile, []byte("name: Amy\nplace: Paris\n"), 0644)
+	envFile := filepath.Join(dir, ".env")
+	ioutil.WriteFile(envFile, []byte("place=Rome\n"), 0644)
+
+	args, params, err := parseArgsForParams([]string{"wskdeploy", "-P", yamlFile, "--param", "name", "Bob", "--param-file", envFile, "-m", "manifest.yaml"})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"wskdeploy", "-m", "manifest.yaml"}, args)
+
+	values, err := utils.GetJSONFromStrings(params, false)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"name": "Bob", "place": "Rome"}, values, "the last value of a parameter should win")
+	assert.Equal(t, parsers.ParameterSource(parsers.PARAM_SOURCE_CLI, ""), utils.ParamSources["name"])
+	assert.Equal(t, parsers.ParameterSource(parsers.PARAM_SOURCE_PARAM_FILE, envFile), utils.ParamSources["place"])
+
+	// numbers and objects of a JSON file are not deployed as strings
+	jsonFile := filepath.Join(dir, "params.json")
+	ioutil.WriteFile(jsonFile, []byte(`{"count": 5, "config": {"a": 1}}`), 0644)
+	_, params, err = parseArgsForParams([]string{"wskdeploy", "-P", jsonFile})
+	assert.Nil(t, err)
+	values, err = utils.GetJSONFromStrings(params, false)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"count": json.Number("5"), "config": map[string]interface{}{"a": json.Number("1")}}, values)
+
+	_, _, err = parseArgsForParams([]string{"wskdeploy", "-P", filepath.Join(dir, "missing.json")})
+	assert.NotNil(t, err)
+
+	// a value which can not be marshalled as JSON is an error of the file
+	nanFile := filepath.Join(dir, "nan.yaml")
+	ioutil.WriteFile(nanFile, []byte("ratio: .nan\n"), 0644)
+	_, _, err = parseArgsForParams([]string{"wskdeploy", "-P", nanFile})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), nanFile)
+}
@@ -0,0 +1,321 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package conductor
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/sciabarracom/openwhisk-wskdeploy/wski18n"
+)
+
+// keys of the steps of a flow
+const (
+	FLOW_SEQUENCE = "sequence"
+	FLOW_IF       = "if"
+	FLOW_THEN     = "then"
+	FLOW_TRY      = "try"
+	FLOW_CATCH    = "catch"
+	FLOW_RETRY    = "retry"
+	FLOW_COUNT    = "count"
+	FLOW_REPEAT   = "repeat"
+	FLOW_UNTIL    = "until"
+	FLOW_MAP      = "map"
+	FLOW_OVER     = "over"
+	FLOW_EQUALS   = "equals"
+)
+
+// instructions of a compiled flow, run by the conductor action
+const (
+	OP_INVOKE = "invoke" // invoke the action and continue with its result
+	OP_TEST   = "test"   // continue at next unless the field matches
+	OP_JUMP   = "jump"   // continue at next
+	OP_TRY    = "try"    // continue at next on errors until untry
+	OP_UNTRY  = "untry"
+	OP_SAVE   = "save" // save the params for count retries
+	OP_RETRY  = "retry"
+	OP_DROP   = "drop"
+	OP_MAP    = "map" // invoke the action in parallel for each item of the field
+)
+
+/*
+   Flow is a step of the control flow of a composition, either the name of an action or one of:
+
+   sequence: the steps run one after the other
+   if: the then step runs if the field is true, or equals the value, the else step otherwise
+   try: the catch step runs with the error of the try step
+   retry: the step runs again with the same params on errors, up to count times
+   repeat: the step runs again until the field is true, or equals the value
+   map: the action runs in parallel for each item of the array over, replaced by the results
+*/
+type Flow struct {
+	Action   string      `yaml:"action,omitempty"`
+	Sequence []Flow      `yaml:"sequence,omitempty"`
+	If       string      `yaml:"if,omitempty"`
+	Equals   interface{} `yaml:"equals,omitempty"`
+	Then     *Flow       `yaml:"then,omitempty"`
+	Else     *Flow       `yaml:"else,omitempty"`
+	Try      *Flow       `yaml:"try,omitempty"`
+	Catch    *Flow       `yaml:"catch,omitempty"`
+	Retry    *Flow       `yaml:"retry,omitempty"`
+	Count    int         `yaml:"count,omitempty"`
+	Repeat   *Flow       `yaml:"repeat,omitempty"`
+	Until    string      `yaml:"until,omitempty"`
+	Map      string      `yaml:"map,omitempty"`
+	Over     string      `yaml:"over,omitempty"`
+}
+
+// a step is either the name of an action, a list of steps run in sequence or a map
+func (flow *Flow) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var action string
+	if err := unmarshal(&action); err == nil {
+		*flow = Flow{Action: action}
+		return nil
+	}
+	var sequence []Flow
+	if err := unmarshal(&sequence); err == nil {
+		*flow = Flow{Sequence: sequence}
+		return nil
+	}
+	type flowStep Flow
+	var f flowStep
+	if err := unmarshal(&f); err != nil {
+		return err
+	}
+	*flow = Flow(f)
+	return nil
+}
+
+// replaced by the instructions of the flow in the template of a compiler
+const PROGRAM_PLACEHOLDER = "{{program}}"
+
+// Compiler generates the code of a conductor action running a compiled flow
+type Compiler struct {
+	Template string // code running the program, declared in place of PROGRAM_PLACEHOLDER
+	Parallel bool   // the runtime can invoke actions in parallel
+}
+
+// compilers by runtime language
+var Compilers = map[string]Compiler{
+	"nodejs": {Template: NODEJS_TEMPLATE, Parallel: true},
+}
+
+// GetCompiler returns the compiler of the language of the runtime, nodejs for nodejs:default
+func GetCompiler(runtime string) (Compiler, bool) {
+	compiler, ok := Compilers[strings.Split(runtime, ":")[0]]
+	return compiler, ok
+}
+
+// Components returns the names of the actions the flow invokes, in order
+func (flow Flow) Components() []string {
+	var names []string
+	flow.walk(func(step *Flow) error {
+		if len(step.Action) != 0 {
+			names = append(names, step.Action)
+		}
+		if len(step.Map) != 0 {
+			names = append(names, step.Map)
+		}
+		return nil
+	})
+	return names
+}
+
+// Resolve returns a copy of the flow with the names of its actions replaced by resolve
+func (flow Flow) Resolve(resolve func(name string) (string, error)) (Flow, error) {
+	resolved := flow.copy()
+	err := resolved.walk(func(step *Flow) error {
+		var err error
+		if len(step.Action) != 0 {
+			if step.Action, err = resolve(step.Action); err != nil {
+				return err
+			}
+		}
+		if len(step.Map) != 0 {
+			if step.Map, err = resolve(step.Map); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return resolved, err
+}
+
+func (flow Flow) copy() Flow {
+	copied := flow
+	copied.Sequence = nil
+	for _, step := range flow.Sequence {
+		copied.Sequence = append(copied.Sequence, step.copy())
+	}
+	for _, step := range []**Flow{&copied.Then, &copied.Else, &copied.Try, &copied.Catch, &copied.Retry, &copied.Repeat} {
+		if *step != nil {
+			c := (*step).copy()
+			*step = &c
+		}
+	}
+	return copied
+}
+
+// walk calls visit for the step and the steps it includes, depth first
+func (flow *Flow) walk(visit func(step *Flow) error) error {
+	if err := visit(flow); err != nil {
+		return err
+	}
+	for i := range flow.Sequence {
+		if err := flow.Sequence[i].walk(visit); err != nil {
+			return err
+		}
+	}
+	for _, step := range []*Flow{flow.Then, flow.Else, flow.Try, flow.Catch, flow.Retry, flow.Repeat} {
+		if step != nil {
+			if err := step.walk(visit); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Validate returns an error for the first step which is not exactly one of the steps of a flow
+// with its required keys, or a parallel map when the compiler does not support it
+func (flow Flow) Validate(compiler Compiler) error {
+	return flow.walk(func(step *Flow) error {
+		combinators := 0
+		for _, set := range []bool{len(step.Action) != 0, step.Sequence != nil, len(step.If) != 0,
+			step.Try != nil, step.Retry != nil, step.Repeat != nil, len(step.Map) != 0} {
+			if set {
+				combinators++
+			}
+		}
+		if combinators != 1 {
+			return errors.New(wski18n.T(wski18n.ID_ERR_FLOW_STEP))
+		}
+
+		missing := func(combinator string, key string) error {
+			return errors.New(wski18n.T(wski18n.ID_ERR_FLOW_MISSING_KEY_X_combinator_X_key_X,
+				map[string]interface{}{
+					wski18n.KEY_COMBINATOR: combinator,
+					wski18n.KEY_KEY:        key}))
+		}
+		switch {
+		case len(step.If) != 0 && step.Then == nil:
+			return missing(FLOW_IF, FLOW_THEN)
+		case step.Try != nil && step.Catch == nil:
+			return missing(FLOW_TRY, FLOW_CATCH)
+		case step.Retry != nil && step.Count <= 0:
+			return missing(FLOW_RETRY, FLOW_COUNT)
+		case step.Repeat != nil && len(step.Until) == 0:
+			return missing(FLOW_REPEAT, FLOW_UNTIL)
+		case len(step.Map) != 0 && len(step.Over) == 0:
+			return missing(FLOW_MAP, FLOW_OVER)
+		case len(step.Map) != 0 && !compiler.Parallel:
+			return errors.New(wski18n.T(wski18n.ID_ERR_FLOW_PARALLEL))
+		}
+
+		switch step.Equals.(type) {
+		case nil, string, bool, int, float64:
+		default:
+			return errors.New(wski18n.T(wski18n.ID_ERR_FLOW_EQUALS_X_value_X,
+				map[string]interface{}{wski18n.KEY_VALUE: fmt.Sprintf("%v", step.Equals)}))
+		}
+		return nil
+	})
+}
+
+type instruction struct {
+	Op     string      `json:"op"`
+	Action string      `json:"action,omitempty"`
+	Field  string      `json:"field,omitempty"`
+	Equals interface{} `json:"equals,omitempty"`
+	Count  int         `json:"count,omitempty"`
+	Next   int         `json:"next"`
+}
+
+// program returns the instructions running the flow
+func (flow Flow) program() []instruction {
+	var program []instruction
+	var compile func(step Flow)
+	emit := func(i instruction) int {
+		program = append(program, i)
+		return len(program) - 1
+	}
+	compile = func(step Flow) {
+		switch {
+		case len(step.Action) != 0:
+			emit(instruction{Op: OP_INVOKE, Action: step.Action})
+		case step.Sequence != nil:
+			for _, s := range step.Sequence {
+				compile(s)
+			}
+		case len(step.If) != 0:
+			test := emit(instruction{Op: OP_TEST, Field: step.If, Equals: step.Equals})
+			compile(*step.Then)
+			jump := emit(instruction{Op: OP_JUMP})
+			program[test].Next = len(program)
+			if step.Else != nil {
+				compile(*step.Else)
+			}
+			program[jump].Next = len(program)
+		case step.Try != nil:
+			try := emit(instruction{Op: OP_TRY})
+			compile(*step.Try)
+			emit(instruction{Op: OP_UNTRY})
+			jump := emit(instruction{Op: OP_JUMP})
+			program[try].Next = len(program)
+			compile(*step.Catch)
+			program[jump].Next = len(program)
+		case step.Retry != nil:
+			emit(instruction{Op: OP_SAVE, Count: step.Count})
+			try := emit(instruction{Op: OP_TRY})
+			compile(*step.Retry)
+			emit(instruction{Op: OP_UNTRY})
+			emit(instruction{Op: OP_DROP})
+			jump := emit(instruction{Op: OP_JUMP})
+			program[try].Next = emit(instruction{Op: OP_RETRY, Next: try})
+			program[jump].Next = len(program)
+		case step.Repeat != nil:
+			start := len(program)
+			compile(*step.Repeat)
+			emit(instruction{Op: OP_TEST, Field: step.Until, Equals: step.Equals, Next: start})
+		case len(step.Map) != 0:
+			emit(instruction{Op: OP_MAP, Action: step.Map, Field: step.Over})
+		}
+	}
+	compile(flow)
+	return program
+}
+
+// Compile returns the code of the conductor action running the flow
+func (flow Flow) Compile(compiler Compiler) (string, error) {
+	if err := flow.Validate(compiler); err != nil {
+		return "", err
+	}
+	// one instruction per line, numbered, for the code to be readable
+	var lines []string
+	for pc, i := range flow.program() {
+		instruction, err := json.Marshal(i)
+		if err != nil {
+			return "", err
+		}
+		lines = append(lines, fmt.Sprintf("  /* %d */ %s", pc, instruction))
+	}
+	program := "[\n" + strings.Join(lines, ",\n") + "\n]"
+	return strings.Replace(compiler.Template, PROGRAM_PLACEHOLDER, program, 1), nil
+}
@@ -0,0 +1,126 @@
+// +build unit
+
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package conductor
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+const TEST_FLOW = `
+- validate
+- if: paid
+  then: ship
+  else: [notify, cancel]
+- try: charge
+  catch: refund
+- retry: fetch
+  count: 2
+- repeat: poll
+  until: status
+  equals: done
+- map: resize
+  over: images
+`
+
+func testFlow(t *testing.T, src string) Flow {
+	var flow Flow
+	assert.Nil(t, yaml.Unmarshal([]byte(src), &flow))
+	return flow
+}
+
+func TestFlowComponents(t *testing.T) {
+	flow := testFlow(t, TEST_FLOW)
+	expected := []string{"validate", "ship", "notify", "cancel", "charge", "refund", "fetch", "poll", "resize"}
+	assert.Equal(t, expected, flow.Components())
+
+	resolved, err := flow.Resolve(func(name string) (string, error) { return "pkg/" + name, nil })
+	assert.Nil(t, err)
+	assert.Equal(t, "pkg/cancel", resolved.Sequence[1].Else.Sequence[1].Action)
+	assert.Equal(t, "pkg/resize", resolved.Sequence[5].Map)
+	assert.Equal(t, "cancel", flow.Sequence[1].Else.Sequence[1].Action, "Resolve must not change the flow")
+}
+
+func TestFlowProgram(t *testing.T) {
+	flow := testFlow(t, TEST_FLOW)
+	program := flow.program()
+	var ops []string
+	for _, i := range program {
+		ops = append(ops, i.Op)
+	}
+	assert.Equal(t, []string{
+		OP_INVOKE,
+		OP_TEST, OP_INVOKE, OP_JUMP, OP_INVOKE, OP_INVOKE,
+		OP_TRY, OP_INVOKE, OP_UNTRY, OP_JUMP, OP_INVOKE,
+		OP_SAVE, OP_TRY, OP_INVOKE, OP_UNTRY, OP_DROP, OP_JUMP, OP_RETRY,
+		OP_INVOKE, OP_TEST,
+		OP_MAP}, ops)
+
+	// if jumps to else, then over it
+	assert.Equal(t, 4, program[1].Next)
+	assert.Equal(t, 6, program[3].Next)
+	// try continues at catch
+	assert.Equal(t, 10, program[6].Next)
+	// retry runs again from try
+	assert.Equal(t, 2, program[11].Count)
+	assert.Equal(t, 17, program[12].Next)
+	assert.Equal(t, 12, program[17].Next)
+	// repeat runs again from its first step until the field equals the value
+	assert.Equal(t, 18, program[19].Next)
+	assert.Equal(t, "done", program[19].Equals)
+}
+
+func TestFlowCompile(t *testing.T) {
+	compiler, ok := GetCompiler("nodejs:default")
+	assert.True(t, ok)
+	_, ok = GetCompiler("python:3")
+	assert.False(t, ok)
+
+	code, err := testFlow(t, TEST_FLOW).Compile(compiler)
+	assert.Nil(t, err)
+	assert.NotContains(t, code, PROGRAM_PLACEHOLDER)
+	assert.Contains(t, code, `/* 0 */ {"op":"invoke","action":"validate","next":0}`)
+	assert.True(t, strings.HasSuffix(strings.TrimSpace(code), "}"))
+}
+
+func TestFlowValidate(t *testing.T) {
+	compiler := Compiler{Template: NODEJS_TEMPLATE, Parallel: true}
+	for src, expected := range map[string]string{
+		"if: paid\nelse: cancel":        "[then]",
+		"try: charge":                   "[catch]",
+		"retry: fetch":                  "[count]",
+		"repeat: poll":                  "[until]",
+		"map: resize":                   "[map]",
+		"if: paid\nthen: a\ntry: b":     "[sequence]",
+		"[a, {}]":                       "[sequence]",
+		"if: paid\nthen: a\nequals: []": "[equals]",
+	} {
+		err := testFlow(t, src).Validate(compiler)
+		assert.NotNil(t, err, "Expected an error for "+src)
+		assert.Contains(t, err.Error(), expected)
+	}
+
+	err := testFlow(t, "map: resize\nover: images").Validate(Compiler{Template: NODEJS_TEMPLATE})
+	assert.NotNil(t, err, "Expected an error for a parallel map")
+	assert.Nil(t, testFlow(t, TEST_FLOW).Validate(compiler))
+}
@@ -0,0 +1,140 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package conductor
+
+// NODEJS_TEMPLATE is the conductor action running a compiled flow on nodejs, the state of the
+// flow is returned with each action to invoke and given back by OpenWhisk in $resume with its result
+const NODEJS_TEMPLATE = `// generated by wskdeploy from the flow of the composition, do not edit
+const program = {{program}}
+
+function qualified(name) {
+  return name.startsWith('/') ? name : '/' + process.env.__OW_NAMESPACE + '/' + name
+}
+
+function get(params, field) {
+  return field.split('.').reduce((value, key) => value === undefined || value === null ? undefined : value[key], params)
+}
+
+function set(params, field, value) {
+  const keys = field.split('.')
+  const result = Object.assign({}, params)
+  let target = result
+  for (const key of keys.slice(0, -1)) {
+    target[key] = Object.assign({}, target[key])
+    target = target[key]
+  }
+  target[keys[keys.length - 1]] = value
+  return result
+}
+
+function test(value, op) {
+  return op.equals === undefined ? Boolean(value) : value === op.equals
+}
+
+// fail continues at the innermost catch, false if there is none
+function fail(state) {
+  while (state.stack.length > 0) {
+    const frame = state.stack.pop()
+    if (frame.catch !== undefined) {
+      state.pc = frame.catch
+      return true
+    }
+  }
+  return false
+}
+
+async function map(op, params) {
+  const items = get(params, op.field)
+  if (!Array.isArray(items)) {
+    return { error: 'the field [' + op.field + '] of the params is not an array' }
+  }
+  const ow = require('openwhisk')()
+  try {
+    const results = await Promise.all(items.map(item => ow.actions.invoke({
+      name: qualified(op.action),
+      params: typeof item === 'object' && item !== null && !Array.isArray(item) ? item : { value: item },
+      blocking: true,
+      result: true
+    })))
+    return set(params, op.field, results)
+  } catch (err) {
+    return { error: err.message }
+  }
+}
+
+async function main(args) {
+  let state = args.$resume
+  const params = Object.assign({}, args)
+  delete params.$resume
+  if (state === undefined) {
+    state = { pc: 0, stack: [] }
+  } else if (params.error !== undefined && !fail(state)) {
+    return params
+  }
+  return run(state, params)
+}
+
+async function run(state, params) {
+  while (state.pc < program.length) {
+    const op = program[state.pc++]
+    switch (op.op) {
+      case 'invoke':
+        return { action: qualified(op.action), params: params, state: state }
+      case 'test':
+        if (!test(get(params, op.field), op)) {
+          state.pc = op.next
+        }
+        break
+      case 'jump':
+        state.pc = op.next
+        break
+      case 'try':
+        state.stack.push({ catch: op.next })
+        break
+      case 'untry':
+      case 'drop':
+        state.stack.pop()
+        break
+      case 'save':
+        state.stack.push({ params: params, count: op.count })
+        break
+      case 'retry': {
+        const frame = state.stack[state.stack.length - 1]
+        if (frame.count > 0) {
+          frame.count--
+          params = frame.params
+          state.pc = op.next
+        } else {
+          state.stack.pop()
+          if (!fail(state)) {
+            return params
+          }
+        }
+        break
+      }
+      case 'map':
+        params = await map(op, params)
+        if (params.error !== undefined && !fail(state)) {
+          return params
+        }
+        break
+    }
+  }
+  return params
+}
+`
@@ -18,6 +18,8 @@
 package deployers
 
 import (
+	"path/filepath"
+
 	"github.com/apache/openwhisk-client-go/whisk"
 	"github.com/sciabarracom/openwhisk-wskdeploy/parsers"
 	"github.com/sciabarracom/openwhisk-wskdeploy/utils"
@@ -29,6 +31,8 @@ import (
 type DeploymentReader struct {
 	serviceDeployer      *ServiceDeployer
 	DeploymentDescriptor *parsers.YAML
+	// the manifest declares the types of the inputs bound by the deployment file
+	ManifestDescriptor *parsers.YAML
 }
 
 func NewDeploymentReader(serviceDeployer *ServiceDeployer) *DeploymentReader {
@@ -114,26 +118,102 @@ func (reader *DeploymentReader) getPackageMap() map[string]parsers.Package {
 	return packMap
 }
 
-func (reader *DeploymentReader) getListOfParameters(inputs map[string]parsers.Parameter) whisk.KeyValueArr {
+// deployment file values are interpolated with the same expressions as the manifest
+func (reader *DeploymentReader) interpolationContext() *wskenv.Context {
+	ctx := &wskenv.Context{ProjectName: reader.serviceDeployer.ProjectName}
+	if reader.DeploymentDescriptor != nil {
+		ctx.BaseDir = filepath.Dir(reader.DeploymentDescriptor.Filepath)
+	}
+	return ctx
+}
+
+// declaredInputs returns the inputs of an action or trigger as declared in the manifest
+func (reader *DeploymentReader) declaredInputs(packName string, key string, name string) map[string]parsers.Parameter {
+	if reader.ManifestDescriptor == nil {
+		return nil
+	}
+	packages := reader.ManifestDescriptor.Packages
+	if len(packages) == 0 {
+		packages = reader.ManifestDescriptor.GetProject().Packages
+	}
+	pack, ok := packages[packName]
+	if !ok {
+		return nil
+	}
+	switch key {
+	case parsers.YAML_KEY_ACTION:
+		return pack.Actions[name].Inputs
+	case parsers.YAML_KEY_TRIGGER:
+		return pack.Triggers[name].Inputs
+	}
+	return nil
+}
+
+// coerceParameter converts a value to the type declared in the manifest, if any
+func (reader *DeploymentReader) coerceParameter(name string, declared map[string]parsers.Parameter, value interface{}, source string) (interface{}, error) {
+	if param, ok := declared[name]; ok {
+		parsers.RegisterSecretValue(param, value)
+		return parsers.CoerceParameterValue(name, &param, value, reader.serviceDeployer.ManifestPath, source)
+	}
+	return value, nil
+}
+
+// bindCLIParameters overrides parameters of an entity with the values specified on CLI
+func (reader *DeploymentReader) bindCLIParameters(entity string, keyValArr whisk.KeyValueArr, paramsCLI interface{}, declared map[string]parsers.Parameter) (whisk.KeyValueArr, error) {
+	if paramsCLI == nil {
+		return keyValArr, nil
+	}
+	var err error
+	inputs := make(whisk.KeyValueArr, 0)
+	for _, kv := range keyValArr {
+		// check if this particular input is specified on CLI
+		if v, ok := paramsCLI.(map[string]interface{})[kv.Key]; ok {
+			kv.Value = wskenv.ConvertSingleName(v.(string))
+			if kv.Value, err = reader.coerceParameter(kv.Key, declared, kv.Value, parsers.CLISource(kv.Key)); err != nil {
+				return nil, err
+			}
+			parsers.RecordParameterSource(entity, kv.Key, parsers.CLISource(kv.Key))
+		}
+		inputs = append(inputs, kv)
+	}
+	return inputs, nil
+}
+
+// getListOfParameters resolves the inputs of an entity from the deployment file and records the source of their values
+func (reader *DeploymentReader) getListOfParameters(entity string, inputs map[string]parsers.Parameter, declared map[string]parsers.Parameter) (whisk.KeyValueArr, error) {
+	var err error
 	keyValArr := make(whisk.KeyValueArr, 0)
+	ctx := reader.interpolationContext()
 	for name, input := range inputs {
 		var keyVal whisk.KeyValue
 		keyVal.Key = name
-		keyVal.Value = wskenv.InterpolateStringWithEnvVar(input.Value)
+		if keyVal.Value, err = wskenv.Interpolate(input.Value, ctx); err != nil {
+			return nil, err
+		}
+		parsers.RegisterSecretValue(input, keyVal.Value)
+		source := parsers.ValueSource(parsers.PARAM_SOURCE_DEPLOYMENT, reader.DeploymentDescriptor.Filepath, input.Value)
+		if keyVal.Value, err = reader.coerceParameter(name, declared, keyVal.Value, source); err != nil {
+			return nil, err
+		}
+		parsers.RecordParameterSource(entity, name, source)
 		keyValArr = append(keyValArr, keyVal)
 	}
-	return keyValArr
+	return keyValArr, nil
 }
 
-func (reader *DeploymentReader) getListOfAnnotations(inputs map[string]interface{}) whisk.KeyValueArr {
+func (reader *DeploymentReader) getListOfAnnotations(inputs map[string]interface{}) (whisk.KeyValueArr, error) {
+	var err error
 	keyValArr := make(whisk.KeyValueArr, 0)
+	ctx := reader.interpolationContext()
 	for name, input := range inputs {
 		var keyVal whisk.KeyValue
 		keyVal.Key = name
-		keyVal.Value = wskenv.InterpolateStringWithEnvVar(input)
+		if keyVal.Value, err = wskenv.Interpolate(input, ctx); err != nil {
+			return nil, err
+		}
 		keyValArr = append(keyValArr, keyVal)
 	}
-	return keyValArr
+	return keyValArr, nil
 }
 
 func (reader *DeploymentReader) bindPackageInputsAndAnnotations(paramsCLI interface{}) error {
@@ -154,7 +234,11 @@ func (reader *DeploymentReader) bindPackageInputsAndAnnotations(paramsCLI interf
 
 		if len(pack.Inputs) > 0 {
 
-			keyValArr := reader.getListOfParameters(pack.Inputs)
+			declared := serviceDeployPack.Inputs.Inputs
+			keyValArr, err := reader.getListOfParameters(parsers.ParameterEntity(parsers.YAML_KEY_PACKAGE, packName), pack.Inputs, declared)
+			if err != nil {
+				return err
+			}
 
 			depParams := make(map[string]whisk.KeyValue)
 			for _, kv := range keyValArr {
@@ -167,18 +251,9 @@ func (reader *DeploymentReader) bindPackageInputsAndAnnotations(paramsCLI interf
 				}
 			}
 
-			packageInputs := make(whisk.KeyValueArr, 0)
-
-			if paramsCLI != nil {
-				for _, kv := range keyValArr {
-					// check if this particular input is specified on CLI
-					if v, ok := paramsCLI.(map[string]interface{})[kv.Key]; ok {
-						kv.Value = wskenv.ConvertSingleName(v.(string))
-					}
-					packageInputs = append(packageInputs, kv)
-				}
-			} else {
-				packageInputs = keyValArr
+			packageInputs, err := reader.bindCLIParameters(parsers.ParameterEntity(parsers.YAML_KEY_PACKAGE, packName), keyValArr, paramsCLI, declared)
+			if err != nil {
+				return err
 			}
 
 			serviceDeployPack.Package.Parameters = packageInputs
@@ -186,7 +261,10 @@ func (reader *DeploymentReader) bindPackageInputsAndAnnotations(paramsCLI interf
 
 		if len(pack.Annotations) > 0 {
 
-			keyValArr := reader.getListOfAnnotations(pack.Annotations)
+			keyValArr, err := reader.getListOfAnnotations(pack.Annotations)
+			if err != nil {
+				return err
+			}
 
 			// iterate over each annotation from deployment file
 			for _, keyVal := range serviceDeployPack.Package.Annotations {
@@ -218,9 +296,14 @@ func (reader *DeploymentReader) bindActionInputsAndAnnotations(paramsCLI interfa
 		for actionName, action := range pack.Actions {
 
 			keyValArr := make(whisk.KeyValueArr, 0)
+			var err error
 
 			if len(action.Inputs) > 0 {
-				keyValArr = reader.getListOfParameters(action.Inputs)
+				declared := reader.declaredInputs(packName, parsers.YAML_KEY_ACTION, actionName)
+				entity := parsers.ParameterEntity(parsers.YAML_KEY_ACTION, packName+"/"+actionName)
+				if keyValArr, err = reader.getListOfParameters(entity, action.Inputs, declared); err != nil {
+					return err
+				}
 
 				if wskAction, exists := serviceDeployPack.Actions[actionName]; exists {
 
@@ -237,18 +320,9 @@ func (reader *DeploymentReader) bindActionInputsAndAnnotations(paramsCLI interfa
 						}
 					}
 
-					actionInputs := make(whisk.KeyValueArr, 0)
-
-					if paramsCLI != nil {
-						for _, kv := range keyValArr {
-							// check if this particular input is specified on CLI
-							if v, ok := paramsCLI.(map[string]interface{})[kv.Key]; ok {
-								kv.Value = wskenv.ConvertSingleName(v.(string))
-							}
-							actionInputs = append(actionInputs, kv)
-						}
-					} else {
-						actionInputs = keyValArr
+					actionInputs, err := reader.bindCLIParameters(entity, keyValArr, paramsCLI, declared)
+					if err != nil {
+						return err
 					}
 
 					wskAction.Action.Parameters = actionInputs
@@ -259,7 +333,9 @@ func (reader *DeploymentReader) bindActionInputsAndAnnotations(paramsCLI interfa
 
 			if len(action.Annotations) > 0 {
 
-				keyValArr = reader.getListOfAnnotations(action.Annotations)
+				if keyValArr, err = reader.getListOfAnnotations(action.Annotations); err != nil {
+					return err
+				}
 
 				if wskAction, exists := serviceDeployPack.Actions[actionName]; exists {
 
@@ -286,7 +362,7 @@ func (reader *DeploymentReader) bindTriggerInputsAndAnnotations(paramsCLI interf
 	packMap := reader.getPackageMap()
 
 	// go through all packages in our local package map
-	for _, pack := range packMap {
+	for packName, pack := range packMap {
 		serviceDeployment := reader.serviceDeployer.Deployment
 
 		// for each Deployment file Trigger found in the current package
@@ -294,7 +370,11 @@ func (reader *DeploymentReader) bindTriggerInputsAndAnnotations(paramsCLI interf
 
 			// If the Deployment file trigger has Input values we will attempt to bind them
 			if len(trigger.Inputs) > 0 {
-				keyValArr := reader.getListOfParameters(trigger.Inputs)
+				declared := reader.declaredInputs(packName, parsers.YAML_KEY_TRIGGER, triggerName)
+				keyValArr, err := reader.getListOfParameters(parsers.ParameterEntity(parsers.YAML_KEY_TRIGGER, triggerName), trigger.Inputs, declared)
+				if err != nil {
+					return err
+				}
 
 				// See if a matching Trigger (name) exists in manifest
 				if wskTrigger, exists := serviceDeployment.Triggers[triggerName]; exists {
@@ -315,18 +395,9 @@ func (reader *DeploymentReader) bindTriggerInputsAndAnnotations(paramsCLI interf
 						}
 					}
 
-					triggerInputs := make(whisk.KeyValueArr, 0)
-
-					if paramsCLI != nil {
-						for _, kv := range keyValArr {
-							// check if this particular input is specified on CLI
-							if v, ok := paramsCLI.(map[string]interface{})[kv.Key]; ok {
-								kv.Value = wskenv.ConvertSingleName(v.(string))
-							}
-							triggerInputs = append(triggerInputs, kv)
-						}
-					} else {
-						triggerInputs = keyValArr
+					triggerInputs, err := reader.bindCLIParameters(parsers.ParameterEntity(parsers.YAML_KEY_TRIGGER, triggerName), keyValArr, paramsCLI, declared)
+					if err != nil {
+						return err
 					}
 
 					wskTrigger.Parameters = triggerInputs
@@ -337,7 +408,10 @@ func (reader *DeploymentReader) bindTriggerInputsAndAnnotations(paramsCLI interf
 
 			if len(trigger.Annotations) > 0 {
 
-				keyValArr := reader.getListOfAnnotations(trigger.Annotations)
+				keyValArr, err := reader.getListOfAnnotations(trigger.Annotations)
+				if err != nil {
+					return err
+				}
 
 				if wskTrigger, exists := serviceDeployment.Triggers[triggerName]; exists {
 
@@ -46,11 +46,15 @@ func NewManifestReader(serviceDeployer *ServiceDeployer) *ManifestReader {
 func (deployer *ManifestReader) ParseManifest() (*parsers.YAML, *parsers.YAMLParser, error) {
 	dep := deployer.serviceDeployer
 	manifestParser := parsers.NewYAMLParser()
+	manifestParser.IsUndeploy = deployer.IsUndeploy
 	manifest, err := manifestParser.ParseManifest(dep.ManifestPath)
 
 	if err != nil {
 		return manifest, manifestParser, err
 	}
+	if err := parsers.ApplyRuntimeMappings(manifest); err != nil {
+		return manifest, manifestParser, err
+	}
 	return manifest, manifestParser, nil
 }
 
@@ -103,12 +107,17 @@ func (reader *ManifestReader) HandleYaml(manifestParser *parsers.YAMLParser, man
 		return wskderrors.NewYAMLFileFormatError(manifestName, err)
 	}
 
+	feeds, err := manifestParser.ComposeFeedsFromAllPackages(manifest, reader.serviceDeployer.ManifestPath, inputs)
+	if err != nil {
+		return wskderrors.NewYAMLFileFormatError(manifestName, err)
+	}
+
 	rules, err := manifestParser.ComposeRulesFromAllPackages(manifest, managedAnnotations, inputs)
 	if err != nil {
 		return wskderrors.NewYAMLFileFormatError(manifestName, err)
 	}
 
-	apis, responses, err := manifestParser.ComposeApiRecordsFromAllPackages(reader.serviceDeployer.ClientConfig, manifest, actions, sequences)
+	apis, responses, err := manifestParser.ComposeApiRecordsFromAllPackages(reader.serviceDeployer.ClientConfig, manifest, actions, sequences, inputs)
 	if err != nil {
 		return wskderrors.NewYAMLFileFormatError(manifestName, err)
 	}
@@ -138,6 +147,11 @@ func (reader *ManifestReader) HandleYaml(manifestParser *parsers.YAMLParser, man
 		return wskderrors.NewYAMLFileFormatError(manifestName, err)
 	}
 
+	err = reader.SetFeeds(feeds)
+	if err != nil {
+		return wskderrors.NewYAMLFileFormatError(manifestName, err)
+	}
+
 	err = reader.SetRules(rules)
 	if err != nil {
 		return wskderrors.NewYAMLFileFormatError(manifestName, err)
@@ -279,6 +293,19 @@ func (reader *ManifestReader) SetTriggers(triggers []*whisk.Trigger) error {
 	return nil
 }
 
+func (reader *ManifestReader) SetFeeds(feeds []utils.FeedRecord) error {
+	dep := reader.serviceDeployer
+
+	dep.mt.Lock()
+	defer dep.mt.Unlock()
+
+	for _, feed := range feeds {
+		record := feed
+		dep.Deployment.Feeds[feed.Name] = &record
+	}
+	return nil
+}
+
 func (reader *ManifestReader) SetRules(rules []*whisk.Rule) error {
 	dep := reader.serviceDeployer
 
@@ -348,6 +375,12 @@ func (reader *ManifestReader) checkAction(action utils.ActionRecord) error {
 		return wskderrors.NewYAMLParserErr(reader.serviceDeployer.ManifestPath, err)
 	}
 
+	if action.Artifact != nil && action.Artifact.Size == 0 {
+		err := wski18n.T(wski18n.ID_ERR_ACTION_WITHOUT_SOURCE_X_action_X,
+			map[string]interface{}{wski18n.KEY_ACTION: action.Action.Name})
+		return wskderrors.NewYAMLParserErr(reader.serviceDeployer.ManifestPath, err)
+	}
+
 	if action.Action.Exec.Code != nil {
 		code := *action.Action.Exec.Code
 		if code == "" && action.Action.Exec.Kind != parsers.YAML_KEY_SEQUENCE {
@@ -21,6 +21,8 @@ package deployers
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
 	"testing"
 
 	"github.com/apache/openwhisk-client-go/whisk"
@@ -58,6 +60,17 @@ func init() {
 	utils.Flags.Trace = wskprint.DetectGoTestVerbose()
 }
 
+func TestMain(m *testing.M) {
+	// the catalog of runtimes is cached in a temporary directory, not in the user config directory
+	dir, err := ioutil.TempDir("", "runtimes")
+	if err == nil {
+		runtimes.CatalogCacheDir = dir
+	}
+	code := m.Run()
+	os.RemoveAll(dir)
+	os.Exit(code)
+}
+
 func buildServiceDeployer(manifestFile string) (*ServiceDeployer, error) {
 	deploymentFile := ""
 	var deployer = NewServiceDeployer()
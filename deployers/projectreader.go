@@ -46,15 +46,20 @@ func (deployer *ServiceDeployer) UpdatePackageInputs() error {
 
 	if paramsCLI != nil {
 		// iterate over each package to update its set of inputs with CLI
-		for _, pkg := range deployer.Deployment.Packages {
+		for pkgName, pkg := range deployer.Deployment.Packages {
 			// iterate over each input of type Parameter
 			for name, param := range pkg.Inputs.Inputs {
 				inputValue := param.Value
 				// check if this particular input is specified on CLI
 				if v, ok := paramsCLI.(map[string]interface{})[name]; ok {
 					inputValue = wskenv.InterpolateStringWithEnvVar(v)
+					if inputValue, err = parsers.CoerceParameterValue(name, &param, inputValue, deployer.ManifestPath, parsers.CLISource(name)); err != nil {
+						return err
+					}
+					parsers.RecordParameterSource(parsers.ParameterEntity(parsers.YAML_KEY_PACKAGE, pkgName), name, parsers.CLISource(name))
 				}
 				param.Value = inputValue
+				parsers.RegisterSecretValue(param, inputValue)
 				pkg.Inputs.Inputs[name] = param
 			}
 		}
@@ -24,6 +24,7 @@ import (
 	"net/http"
 	"path"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -39,15 +40,17 @@ import (
 )
 
 const (
-	CONFLICT_MESSAGE = "Concurrent modification to resource detected"
-	CONFLICT_CODE    = 153
-	DEFAULT_ATTEMPTS = 3
-	DEFAULT_INTERVAL = 1 * time.Second
+	CONFLICT_MESSAGE   = "Concurrent modification to resource detected"
+	CONFLICT_CODE      = 153
+	DEFAULT_ATTEMPTS   = 3
+	DEFAULT_INTERVAL   = 1 * time.Second
+	PREVIEW_FIRE_TIMES = 3
 )
 
 type DeploymentProject struct {
 	Packages          map[string]*DeploymentPackage
 	Triggers          map[string]*whisk.Trigger
+	Feeds             map[string]*utils.FeedRecord
 	Rules             map[string]*whisk.Rule
 	Apis              map[string]*whisk.ApiCreateRequest
 	ApiOptions        map[string]*whisk.ApiCreateRequestOptions
@@ -59,6 +62,7 @@ func NewDeploymentProject() *DeploymentProject {
 	var dep DeploymentProject
 	dep.Packages = make(map[string]*DeploymentPackage)
 	dep.Triggers = make(map[string]*whisk.Trigger)
+	dep.Feeds = make(map[string]*utils.FeedRecord)
 	dep.Rules = make(map[string]*whisk.Rule)
 	dep.Apis = make(map[string]*whisk.ApiCreateRequest)
 	dep.ApiOptions = make(map[string]*whisk.ApiCreateRequestOptions)
@@ -96,6 +100,7 @@ type ServiceDeployer struct {
 	mt                sync.RWMutex
 	Preview           bool
 	Report            bool
+	ExplainParams     bool
 	ManifestPath      string
 	ProjectPath       string
 	DeploymentPath    string
@@ -196,6 +201,7 @@ func (deployer *ServiceDeployer) ConstructDeploymentPlan() error {
 
 	// process deployment file
 	var deploymentReader = NewDeploymentReader(deployer)
+	deploymentReader.ManifestDescriptor = manifest
 	if utils.FileExists(deployer.DeploymentPath) {
 		err = deploymentReader.HandleYaml()
 		if err != nil {
@@ -238,7 +244,41 @@ func (deployer *ServiceDeployer) ConstructDeploymentPlan() error {
 		}
 	}
 
-	return err
+	// the json inputs are validated against their schema once all their values are bound
+	return deployer.validateInputSchemas(manifest)
+}
+
+// validateInputSchemas validates the json inputs of packages, actions and triggers
+// declared with a schema in the manifest
+func (deployer *ServiceDeployer) validateInputSchemas(manifest *parsers.YAML) error {
+	packages := manifest.Packages
+	if len(packages) == 0 {
+		packages = manifest.GetProject().Packages
+	}
+	for packName, pack := range packages {
+		deploymentPack, ok := deployer.Deployment.Packages[packName]
+		if !ok || deploymentPack.Package == nil {
+			continue
+		}
+		if err := parsers.ValidateInputSchemas(manifest.Filepath, pack.Inputs, deploymentPack.Package.Parameters); err != nil {
+			return err
+		}
+		for actionName, action := range pack.Actions {
+			if record, ok := deploymentPack.Actions[actionName]; ok {
+				if err := parsers.ValidateInputSchemas(manifest.Filepath, action.Inputs, record.Action.Parameters); err != nil {
+					return err
+				}
+			}
+		}
+		for triggerName, trigger := range pack.Triggers {
+			if wskTrigger, ok := deployer.Deployment.Triggers[triggerName]; ok {
+				if err := parsers.ValidateInputSchemas(manifest.Filepath, trigger.Inputs, wskTrigger.Parameters); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
 }
 
 func (deployer *ServiceDeployer) ConstructUnDeploymentPlan() (*DeploymentProject, error) {
@@ -267,6 +307,7 @@ func (deployer *ServiceDeployer) ConstructUnDeploymentPlan() (*DeploymentProject
 	// process deployment file
 	if utils.FileExists(deployer.DeploymentPath) {
 		var deploymentReader = NewDeploymentReader(deployer)
+		deploymentReader.ManifestDescriptor = manifest
 		err = deploymentReader.HandleYaml()
 		if err != nil {
 			return deployer.Deployment, err
@@ -301,7 +342,13 @@ func (deployer *ServiceDeployer) ConstructUnDeploymentPlan() (*DeploymentProject
 // TODO(TBD): according to some planning?
 func (deployer *ServiceDeployer) Deploy() error {
 
+	if deployer.ExplainParams {
+		deployer.explainParameters()
+	}
+
 	if deployer.Preview {
+		// the actions over the code size limit are printed, as they would fail to deploy
+		deployer.checkCodeSize()
 		deployer.printDeploymentAssets(deployer.Deployment)
 		return nil
 	}
@@ -311,6 +358,11 @@ func (deployer *ServiceDeployer) Deploy() error {
 		return nil
 	}
 
+	if err := deployer.checkCodeSize(); err != nil {
+		wskprint.PrintOpenWhiskError(wski18n.T(wski18n.ID_MSG_DEPLOYMENT_FAILED))
+		return err
+	}
+
 	if err := deployer.deployAssets(); err != nil {
 		wskprint.PrintOpenWhiskError(wski18n.T(wski18n.ID_MSG_DEPLOYMENT_FAILED))
 		return err
@@ -751,13 +803,91 @@ func (deployer *ServiceDeployer) DeployPackages() error {
 // Deploy Sequences into OpenWhisk
 func (deployer *ServiceDeployer) DeploySequences() error {
 
+	planned := make(map[string]bool)
+	sequences := make(map[string]*DeploymentPackage)
+	var names []string
 	for _, pack := range deployer.Deployment.Packages {
-		for _, action := range pack.Sequences {
-			err := deployer.createAction(pack.Package.Name, action.Action)
-			if err != nil {
-				return err
+		for name := range pack.Actions {
+			planned[deployer.componentName(pack.Package.Name, name)] = true
+		}
+		for name := range pack.Sequences {
+			component := deployer.componentName(pack.Package.Name, name)
+			planned[component] = true
+			sequences[component] = pack
+			names = append(names, component)
+		}
+	}
+	sort.Strings(names)
+
+	// sequences are created after the sequences they include
+	created := make(map[string]bool)
+	var create func(component string) error
+	create = func(component string) error {
+		if created[component] {
+			return nil
+		}
+		created[component] = true
+		pack := sequences[component]
+		sequence := pack.Sequences[path.Base(component)]
+		for _, c := range sequence.Action.Exec.Components {
+			if _, ok := sequences[c]; ok {
+				if err := create(c); err != nil {
+					return err
+				}
 			}
 		}
+		if err := deployer.checkSequenceComponents(sequence.Action, planned); err != nil {
+			return err
+		}
+		return deployer.createAction(pack.Package.Name, sequence.Action, nil)
+	}
+
+	for _, component := range names {
+		if err := create(component); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// componentName returns the name of an action or sequence of the package as it appears in the components of a sequence
+func (deployer *ServiceDeployer) componentName(pkgname string, name string) string {
+	if strings.ToLower(pkgname) != parsers.DEFAULT_PACKAGE {
+		name = pkgname + parsers.PATH_SEPARATOR + name
+	}
+	return path.Join(parsers.PATH_SEPARATOR+deployer.ClientConfig.Namespace, name)
+}
+
+// checkSequenceComponents verifies that the components of a sequence which are not deployed with it,
+// such as absolute names or the actions of dependency bindings, exist in their namespace
+func (deployer *ServiceDeployer) checkSequenceComponents(sequence *whisk.Action, planned map[string]bool) error {
+	for _, component := range sequence.Exec.Components {
+		if planned[component] {
+			continue
+		}
+		qName, err := utils.ParseQualifiedName(component, deployer.ClientConfig.Namespace)
+		if err != nil {
+			return err
+		}
+
+		namespace := deployer.Client.Namespace
+		deployer.Client.Namespace = qName.Namespace
+		var response *http.Response
+		err = retry(DEFAULT_ATTEMPTS, DEFAULT_INTERVAL, func() error {
+			_, response, err = deployer.Client.Actions.Get(qName.EntityName, false)
+			return err
+		})
+		deployer.Client.Namespace = namespace
+
+		if err != nil {
+			errString := wski18n.T(wski18n.ID_ERR_SEQUENCE_COMPONENT_MISSING_X_sequence_X_action_X_namespace_X,
+				map[string]interface{}{
+					wski18n.KEY_SEQUENCE:  sequence.Name,
+					wski18n.KEY_ACTION:    component,
+					wski18n.KEY_NAMESPACE: qName.Namespace})
+			whisk.Debug(whisk.DbgError, errString)
+			return wskderrors.NewWhiskClientError(errString, err.(*whisk.WskError).ExitCode, response)
+		}
 	}
 	return nil
 }
@@ -767,7 +897,13 @@ func (deployer *ServiceDeployer) DeployActions() error {
 
 	for _, pack := range deployer.Deployment.Packages {
 		for _, action := range pack.Actions {
-			err := deployer.createAction(pack.Package.Name, action.Action)
+			if len(action.Digest) != 0 {
+				wskprint.PrintOpenWhiskVerbose(utils.Flags.Verbose, wski18n.T(wski18n.ID_VERBOSE_ACTION_DIGEST_X_action_X_digest_X,
+					map[string]interface{}{
+						wski18n.KEY_ACTION: pack.Package.Name + parsers.PATH_SEPARATOR + action.Action.Name,
+						wski18n.KEY_DIGEST: action.Digest}))
+			}
+			err := deployer.createAction(pack.Package.Name, action.Action, action.Artifact)
 			if err != nil {
 				return err
 			}
@@ -896,11 +1032,6 @@ func (deployer *ServiceDeployer) createFeedAction(trigger *whisk.Trigger, feedNa
 		params[keyVal.Key] = keyVal.Value
 	}
 
-	// TODO() define keys and lifecycle operation names as const
-	params["authKey"] = deployer.ClientConfig.AuthToken
-	params["lifecycleEvent"] = "CREATE"
-	params["triggerName"] = "/" + deployer.Client.Namespace + "/" + trigger.Name
-
 	pub := true
 	t := &whisk.Trigger{
 		Name:        trigger.Name,
@@ -913,12 +1044,19 @@ func (deployer *ServiceDeployer) createFeedAction(trigger *whisk.Trigger, feedNa
 	// wskdeploy is designed such that, it updates trigger feeds if they exists
 	// or creates new in case they are missing
 	// To address trigger feed UPDATE issue, we are checking here if trigger feed
-	// exists, if so, delete it and recreate it
+	// exists, if so, delete it and recreate it unless the feed declares an update operation
+	feed := deployer.Deployment.Feeds[feedName]
+	event := parsers.FEED_LIFECYCLE_CREATE
 	_, r, _ := deployer.Client.Triggers.Get(trigger.Name)
 	if r.StatusCode == 200 {
-		// trigger feed already exists so first lets delete it and then recreate it
-		deployer.deleteFeedAction(trigger, feedName)
+		if hasFeedOperation(feed, parsers.FEED_LIFECYCLE_UPDATE) {
+			event = parsers.FEED_LIFECYCLE_UPDATE
+		} else {
+			// trigger feed already exists so first lets delete it and then recreate it
+			deployer.deleteFeedAction(trigger, feedName, feed)
+		}
 	}
+	deployer.setFeedParameters(params, trigger, feed, event)
 
 	var err error
 	var response *http.Response
@@ -939,13 +1077,15 @@ func (deployer *ServiceDeployer) createFeedAction(trigger *whisk.Trigger, feedNa
 	deployer.Client.Namespace = namespace
 
 	if err != nil {
-		// Remove the created trigger
-		deployer.Client.Triggers.Delete(trigger.Name)
+		// Remove the created trigger, an updated trigger is left in place
+		if event == parsers.FEED_LIFECYCLE_CREATE {
+			deployer.Client.Triggers.Delete(trigger.Name)
 
-		retry(DEFAULT_ATTEMPTS, DEFAULT_INTERVAL, func() error {
-			_, _, err := deployer.Client.Triggers.Delete(trigger.Name)
-			return err
-		})
+			retry(DEFAULT_ATTEMPTS, DEFAULT_INTERVAL, func() error {
+				_, _, err := deployer.Client.Triggers.Delete(trigger.Name)
+				return err
+			})
+		}
 
 		return createWhiskClientError(err.(*whisk.WskError), response, wski18n.TRIGGER_FEED, false)
 	}
@@ -954,6 +1094,28 @@ func (deployer *ServiceDeployer) createFeedAction(trigger *whisk.Trigger, feedNa
 	return nil
 }
 
+// hasFeedOperation tells whether the feed declares an operation for the lifecycle event
+func hasFeedOperation(feed *utils.FeedRecord, event string) bool {
+	if feed == nil {
+		return false
+	}
+	_, ok := feed.Operations[event]
+	return ok
+}
+
+// setFeedParameters adds to the parameters of a feed action invocation the inputs
+// of the feed operation for the lifecycle event, followed by the parameters every feed action receives
+func (deployer *ServiceDeployer) setFeedParameters(params map[string]interface{}, trigger *whisk.Trigger, feed *utils.FeedRecord, event string) {
+	if feed != nil {
+		for _, keyVal := range feed.Operations[event] {
+			params[keyVal.Key] = keyVal.Value
+		}
+	}
+	params[parsers.FEED_PARAM_AUTH_KEY] = deployer.ClientConfig.AuthToken
+	params[parsers.FEED_PARAM_LIFECYCLE_EVENT] = event
+	params[parsers.FEED_PARAM_TRIGGER_NAME] = "/" + deployer.Client.Namespace + "/" + trigger.Name
+}
+
 func (deployer *ServiceDeployer) createRule(rule *whisk.Rule) error {
 	displayPreprocessingInfo(parsers.YAML_KEY_RULE, rule.Name, true)
 
@@ -987,8 +1149,9 @@ func (deployer *ServiceDeployer) createRule(rule *whisk.Rule) error {
 	return nil
 }
 
-// Utility function to call go-whisk framework to make action
-func (deployer *ServiceDeployer) createAction(pkgname string, action *whisk.Action) error {
+// Utility function to call go-whisk framework to make action,
+// the code of the action is read from its artifact, if any, only while it is sent
+func (deployer *ServiceDeployer) createAction(pkgname string, action *whisk.Action, artifact *utils.Artifact) error {
 	// call ActionService through the Client
 	if strings.ToLower(pkgname) != parsers.DEFAULT_PACKAGE {
 		// the action will be created under package with pattern 'packagename/actionname'
@@ -999,13 +1162,27 @@ func (deployer *ServiceDeployer) createAction(pkgname string, action *whisk.Acti
 
 	var err error
 	var response *http.Response
-	err = retry(DEFAULT_ATTEMPTS, DEFAULT_INTERVAL, func() error {
-		_, response, err = deployer.Client.Actions.Insert(action, true)
-		return err
-	})
+	insert := func() error {
+		return retry(DEFAULT_ATTEMPTS, DEFAULT_INTERVAL, func() error {
+			_, response, err = deployer.Client.Actions.Insert(action, true)
+			return err
+		})
+	}
+	if artifact != nil {
+		err = artifact.WithCode(func(code string) error {
+			action.Exec.Code = &code
+			defer func() { action.Exec.Code = nil }()
+			return insert()
+		})
+	} else {
+		err = insert()
+	}
 
-	if err != nil {
-		return createWhiskClientError(err.(*whisk.WskError), response, parsers.YAML_KEY_ACTION, true)
+	if wskErr, ok := err.(*whisk.WskError); ok {
+		return createWhiskClientError(wskErr, response, parsers.YAML_KEY_ACTION, true)
+	} else if err != nil {
+		// the artifact could not be read
+		return err
 	}
 
 	displayPostprocessingInfo(parsers.YAML_KEY_ACTION, action.Name, true)
@@ -1278,7 +1455,7 @@ func (deployer *ServiceDeployer) UnDeployTriggers(deployment *DeploymentProject)
 
 	for _, trigger := range deployment.Triggers {
 		if feedname, isFeed := utils.IsFeedAction(trigger); isFeed {
-			err := deployer.deleteFeedAction(trigger, feedname)
+			err := deployer.deleteFeedAction(trigger, feedname, deployment.Feeds[feedname])
 			if err != nil {
 				return err
 			}
@@ -1366,20 +1543,12 @@ func (deployer *ServiceDeployer) deleteTrigger(trigger *whisk.Trigger) error {
 	return nil
 }
 
-func (deployer *ServiceDeployer) deleteFeedAction(trigger *whisk.Trigger, feedName string) error {
+func (deployer *ServiceDeployer) deleteFeedAction(trigger *whisk.Trigger, feedName string, feed *utils.FeedRecord) error {
 
 	displayPreprocessingInfo(parsers.YAML_KEY_FEED, trigger.Name, false)
 
-	params := make(whisk.KeyValueArr, 0)
-	// TODO() define keys and operations as const
-	params = append(params, whisk.KeyValue{Key: "authKey", Value: deployer.ClientConfig.AuthToken})
-	params = append(params, whisk.KeyValue{Key: "lifecycleEvent", Value: "DELETE"})
-	params = append(params, whisk.KeyValue{Key: "triggerName", Value: "/" + deployer.Client.Namespace + "/" + trigger.Name})
-
 	parameters := make(map[string]interface{})
-	for _, keyVal := range params {
-		parameters[keyVal.Key] = keyVal.Value
-	}
+	deployer.setFeedParameters(parameters, trigger, feed, parsers.FEED_LIFECYCLE_DELETE)
 
 	qName, err := utils.ParseQualifiedName(feedName, deployer.ClientConfig.Namespace)
 	if err != nil {
@@ -1615,15 +1784,7 @@ func (deployer *ServiceDeployer) printDeploymentAssets(assets *DeploymentProject
 	wskprint.PrintlnOpenWhiskOutput(strings.Title(parsers.YAML_KEY_PACKAGES) + ":")
 	for _, pack := range assets.Packages {
 		wskprint.PrintlnOpenWhiskOutput(strings.Title(wski18n.KEY_NAME) + ": " + pack.Package.Name)
-		wskprint.PrintlnOpenWhiskOutput("    " + wski18n.KEY_BINDINGS + ": ")
-		for _, p := range pack.Package.Parameters {
-			jsonValue, err := utils.PrettyJSON(p.Value)
-			if err != nil {
-				fmt.Printf("        - %s : %s\n", p.Key, wskderrors.STR_UNKNOWN_VALUE)
-			} else {
-				fmt.Printf("        - %s : %v\n", p.Key, jsonValue)
-			}
-		}
+		printParameters(pack.Package.Parameters, pack.Package.Annotations)
 
 		wskprint.PrintlnOpenWhiskOutput("    " + parsers.YAML_KEY_ANNOTATION + ": ")
 		for _, p := range pack.Package.Annotations {
@@ -1643,41 +1804,32 @@ func (deployer *ServiceDeployer) printDeploymentAssets(assets *DeploymentProject
 
 		for _, action := range pack.Actions {
 			wskprint.PrintlnOpenWhiskOutput("  * " + parsers.YAML_KEY_ACTION + ": " + action.Action.Name)
-			wskprint.PrintlnOpenWhiskOutput("    " + wski18n.KEY_BINDINGS + ": ")
-			for _, p := range action.Action.Parameters {
-
-				if reflect.TypeOf(p.Value).Kind() == reflect.Map {
-					if _, ok := p.Value.(map[interface{}]interface{}); ok {
-						var temp map[string]interface{} = utils.ConvertInterfaceMap(p.Value.(map[interface{}]interface{}))
-						fmt.Printf("        - %s : %v\n", p.Key, temp)
-					} else {
-						jsonValue, err := utils.PrettyJSON(p.Value)
-						if err != nil {
-							fmt.Printf("        - %s : %s\n", p.Key, wskderrors.STR_UNKNOWN_VALUE)
-						} else {
-							fmt.Printf("        - %s : %v\n", p.Key, jsonValue)
-						}
-					}
-				} else {
-					jsonValue, err := utils.PrettyJSON(p.Value)
-					if err != nil {
-						fmt.Printf("        - %s : %s\n", p.Key, wskderrors.STR_UNKNOWN_VALUE)
-					} else {
-						fmt.Printf("        - %s : %v\n", p.Key, jsonValue)
-					}
-				}
-
-			}
+			printParameters(action.Action.Parameters, action.Action.Annotations)
 			wskprint.PrintlnOpenWhiskOutput("    " + parsers.YAML_KEY_ANNOTATION + ": ")
 			for _, p := range action.Action.Annotations {
 				fmt.Printf("        - %s : %v\n", p.Key, p.Value)
 
 			}
+			// effective values, including the ones inherited from package defaults
+			if action.Action.Exec != nil && len(action.Action.Exec.Kind) != 0 {
+				wskprint.PrintlnOpenWhiskOutput("    " + wski18n.KEY_RUNTIME + ": " + action.Action.Exec.Kind)
+			}
+			if limits := action.Action.Limits; limits != nil {
+				wskprint.PrintlnOpenWhiskOutput("    " + parsers.YAML_KEY_LIMITS + ": ")
+				printLimit(parsers.LIMIT_VALUE_TIMEOUT, limits.Timeout)
+				printLimit(parsers.LIMIT_VALUE_MEMORY_SIZE, limits.Memory)
+				printLimit(parsers.LIMIT_VALUE_LOG_SIZE, limits.Logsize)
+				printLimit(parsers.LIMIT_VALUE_CONCURRENCY, limits.Concurrency)
+			}
 		}
 
 		wskprint.PrintlnOpenWhiskOutput("")
 		for _, action := range pack.Sequences {
 			wskprint.PrintlnOpenWhiskOutput("  * " + parsers.YAML_KEY_SEQUENCE + ": " + action.Action.Name)
+			wskprint.PrintlnOpenWhiskOutput("    " + wski18n.COMPONENTS + ": ")
+			for _, component := range action.Action.Exec.Components {
+				fmt.Printf("        - %s\n", component)
+			}
 			wskprint.PrintlnOpenWhiskOutput("    " + parsers.YAML_KEY_ANNOTATION + ": ")
 			for _, p := range action.Action.Annotations {
 				fmt.Printf("        - %s : %v\n", p.Key, p.Value)
@@ -1691,22 +1843,14 @@ func (deployer *ServiceDeployer) printDeploymentAssets(assets *DeploymentProject
 	wskprint.PrintlnOpenWhiskOutput(wski18n.TRIGGERS + ":")
 	for _, trigger := range assets.Triggers {
 		wskprint.PrintlnOpenWhiskOutput("* " + parsers.YAML_KEY_TRIGGER + ": " + trigger.Name)
-		wskprint.PrintlnOpenWhiskOutput("    " + wski18n.KEY_BINDINGS + ": ")
-
-		for _, p := range trigger.Parameters {
-			jsonValue, err := utils.PrettyJSON(p.Value)
-			if err != nil {
-				fmt.Printf("        - %s : %s\n", p.Key, wskderrors.STR_UNKNOWN_VALUE)
-			} else {
-				fmt.Printf("        - %s : %v\n", p.Key, jsonValue)
-			}
-		}
+		printParameters(trigger.Parameters, trigger.Annotations)
 
 		wskprint.PrintlnOpenWhiskOutput("    " + parsers.YAML_KEY_ANNOTATION + ": ")
 		for _, p := range trigger.Annotations {
 			fmt.Printf("        - %s : %v\n", p.Key, p.Value)
 
 		}
+		printNextFireTimes(trigger)
 	}
 
 	wskprint.PrintlnOpenWhiskOutput("\n" + wski18n.RULES)
@@ -1733,6 +1877,203 @@ func (deployer *ServiceDeployer) printDeploymentAssets(assets *DeploymentProject
 
 }
 
+// printParameters prints the bindings of an entity, with the values of its secret inputs masked
+func printParameters(parameters whisk.KeyValueArr, annotations whisk.KeyValueArr) {
+	wskprint.PrintlnOpenWhiskOutput("    " + wski18n.KEY_BINDINGS + ": ")
+	for _, p := range utils.MaskSecretParameters(parameters, annotations) {
+		if m, ok := p.Value.(map[interface{}]interface{}); ok {
+			wskprint.PrintlnOpenWhiskOutput(fmt.Sprintf("        - %s : %v", p.Key, utils.ConvertInterfaceMap(m)))
+		} else if jsonValue, err := utils.PrettyJSON(p.Value); err != nil {
+			wskprint.PrintlnOpenWhiskOutput(fmt.Sprintf("        - %s : %s", p.Key, wskderrors.STR_UNKNOWN_VALUE))
+		} else {
+			wskprint.PrintlnOpenWhiskOutput(fmt.Sprintf("        - %s : %v", p.Key, jsonValue))
+		}
+	}
+}
+
+// checkCodeSize fails when the code of an action, as sent to the apihost, is larger than the code size limit,
+// printing the largest files and directories of the archives over the limit or close to it
+func (deployer *ServiceDeployer) checkCodeSize() error {
+	var oversized []string
+	limit := utils.CodeSizeLimit
+	packNames := make([]string, 0, len(deployer.Deployment.Packages))
+	for packName := range deployer.Deployment.Packages {
+		packNames = append(packNames, packName)
+	}
+	sort.Strings(packNames)
+	for _, packName := range packNames {
+		pack := deployer.Deployment.Packages[packName]
+		actionNames := make([]string, 0, len(pack.Actions))
+		for actionName := range pack.Actions {
+			actionNames = append(actionNames, actionName)
+		}
+		sort.Strings(actionNames)
+		for _, actionName := range actionNames {
+			record := pack.Actions[actionName]
+			var size int
+			if record.Artifact != nil {
+				size = int(record.Artifact.CodeSize())
+			} else if exec := record.Action.Exec; exec != nil && exec.Code != nil {
+				size = len(*exec.Code)
+			} else {
+				continue
+			}
+			if size*100 < limit*utils.CODE_SIZE_WARNING_PERCENT {
+				continue
+			}
+			id := wski18n.ID_MSG_CODE_SIZE_CLOSE_X_action_X_size_X_limit_X
+			if size > limit {
+				id = wski18n.ID_MSG_CODE_SIZE_OVER_X_action_X_size_X_limit_X
+				oversized = append(oversized, packName+"/"+actionName)
+			}
+			wskprint.PrintlnOpenWhiskInfo(wski18n.T(id,
+				map[string]interface{}{
+					wski18n.KEY_ACTION: packName + "/" + actionName,
+					wski18n.KEY_SIZE:   utils.FormatSize(int64(size)),
+					wski18n.KEY_LIMIT:  utils.FormatSize(int64(limit)),
+				}))
+			if record.Artifact != nil && record.Artifact.Binary {
+				printLargestFiles(record.Artifact.Path)
+			}
+		}
+	}
+	if len(oversized) != 0 {
+		return wskderrors.NewYAMLFileFormatError(deployer.ManifestPath, wski18n.T(wski18n.ID_ERR_ACTION_CODE_SIZE_X_action_X_limit_X,
+			map[string]interface{}{
+				wski18n.KEY_ACTION: strings.Join(oversized, ", "),
+				wski18n.KEY_LIMIT:  utils.FormatSize(int64(limit)),
+			}))
+	}
+	return nil
+}
+
+// printLargestFiles prints the largest files and directories of an archive, nothing if it is not a zip file
+func printLargestFiles(archive string) {
+	files, dirs, err := utils.ZipSizes(archive)
+	if err != nil {
+		return
+	}
+	wskprint.PrintlnOpenWhiskOutput(wski18n.T(wski18n.ID_MSG_CODE_SIZE_LARGEST))
+	for _, sizes := range [][]utils.ZipSize{dirs, files} {
+		if len(sizes) > utils.CODE_SIZE_LARGEST_FILES {
+			sizes = sizes[:utils.CODE_SIZE_LARGEST_FILES]
+		}
+		for _, size := range sizes {
+			wskprint.PrintlnOpenWhiskOutput(fmt.Sprintf("    %10s  %s", utils.FormatSize(size.Size), size.Name))
+		}
+	}
+}
+
+// explainParameters prints the final value of the parameters of each entity and where it comes from
+func (deployer *ServiceDeployer) explainParameters() {
+	wskprint.PrintlnOpenWhiskOutput(wski18n.T(wski18n.ID_MSG_EXPLAIN_PARAMS))
+
+	packNames := make([]string, 0, len(deployer.Deployment.Packages))
+	for packName := range deployer.Deployment.Packages {
+		packNames = append(packNames, packName)
+	}
+	sort.Strings(packNames)
+	for _, packName := range packNames {
+		pack := deployer.Deployment.Packages[packName]
+		printParameterSources(parsers.ParameterEntity(parsers.YAML_KEY_PACKAGE, packName),
+			pack.Package.Parameters, pack.Package.Annotations)
+
+		depNames := make([]string, 0, len(pack.Dependencies))
+		for depName := range pack.Dependencies {
+			depNames = append(depNames, depName)
+		}
+		sort.Strings(depNames)
+		for _, depName := range depNames {
+			dep := pack.Dependencies[depName]
+			printParameterSources(parsers.ParameterEntity(parsers.YAML_KEY_DEPENDENCY, depName),
+				dep.Parameters, dep.Annotations)
+		}
+
+		actionNames := make([]string, 0, len(pack.Actions))
+		for actionName := range pack.Actions {
+			actionNames = append(actionNames, actionName)
+		}
+		sort.Strings(actionNames)
+		for _, actionName := range actionNames {
+			action := pack.Actions[actionName].Action
+			printParameterSources(parsers.ParameterEntity(parsers.YAML_KEY_ACTION, packName+"/"+actionName),
+				action.Parameters, action.Annotations)
+		}
+	}
+
+	triggerNames := make([]string, 0, len(deployer.Deployment.Triggers))
+	for triggerName := range deployer.Deployment.Triggers {
+		triggerNames = append(triggerNames, triggerName)
+	}
+	sort.Strings(triggerNames)
+	for _, triggerName := range triggerNames {
+		trigger := deployer.Deployment.Triggers[triggerName]
+		printParameterSources(parsers.ParameterEntity(parsers.YAML_KEY_TRIGGER, triggerName),
+			trigger.Parameters, trigger.Annotations)
+	}
+}
+
+func printParameterSources(entity string, parameters whisk.KeyValueArr, annotations whisk.KeyValueArr) {
+	if len(parameters) == 0 {
+		return
+	}
+	wskprint.PrintlnOpenWhiskOutput("  * " + entity)
+	for _, p := range utils.MaskSecretParameters(parameters, annotations) {
+		source, ok := parsers.GetParameterSource(entity, p.Key)
+		if !ok {
+			source = parsers.PARAM_SOURCE_GENERATED
+		}
+		value, err := utils.PrettyJSON(p.Value)
+		if err != nil {
+			value = wskderrors.STR_UNKNOWN_VALUE
+		}
+		wskprint.PrintlnOpenWhiskOutput(fmt.Sprintf("        - %s : %s <- %s", p.Key, value, source))
+	}
+}
+
+func printLimit(name string, value *int) {
+	if value != nil {
+		fmt.Printf("        - %s : %d\n", name, *value)
+	}
+}
+
+// printNextFireTimes prints when a trigger with the cron parameter of the alarm feed fires next
+func printNextFireTimes(trigger *whisk.Trigger) {
+	cron, ok := trigger.Parameters.GetValue(parsers.SCHEDULE_PARAM_CRON).(string)
+	if !ok {
+		return
+	}
+	location := time.UTC
+	if timezone, ok := trigger.Parameters.GetValue(parsers.SCHEDULE_PARAM_TIMEZONE).(string); ok {
+		if l, err := time.LoadLocation(timezone); err == nil {
+			location = l
+		}
+	}
+	schedule, err := utils.ParseCron(cron, location)
+	if err != nil {
+		return
+	}
+
+	from := time.Now()
+	if start, ok := trigger.Parameters.GetValue(parsers.SCHEDULE_PARAM_START_DATE).(string); ok {
+		if t, ok := parsers.ParseScheduleDate(start, location); ok && t.After(from) {
+			from = t
+		}
+	}
+	stop, hasStop := time.Time{}, false
+	if value, ok := trigger.Parameters.GetValue(parsers.SCHEDULE_PARAM_STOP_DATE).(string); ok {
+		stop, hasStop = parsers.ParseScheduleDate(value, location)
+	}
+
+	wskprint.PrintlnOpenWhiskOutput("    " + wski18n.NEXT_FIRE_TIMES + ": ")
+	for _, t := range schedule.NextTimes(from, PREVIEW_FIRE_TIMES) {
+		if hasStop && t.After(stop) {
+			break
+		}
+		fmt.Printf("        - %s\n", t.Format(time.RFC3339))
+	}
+}
+
 func (deployer *ServiceDeployer) getDependentDeployer(depName string, depRecord dependencies.DependencyRecord) (*ServiceDeployer, error) {
 	depServiceDeployer := NewServiceDeployer()
 	projectPath := path.Join(depRecord.ProjectPath, depName+"-"+depRecord.Version)
@@ -1808,7 +2149,7 @@ func (deployer *ServiceDeployer) reportInputs() error {
 	// display project level inputs
 	i := make(map[string]interface{}, 0)
 	for name, param := range deployer.ProjectInputs {
-		i[name] = param.Value
+		i[name] = reportValue(param)
 	}
 	projectInputs := parsers.DisplayInputs{Name: deployer.ProjectName, Inputs: i}
 	j, err := json.MarshalIndent(projectInputs, "", " ")
@@ -1823,7 +2164,7 @@ func (deployer *ServiceDeployer) reportInputs() error {
 		i := make(map[string]interface{}, 0)
 		for name, param := range pkg.Inputs.Inputs {
 			if _, ok := deployer.ProjectInputs[name]; !ok {
-				i[name] = param.Value
+				i[name] = reportValue(param)
 			}
 		}
 		packageInputs := parsers.DisplayInputs{Name: pkg.Package.Name, Inputs: i}
@@ -1835,7 +2176,7 @@ func (deployer *ServiceDeployer) reportInputs() error {
 
 		for _, d := range pkg.Dependencies {
 			i := make(map[string]interface{}, 0)
-			for _, param := range d.Parameters {
+			for _, param := range utils.MaskSecretParameters(d.Parameters, d.Annotations) {
 				i[param.Key] = param.Value
 			}
 			depInputs := parsers.DisplayInputs{Name: d.Location, Inputs: i}
@@ -1848,7 +2189,7 @@ func (deployer *ServiceDeployer) reportInputs() error {
 
 		for _, a := range pkg.Actions {
 			i := make(map[string]interface{}, 0)
-			for _, param := range a.Action.Parameters {
+			for _, param := range utils.MaskSecretParameters(a.Action.Parameters, a.Action.Annotations) {
 				i[param.Key] = param.Value
 			}
 
@@ -1876,7 +2217,7 @@ func (deployer *ServiceDeployer) reportInputs() error {
 
 	for _, trigger := range deployer.Deployment.Triggers {
 		i := make(map[string]interface{}, 0)
-		for _, param := range trigger.Parameters {
+		for _, param := range utils.MaskSecretParameters(trigger.Parameters, trigger.Annotations) {
 			i[param.Key] = param.Value
 		}
 		triggerInputs := parsers.DisplayInputs{Name: trigger.Name, Inputs: i}
@@ -1888,3 +2229,11 @@ func (deployer *ServiceDeployer) reportInputs() error {
 	}
 	return nil
 }
+
+// reportValue returns the value of the input to report, masked if secret
+func reportValue(param parsers.Parameter) interface{} {
+	if param.Secret.Enabled {
+		return wskprint.SECRET_MASK
+	}
+	return param.Value
+}
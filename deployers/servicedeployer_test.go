@@ -0,0 +1,97 @@
+// +build unit
+
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package deployers
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/sciabarracom/openwhisk-wskdeploy/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func actionRecord(code string) utils.ActionRecord {
+	return utils.ActionRecord{Action: &whisk.Action{Exec: &whisk.Exec{Kind: "nodejs:default", Code: &code}}}
+}
+
+func TestServiceDeployer_checkCodeSize(t *testing.T) {
+	defer func(limit int) { utils.CodeSizeLimit = limit }(utils.CodeSizeLimit)
+	utils.CodeSizeLimit = 1000
+
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+	wr, err := writer.CreateHeader(&zip.FileHeader{Name: "node_modules/dep/index.js", Method: zip.Store})
+	assert.Nil(t, err)
+	wr.Write(bytes.Repeat([]byte("x"), 800))
+	assert.Nil(t, writer.Close())
+	artifact, err := utils.NewTempArtifact(true, func(w io.Writer) error {
+		_, err := w.Write(buf.Bytes())
+		return err
+	})
+	assert.Nil(t, err)
+	t.Cleanup(utils.RemoveTempArtifacts)
+
+	deployer := NewServiceDeployer()
+	deployer.ManifestPath = "manifest.yaml"
+	pack := NewDeploymentPackage()
+	pack.Actions["small"] = actionRecord("function main() {}")
+	pack.Actions["close"] = actionRecord(strings.Repeat("x", 950))
+	deployer.Deployment.Packages["demo"] = pack
+	assert.Nil(t, deployer.checkCodeSize(), "actions close to the limit are deployed")
+
+	// the base64 encoded archive is over the limit, while its content is not
+	pack.Actions["zipped"] = utils.ActionRecord{Action: &whisk.Action{Exec: &whisk.Exec{Kind: "nodejs:default"}}, Artifact: artifact}
+	pack.Actions["large"] = actionRecord(strings.Repeat("x", 1001))
+	err = deployer.checkCodeSize()
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "[demo/large, demo/zipped]")
+
+	// sequences and docker actions without code are not checked
+	pack.Actions["large"] = utils.ActionRecord{Action: &whisk.Action{Exec: &whisk.Exec{Kind: "sequence"}}}
+	delete(pack.Actions, "zipped")
+	assert.Nil(t, deployer.checkCodeSize())
+}
+
+func TestServiceDeployer_ConstructUnDeploymentPlan_WithoutBuild(t *testing.T) {
+	dir := t.TempDir()
+	marker := filepath.Join(dir, "built")
+	manifest := "packages:\n  build:\n    actions:\n      hello:\n        runtime: nodejs:12\n" +
+		"        build:\n          command: touch " + marker + " && exit 1\n          output: hello.js\n"
+	manifestPath := filepath.Join(dir, "manifest.yaml")
+	assert.Nil(t, ioutil.WriteFile(manifestPath, []byte(manifest), 0644))
+
+	deployer, err := buildServiceDeployer(manifestPath)
+	assert.Nil(t, err)
+	plan, err := deployer.ConstructUnDeploymentPlan()
+	assert.Nil(t, err, "the actions are undeployed without building them")
+	_, err = os.Stat(marker)
+	assert.True(t, os.IsNotExist(err), "the build command must not run")
+	action, ok := plan.Packages["build"].Actions["hello"]
+	assert.True(t, ok)
+	assert.Equal(t, "nodejs:12", action.Action.Exec.Kind)
+	assert.Nil(t, action.Artifact)
+}
@@ -242,7 +242,7 @@ func NewWhiskConfig(proppath string, deploymentPath string, manifestPath string)
 	}
 
 	mode := true
-	if len(cert.Value) != 0 && len(key.Value) != 0 {
+	if len(cert.Value) != 0 && len(key.Value) != 0 && !utils.Flags.Insecure {
 		mode = false
 	}
 
@@ -18,6 +18,9 @@
 package main
 
 import (
+	// the timezones of schedules are known on systems without a timezone database
+	_ "time/tzdata"
+
 	"github.com/sciabarracom/openwhisk-wskdeploy/cmd"
 	"github.com/sciabarracom/openwhisk-wskdeploy/utils"
 )
@@ -0,0 +1,189 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parsers
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/sciabarracom/openwhisk-wskdeploy/wskderrors"
+	"github.com/sciabarracom/openwhisk-wskdeploy/wski18n"
+	"github.com/sciabarracom/openwhisk-wskdeploy/wskprint"
+)
+
+// placeholders of the name of the actions declared with actions-from
+const (
+	ACTIONS_FROM_BASENAME = "{{basename}}" // file name without extension
+	ACTIONS_FROM_FILENAME = "{{filename}}" // file name with extension
+	ACTIONS_FROM_DIRNAME  = "{{dirname}}"  // name of the parent directory
+)
+
+// ActionsFrom declares an action for each file or directory matching the glob Path,
+// relative to the manifest file; directories are deployed as zip actions.
+// The inline Action keys apply to every action, the name being a template of placeholders
+// and Overrides are merged over the actions they name.
+type ActionsFrom struct {
+	Path      string            `yaml:"path"`
+	Overrides map[string]Action `yaml:"overrides,omitempty"`
+	Action    `yaml:",inline"`
+}
+
+// ActionsFromList accepts either a single actions-from entry or a list of them
+type ActionsFromList []ActionsFrom
+
+func (list *ActionsFromList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var entries []ActionsFrom
+	if err := unmarshal(&entries); err == nil {
+		*list = entries
+		return nil
+	}
+	var entry ActionsFrom
+	if err := unmarshal(&entry); err != nil {
+		return err
+	}
+	*list = ActionsFromList{entry}
+	return nil
+}
+
+// expandActionsFrom adds the actions declared with actions-from to the packages of the manifest
+func expandActionsFrom(manifest *YAML, manifestPath string) error {
+	var err error
+	for name, pkg := range manifest.Packages {
+		if manifest.Packages[name], err = pkg.expandActionsFrom(manifestPath); err != nil {
+			return err
+		}
+	}
+	for name, pkg := range manifest.Project.Packages {
+		if manifest.Project.Packages[name], err = pkg.expandActionsFrom(manifestPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (pkg Package) expandActionsFrom(manifestPath string) (Package, error) {
+	if len(pkg.ActionsFrom) == 0 {
+		return pkg, nil
+	}
+	manifestDir := filepath.Dir(manifestPath)
+	actions := make(map[string]Action, len(pkg.Actions))
+	for name, action := range pkg.Actions {
+		actions[name] = action
+	}
+
+	for _, entry := range pkg.ActionsFrom {
+		matches, err := filepath.Glob(filepath.Join(manifestDir, entry.Path))
+		if err != nil {
+			errMessage := wski18n.T(wski18n.ID_ERR_ACTIONS_FROM_PATH_X_path_X_err_X,
+				map[string]interface{}{
+					wski18n.KEY_PATH: entry.Path,
+					wski18n.KEY_ERR:  err.Error()})
+			return pkg, wskderrors.NewYAMLFileFormatError(manifestPath, errMessage)
+		}
+
+		generated := make(map[string]bool, len(matches))
+		for _, match := range matches {
+			if strings.HasPrefix(filepath.Base(match), ".") {
+				continue
+			}
+			name := actionsFromName(entry.Name, match)
+			if _, ok := actions[name]; ok {
+				errMessage := wski18n.T(wski18n.ID_ERR_ACTIONS_FROM_NAME_COLLISION_X_action_X_path_X,
+					map[string]interface{}{
+						wski18n.KEY_ACTION: name,
+						wski18n.KEY_PATH:   match})
+				return pkg, wskderrors.NewYAMLFileFormatError(manifestPath, errMessage)
+			}
+			function, err := filepath.Rel(manifestDir, match)
+			if err != nil {
+				function = match
+			}
+
+			action := mergeAction(entry.Action, Action{})
+			action.Name = ""
+			action.Function = filepath.ToSlash(function)
+			if override, ok := entry.Overrides[name]; ok {
+				action = mergeAction(action, override)
+			}
+			actions[name] = action
+			generated[name] = true
+		}
+
+		if len(generated) == 0 {
+			warningString := wski18n.T(wski18n.ID_WARN_ACTIONS_FROM_NO_MATCH_X_path_X,
+				map[string]interface{}{wski18n.KEY_PATH: entry.Path})
+			wskprint.PrintlnOpenWhiskWarning(warningString)
+		}
+		for name := range entry.Overrides {
+			if !generated[name] {
+				warningString := wski18n.T(wski18n.ID_WARN_ACTIONS_FROM_OVERRIDE_X_action_X_path_X,
+					map[string]interface{}{
+						wski18n.KEY_ACTION: name,
+						wski18n.KEY_PATH:   entry.Path})
+				wskprint.PrintlnOpenWhiskWarning(warningString)
+			}
+		}
+	}
+
+	pkg.Actions = actions
+	return pkg, nil
+}
+
+// actionsFromName replaces the placeholders of the name template, "{{basename}}" by default
+func actionsFromName(template string, match string) string {
+	if len(template) == 0 {
+		template = ACTIONS_FROM_BASENAME
+	}
+	filename := filepath.Base(match)
+	basename := filename
+	if info, err := os.Stat(match); err == nil && !info.IsDir() {
+		basename = strings.TrimSuffix(filename, filepath.Ext(filename))
+	}
+	return strings.NewReplacer(
+		ACTIONS_FROM_BASENAME, basename,
+		ACTIONS_FROM_FILENAME, filename,
+		ACTIONS_FROM_DIRNAME, filepath.Base(filepath.Dir(match)),
+	).Replace(template)
+}
+
+// mergeAction returns the action with the keys set by override replaced,
+// maps such as inputs and annotations are merged key by key
+func mergeAction(action Action, override Action) Action {
+	merged := reflect.ValueOf(&action).Elem()
+	values := reflect.ValueOf(override)
+	for i := 0; i < values.NumField(); i++ {
+		value := values.Field(i)
+		field := merged.Field(i)
+		if value.Kind() == reflect.Map {
+			copied := reflect.MakeMap(field.Type())
+			for _, m := range []reflect.Value{field, value} {
+				for _, key := range m.MapKeys() {
+					copied.SetMapIndex(key, m.MapIndex(key))
+				}
+			}
+			if copied.Len() != 0 {
+				field.Set(copied)
+			}
+		} else if !value.IsZero() {
+			field.Set(value)
+		}
+	}
+	return action
+}
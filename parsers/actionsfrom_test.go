@@ -0,0 +1,70 @@
+// +build unit
+
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parsers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+func TestActionsFromName(t *testing.T) {
+	file := "../tests/dat/actionsfrom/greet.js"
+	assert.Equal(t, "greet", actionsFromName("", file))
+	assert.Equal(t, "greet.js", actionsFromName(ACTIONS_FROM_FILENAME, file))
+	assert.Equal(t, "actionsfrom-greet", actionsFromName("{{dirname}}-{{basename}}", file))
+
+	// directories keep their whole name
+	assert.Equal(t, "bundle", actionsFromName("", "../tests/dat/actionsfrom/bundle"))
+}
+
+func TestMergeAction(t *testing.T) {
+	action := Action{
+		Runtime:     "nodejs:default",
+		Main:        "main",
+		Inputs:      map[string]Parameter{"name": {Value: "Amy"}},
+		Annotations: map[string]interface{}{"final": true},
+	}
+	merged := mergeAction(action, Action{Main: "shout", Inputs: map[string]Parameter{"place": {Value: "Paris"}}})
+	assert.Equal(t, "nodejs:default", merged.Runtime)
+	assert.Equal(t, "shout", merged.Main)
+	assert.Equal(t, 2, len(merged.Inputs))
+	assert.Equal(t, true, merged.Annotations["final"])
+
+	// the merged maps are copies
+	assert.Equal(t, 1, len(action.Inputs))
+}
+
+func TestActionsFromListUnmarshal(t *testing.T) {
+	var list ActionsFromList
+	err := yaml.UnmarshalStrict([]byte("path: src/*.js\nruntime: nodejs:default\n"), &list)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(list))
+	assert.Equal(t, "nodejs:default", list[0].Runtime)
+
+	err = yaml.UnmarshalStrict([]byte("- path: src/*.js\n- path: lib/*\n  name: \"{{filename}}\"\n"), &list)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(list))
+	assert.Equal(t, ACTIONS_FROM_FILENAME, list[1].Name)
+
+	err = yaml.UnmarshalStrict([]byte("path: src/*.js\nunknown: true\n"), &list)
+	assert.NotNil(t, err)
+}
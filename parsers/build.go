@@ -0,0 +1,87 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parsers
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/sciabarracom/openwhisk-wskdeploy/builder"
+	"github.com/sciabarracom/openwhisk-wskdeploy/runtimes"
+	"github.com/sciabarracom/openwhisk-wskdeploy/wskderrors"
+)
+
+// buildActions runs the builds of the actions of all the packages of the manifest before their exec is composed
+func (dm *YAMLParser) buildActions(manifest *YAML, manifestFilePath string) error {
+	manifestPackages := manifest.Packages
+	if len(manifestPackages) == 0 {
+		manifestPackages = manifest.GetProject().Packages
+	}
+	packageNames := make([]string, 0, len(manifestPackages))
+	for packageName := range manifestPackages {
+		packageNames = append(packageNames, packageName)
+	}
+	sort.Strings(packageNames)
+
+	var jobs []*builder.Job
+	for _, packageName := range packageNames {
+		pkg := manifestPackages[packageName]
+		defaults := manifest.PackageDefaults(pkg)
+		for actionName, action := range pkg.Actions {
+			action = action.withDefaults(defaults)
+			if action.Build == nil {
+				continue
+			}
+			job, err := action.Build.Job(packageName+"/"+actionName, filepath.Dir(manifestFilePath))
+			if err != nil {
+				return wskderrors.NewYAMLFileFormatError(manifestFilePath, err.Error())
+			}
+			jobs = append(jobs, job)
+		}
+	}
+	return builder.Run(jobs)
+}
+
+// buildFunction returns the output of the build of the action relative to the manifest, which is its function
+func buildFunction(manifestFilePath string, action Action) (string, error) {
+	job, err := action.Build.Job(action.Name, filepath.Dir(manifestFilePath))
+	if err != nil {
+		return "", err
+	}
+	manifestDir, err := filepath.Abs(filepath.Dir(manifestFilePath))
+	if err != nil {
+		return "", err
+	}
+	output, err := filepath.Abs(job.Output)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Rel(manifestDir, output)
+}
+
+// undeployBuildExec returns the exec of an action built before it is deployed, without its code,
+// with the kind of its runtime or else of the extension of the output of its build
+func undeployBuildExec(action Action) *whisk.Exec {
+	kind := action.Runtime
+	if len(kind) == 0 {
+		kind = runtimes.ExtensionKind(strings.TrimPrefix(filepath.Ext(action.Function), "."))
+	}
+	return &whisk.Exec{Kind: kind}
+}
@@ -0,0 +1,80 @@
+// +build unit
+
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parsers
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/sciabarracom/openwhisk-wskdeploy/builder"
+	"github.com/stretchr/testify/assert"
+)
+
+const buildManifest = `project:
+  name: build
+  defaults:
+    build:
+      command: printf 'function main(params) { return params }' > $WSKDEPLOY_OUTPUT
+  packages:
+    build:
+      actions:
+        hello:
+          runtime: nodejs:12
+          build:
+            dir: src
+            output: hello.js
+        plain:
+          function: src/plain.js
+`
+
+func TestComposeActionsWithBuild(t *testing.T) {
+	dir := t.TempDir()
+	builder.CacheDir = filepath.Join(dir, "cache")
+	defer func() { builder.CacheDir = "" }()
+	manifestPath := filepath.Join(dir, "manifest.yaml")
+	assert.Nil(t, ioutil.WriteFile(manifestPath, []byte(buildManifest), 0644))
+	assert.Nil(t, os.Mkdir(filepath.Join(dir, "src"), 0755))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "src", "plain.js"), []byte("function main() {}"), 0644))
+
+	p, m, _ := testLoadParseManifest(t, manifestPath)
+	actions, err := p.ComposeActionsFromAllPackages(m, m.Filepath, whisk.KeyValue{}, map[string]PackageInputs{})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(actions))
+	for _, action := range actions {
+		if action.Action.Name == "hello" {
+			code, err := action.Artifact.ReadCode()
+			assert.Nil(t, err)
+			assert.Equal(t, "function main(params) { return params }", code)
+			assert.Equal(t, "nodejs:12", action.Action.Exec.Kind)
+		}
+	}
+}
+
+func TestComposeActionsWithBuild_MissingOutput(t *testing.T) {
+	m := &YAML{Filepath: "manifest.yaml"}
+	m.Packages = map[string]Package{"build": {Actions: map[string]Action{
+		"hello": {Build: &builder.Build{Command: "true"}},
+	}}}
+	_, err := NewYAMLParser().ComposeActionsFromAllPackages(m, m.Filepath, whisk.KeyValue{}, map[string]PackageInputs{})
+	assert.NotNil(t, err, "a build without output must be an error")
+}
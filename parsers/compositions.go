@@ -0,0 +1,125 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parsers
+
+import (
+	"github.com/sciabarracom/openwhisk-wskdeploy/conductor"
+	"github.com/sciabarracom/openwhisk-wskdeploy/wskderrors"
+	"github.com/sciabarracom/openwhisk-wskdeploy/wski18n"
+)
+
+// the runtime of the conductor action of a composition which does not declare one
+const COMPOSITION_DEFAULT_RUNTIME = "nodejs:default"
+
+// expandCompositions turns the compositions of the manifest into conductor actions
+func expandCompositions(manifest *YAML, manifestPath string) error {
+	for _, packages := range []map[string]Package{manifest.Packages, manifest.Project.Packages} {
+		// all the compositions are actions before any flow is compiled, so that flows can invoke them
+		for name, pkg := range packages {
+			var err error
+			if packages[name], err = pkg.addCompositions(manifestPath); err != nil {
+				return err
+			}
+		}
+		for name, pkg := range packages {
+			if err := pkg.compileCompositions(name, manifestPath, packages); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// addCompositions adds to the package the conductor action of each composition, without its code
+func (pkg Package) addCompositions(manifestPath string) (Package, error) {
+	if len(pkg.Compositions) == 0 {
+		return pkg, nil
+	}
+	actions := make(map[string]Action, len(pkg.Actions)+len(pkg.Compositions))
+	for name, action := range pkg.Actions {
+		actions[name] = action
+	}
+	for name, composition := range pkg.Compositions {
+		if pkg.hasActionOrSequence(name) {
+			errMessage := wski18n.T(wski18n.ID_ERR_COMPOSITION_NAME_COLLISION_X_composition_X,
+				map[string]interface{}{wski18n.KEY_COMPOSITION: name})
+			return pkg, wskderrors.NewYAMLFileFormatError(manifestPath, errMessage)
+		}
+		runtime := composition.Runtime
+		if len(runtime) == 0 {
+			runtime = COMPOSITION_DEFAULT_RUNTIME
+		}
+		actions[name] = Action{
+			Runtime:     runtime,
+			Web:         composition.Web,
+			Limits:      composition.Limits,
+			Inputs:      composition.Inputs,
+			Description: composition.Description,
+			Annotations: composition.Annotations,
+			Conductor:   true,
+		}
+	}
+	pkg.Actions = actions
+	return pkg, nil
+}
+
+// compileCompositions sets the code of the conductor action of each composition of the package
+// to its flow, with the names of the actions it invokes resolved as the ones of sequences
+func (pkg Package) compileCompositions(packageName string, manifestPath string, manifestPackages map[string]Package) error {
+	for name, composition := range pkg.Compositions {
+		action := pkg.Actions[name]
+		compiler, ok := conductor.GetCompiler(action.Runtime)
+		if !ok {
+			errMessage := wski18n.T(wski18n.ID_ERR_COMPOSITION_RUNTIME_X_composition_X_runtime_X,
+				map[string]interface{}{
+					wski18n.KEY_COMPOSITION: name,
+					wski18n.KEY_RUNTIME:     action.Runtime})
+			return wskderrors.NewYAMLFileFormatError(manifestPath, errMessage)
+		}
+		flowError := func(err error) error {
+			errMessage := wski18n.T(wski18n.ID_ERR_COMPOSITION_X_composition_X_err_X,
+				map[string]interface{}{
+					wski18n.KEY_COMPOSITION: name,
+					wski18n.KEY_ERR:         err.Error()})
+			return wskderrors.NewYAMLFileFormatError(manifestPath, errMessage)
+		}
+		if err := composition.Flow.Validate(compiler); err != nil {
+			return flowError(err)
+		}
+
+		flow, err := composition.Flow.Resolve(func(component string) (string, error) {
+			resolved, ok := resolveSequenceStep(component, packageName, manifestPackages)
+			if !ok {
+				errMessage := wski18n.T(wski18n.ID_ERR_COMPOSITION_COMPONENT_UNRESOLVED_X_composition_X_action_X,
+					map[string]interface{}{
+						wski18n.KEY_COMPOSITION: name,
+						wski18n.KEY_ACTION:      component})
+				return "", wskderrors.NewYAMLFileFormatError(manifestPath, errMessage)
+			}
+			return resolved, nil
+		})
+		if err != nil {
+			return err
+		}
+		if action.Code, err = flow.Compile(compiler); err != nil {
+			return flowError(err)
+		}
+		pkg.Actions[name] = action
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+// +build unit
+
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parsers
+
+import (
+	"testing"
+
+	"github.com/sciabarracom/openwhisk-wskdeploy/conductor"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandCompositions(t *testing.T) {
+	manifest := YAML{Packages: map[string]Package{
+		"pipeline": {
+			Actions: map[string]Action{"greet": {}},
+			Compositions: map[string]Composition{
+				"first":  {Flow: conductor.Flow{Sequence: []conductor.Flow{{Action: "greet"}, {Action: "second"}}}},
+				"second": {Flow: conductor.Flow{Action: "other/third"}, Runtime: "nodejs:20"},
+			},
+		},
+		"other": {
+			Compositions: map[string]Composition{"third": {Flow: conductor.Flow{Action: "pipeline/first"}}},
+		},
+	}}
+	assert.Nil(t, expandCompositions(&manifest, "manifest.yaml"))
+
+	first := manifest.Packages["pipeline"].Actions["first"]
+	assert.True(t, first.Conductor)
+	assert.Equal(t, COMPOSITION_DEFAULT_RUNTIME, first.Runtime)
+	assert.Contains(t, first.Code, `"action":"pipeline/greet"`)
+	assert.Contains(t, first.Code, `"action":"pipeline/second"`)
+	assert.Equal(t, "nodejs:20", manifest.Packages["pipeline"].Actions["second"].Runtime)
+	assert.Contains(t, manifest.Packages["pipeline"].Actions["second"].Code, `"action":"other/third"`)
+	assert.Contains(t, manifest.Packages["other"].Actions["third"].Code, `"action":"pipeline/first"`)
+}
+
+func TestExpandCompositionsErrors(t *testing.T) {
+	for expected, pkg := range map[string]Package{
+		"[greet]": {
+			Actions:      map[string]Action{"greet": {}},
+			Compositions: map[string]Composition{"greet": {Flow: conductor.Flow{Action: "greet"}}},
+		},
+		"[python:3]": {
+			Compositions: map[string]Composition{"flow": {Flow: conductor.Flow{Action: "flow"}, Runtime: "python:3"}},
+		},
+		"[missing]": {
+			Compositions: map[string]Composition{"flow": {Flow: conductor.Flow{Action: "missing"}}},
+		},
+		"[catch]": {
+			Compositions: map[string]Composition{"flow": {Flow: conductor.Flow{Try: &conductor.Flow{Action: "flow"}}}},
+		},
+	} {
+		manifest := YAML{Packages: map[string]Package{"pipeline": pkg}}
+		err := expandCompositions(&manifest, "manifest.yaml")
+		assert.NotNil(t, err, "Expected an error for "+expected)
+		assert.Contains(t, err.Error(), expected)
+	}
+}
@@ -0,0 +1,387 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parsers
+
+import (
+	"reflect"
+	"sort"
+
+	"github.com/sciabarracom/openwhisk-wskdeploy/builder"
+	"github.com/sciabarracom/openwhisk-wskdeploy/utils"
+)
+
+// DEFAULTS_MIN_ACTIONS is the number of actions of a package that must share a value
+// before export factors it into the defaults of the package
+const DEFAULTS_MIN_ACTIONS = 3
+
+// Defaults are inherited by every action and sequence of a package unless they override them,
+// the defaults of a project are inherited by its packages.
+// Maps, i.e. annotations, are merged key by key, and so are the keys of limits and build,
+// the default build only applies to the actions with a build section.
+type Defaults struct {
+	Runtime     string                 `yaml:"runtime,omitempty"`
+	Web         string                 `yaml:"web,omitempty"`
+	Limits      *Limits                `yaml:"limits,omitempty"`
+	Annotations map[string]interface{} `yaml:"annotations,omitempty"`
+	Include     [][]string             `yaml:"include,omitempty"`
+	Exclude     []string               `yaml:"exclude,omitempty"`
+	Build       *builder.Build         `yaml:"build,omitempty"`
+}
+
+// merge returns the defaults overridden by the given ones, either can be nil
+func (defaults *Defaults) merge(override *Defaults) *Defaults {
+	if defaults == nil {
+		return override
+	}
+	if override == nil {
+		return defaults
+	}
+	merged := *defaults
+	if len(override.Runtime) != 0 {
+		merged.Runtime = override.Runtime
+	}
+	if len(override.Web) != 0 {
+		merged.Web = override.Web
+	}
+	merged.Limits = mergeLimits(defaults.Limits, override.Limits)
+	merged.Annotations = mergeAnnotations(defaults.Annotations, override.Annotations)
+	if override.Include != nil {
+		merged.Include = override.Include
+	}
+	if override.Exclude != nil {
+		merged.Exclude = override.Exclude
+	}
+	merged.Build = override.Build.Merge(defaults.Build)
+	return &merged
+}
+
+// PackageDefaults returns the defaults of a package merged over the ones of the project
+func (yaml *YAML) PackageDefaults(pkg Package) *Defaults {
+	return yaml.GetProject().Defaults.merge(pkg.Defaults)
+}
+
+// withDefaults returns the action with the values it does not set taken from the defaults,
+// the default runtime does not apply to docker and native actions
+func (action Action) withDefaults(defaults *Defaults) Action {
+	if defaults == nil {
+		return action
+	}
+	if len(action.Runtime) == 0 && len(action.Docker) == 0 && !action.Native {
+		action.Runtime = defaults.Runtime
+	}
+	if len(action.GetWeb()) == 0 {
+		action.Web = defaults.Web
+	}
+	action.Limits = mergeLimits(defaults.Limits, action.Limits)
+	action.Annotations = mergeAnnotations(defaults.Annotations, action.Annotations)
+	if action.Include == nil {
+		action.Include = defaults.Include
+	}
+	if action.Exclude == nil {
+		action.Exclude = defaults.Exclude
+	}
+	if action.Build != nil {
+		action.Build = action.Build.Merge(defaults.Build)
+	}
+	return action
+}
+
+// withDefaults returns the sequence with the web flag and annotations it does not set taken from the defaults
+func (sequence Sequence) withDefaults(defaults *Defaults) Sequence {
+	if defaults == nil {
+		return sequence
+	}
+	if len(sequence.Web) == 0 {
+		sequence.Web = defaults.Web
+	}
+	sequence.Annotations = mergeAnnotations(defaults.Annotations, sequence.Annotations)
+	return sequence
+}
+
+func mergeLimits(defaults *Limits, override *Limits) *Limits {
+	if defaults == nil {
+		return override
+	}
+	if override == nil {
+		merged := *defaults
+		return &merged
+	}
+	merged := *override
+	if merged.Timeout == nil {
+		merged.Timeout = defaults.Timeout
+	}
+	if merged.Memory == nil {
+		merged.Memory = defaults.Memory
+	}
+	if merged.Logsize == nil {
+		merged.Logsize = defaults.Logsize
+	}
+	if merged.Concurrency == nil {
+		merged.Concurrency = defaults.Concurrency
+	}
+	if merged.ConcurrentActivations == nil {
+		merged.ConcurrentActivations = defaults.ConcurrentActivations
+	}
+	if merged.UserInvocationRate == nil {
+		merged.UserInvocationRate = defaults.UserInvocationRate
+	}
+	if merged.CodeSize == nil {
+		merged.CodeSize = defaults.CodeSize
+	}
+	if merged.ParameterSize == nil {
+		merged.ParameterSize = defaults.ParameterSize
+	}
+	return &merged
+}
+
+func mergeAnnotations(defaults map[string]interface{}, override map[string]interface{}) map[string]interface{} {
+	if len(defaults) == 0 {
+		return override
+	}
+	merged := make(map[string]interface{}, len(defaults)+len(override))
+	for key, value := range defaults {
+		merged[key] = value
+	}
+	for key, value := range override {
+		merged[key] = mergeValue(merged[key], value)
+	}
+	return merged
+}
+
+// mergeValue merges nested maps key by key, any other value is overridden
+func mergeValue(defaults interface{}, override interface{}) interface{} {
+	defaultsMap, ok := defaults.(map[interface{}]interface{})
+	if !ok {
+		return override
+	}
+	overrideMap, ok := override.(map[interface{}]interface{})
+	if !ok {
+		return override
+	}
+	return mergeAnnotations(utils.ConvertInterfaceMap(defaultsMap), utils.ConvertInterfaceMap(overrideMap))
+}
+
+/*
+   FactorDefaults moves the values shared by at least minActions actions of a package
+   into the defaults of the package, the actions with a different value keep overriding it.
+
+   A limit or an annotation is only factored when every action, and for annotations every sequence,
+   of the package sets it, as the ones that do not would otherwise inherit it. A map-valued
+   annotation is not factored when an action or a sequence overrides it with another map,
+   as the two maps would be merged when the manifest is deployed.
+   Nothing is factored when minActions is zero.
+*/
+func (yaml *YAML) FactorDefaults(minActions int) {
+	if minActions <= 0 {
+		return
+	}
+	for name, pkg := range yaml.Packages {
+		if len(pkg.Actions) < minActions {
+			continue
+		}
+		defaults := new(Defaults)
+		if pkg.Defaults != nil {
+			*defaults = *pkg.Defaults
+		}
+		actionNames := make([]string, 0, len(pkg.Actions))
+		for actionName := range pkg.Actions {
+			actionNames = append(actionNames, actionName)
+		}
+		sort.Strings(actionNames)
+
+		factorRuntime(pkg, actionNames, defaults, minActions)
+		for _, limit := range LIMITS_SUPPORTED {
+			factorLimit(pkg, actionNames, defaults, limit, minActions)
+		}
+		for _, key := range annotationKeys(pkg.Actions) {
+			factorAnnotation(pkg, actionNames, defaults, key, minActions)
+		}
+
+		if !reflect.DeepEqual(*defaults, Defaults{}) {
+			pkg.Defaults = defaults
+		}
+		yaml.Packages[name] = pkg
+	}
+}
+
+// mostCommon returns the first of the values that occur most often
+func mostCommon(values []interface{}) (interface{}, int) {
+	var common interface{}
+	var max int
+	for _, value := range values {
+		count := 0
+		for _, other := range values {
+			if reflect.DeepEqual(value, other) {
+				count++
+			}
+		}
+		if count > max {
+			common, max = value, count
+		}
+	}
+	return common, max
+}
+
+// the runtime is not inherited by docker actions, which do not set it
+func factorRuntime(pkg Package, actionNames []string, defaults *Defaults, minActions int) {
+	if len(defaults.Runtime) != 0 {
+		return
+	}
+	var values []interface{}
+	for _, actionName := range actionNames {
+		if runtime := pkg.Actions[actionName].Runtime; len(runtime) != 0 {
+			values = append(values, runtime)
+		}
+	}
+	value, count := mostCommon(values)
+	if count < minActions {
+		return
+	}
+	defaults.Runtime = value.(string)
+	for _, actionName := range actionNames {
+		action := pkg.Actions[actionName]
+		if action.Runtime == defaults.Runtime {
+			action.Runtime = ""
+			pkg.Actions[actionName] = action
+		}
+	}
+}
+
+// limitField returns the address of a limit
+func limitField(limits *Limits, limit string) **int {
+	switch limit {
+	case LIMIT_VALUE_TIMEOUT:
+		return &limits.Timeout
+	case LIMIT_VALUE_MEMORY_SIZE:
+		return &limits.Memory
+	case LIMIT_VALUE_LOG_SIZE:
+		return &limits.Logsize
+	case LIMIT_VALUE_CONCURRENCY:
+		return &limits.Concurrency
+	}
+	return nil
+}
+
+func factorLimit(pkg Package, actionNames []string, defaults *Defaults, limit string, minActions int) {
+	if defaults.Limits != nil && *limitField(defaults.Limits, limit) != nil {
+		return
+	}
+	var values []interface{}
+	for _, actionName := range actionNames {
+		action := pkg.Actions[actionName]
+		if action.Limits == nil || *limitField(action.Limits, limit) == nil {
+			return
+		}
+		values = append(values, **limitField(action.Limits, limit))
+	}
+	value, count := mostCommon(values)
+	if count < minActions {
+		return
+	}
+	shared := value.(int)
+	if defaults.Limits == nil {
+		defaults.Limits = new(Limits)
+	}
+	*limitField(defaults.Limits, limit) = &shared
+	for _, actionName := range actionNames {
+		action := pkg.Actions[actionName]
+		if **limitField(action.Limits, limit) == shared {
+			limits := *action.Limits
+			*limitField(&limits, limit) = nil
+			action.Limits = &limits
+			if reflect.DeepEqual(limits, Limits{}) {
+				action.Limits = nil
+			}
+			pkg.Actions[actionName] = action
+		}
+	}
+}
+
+// annotationKeys returns the sorted keys of the annotations of the actions
+func annotationKeys(actions map[string]Action) []string {
+	var keys []string
+	seen := make(map[string]bool)
+	for _, action := range actions {
+		for key := range action.Annotations {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func factorAnnotation(pkg Package, actionNames []string, defaults *Defaults, key string, minActions int) {
+	if _, ok := defaults.Annotations[key]; ok {
+		return
+	}
+	for _, sequence := range pkg.Sequences {
+		if _, ok := sequence.Annotations[key]; !ok {
+			return
+		}
+	}
+	var values []interface{}
+	for _, actionName := range actionNames {
+		value, ok := pkg.Actions[actionName].Annotations[key]
+		if !ok {
+			return
+		}
+		values = append(values, value)
+	}
+	value, count := mostCommon(values)
+	if count < minActions {
+		return
+	}
+	// an override which is a map would be merged with a factored map rather than replace it
+	if isMapValue(value) {
+		for _, other := range values {
+			if isMapValue(other) && !reflect.DeepEqual(other, value) {
+				return
+			}
+		}
+		for _, sequence := range pkg.Sequences {
+			if other := sequence.Annotations[key]; isMapValue(other) && !reflect.DeepEqual(other, value) {
+				return
+			}
+		}
+	}
+	annotations := make(map[string]interface{}, len(defaults.Annotations)+1)
+	for k, v := range defaults.Annotations {
+		annotations[k] = v
+	}
+	annotations[key] = value
+	defaults.Annotations = annotations
+	for name, action := range pkg.Actions {
+		if reflect.DeepEqual(action.Annotations[key], value) {
+			delete(action.Annotations, key)
+			pkg.Actions[name] = action
+		}
+	}
+	for name, sequence := range pkg.Sequences {
+		if reflect.DeepEqual(sequence.Annotations[key], value) {
+			delete(sequence.Annotations, key)
+			pkg.Sequences[name] = sequence
+		}
+	}
+}
+
+func isMapValue(value interface{}) bool {
+	return value != nil && reflect.TypeOf(value).Kind() == reflect.Map
+}
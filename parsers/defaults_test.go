@@ -0,0 +1,123 @@
+// +build unit
+
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parsers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFactorDefaults(t *testing.T) {
+	timeout, memory, other := 60000, 256, 1024
+	limits := func(memorySize *int) *Limits {
+		return &Limits{Timeout: &timeout, Memory: memorySize}
+	}
+	manifest := YAML{Packages: map[string]Package{
+		"samples": {Actions: map[string]Action{
+			"a": {Runtime: "nodejs:20", Limits: limits(&memory), Annotations: map[string]interface{}{"final": true, "team": "web"}},
+			"b": {Runtime: "nodejs:20", Limits: limits(&memory), Annotations: map[string]interface{}{"final": true}},
+			"c": {Runtime: "python:3", Limits: limits(&other), Annotations: map[string]interface{}{"final": false}},
+			"d": {Docker: "openwhisk/skeleton", Limits: limits(&memory), Annotations: map[string]interface{}{"final": true}},
+		}},
+		"small": {Actions: map[string]Action{
+			"e": {Runtime: "nodejs:20"},
+		}},
+	}}
+
+	manifest.FactorDefaults(3)
+	samples := manifest.Packages["samples"]
+	assert.NotNil(t, samples.Defaults)
+
+	// the runtime is shared by two actions only
+	assert.Equal(t, "", samples.Defaults.Runtime)
+	assert.Equal(t, "nodejs:20", samples.Actions["a"].Runtime)
+
+	// limits and annotations set by every action are factored
+	assert.Equal(t, timeout, *samples.Defaults.Limits.Timeout)
+	assert.Equal(t, memory, *samples.Defaults.Limits.Memory)
+	assert.Equal(t, true, samples.Defaults.Annotations["final"])
+	assert.Nil(t, samples.Actions["a"].Limits)
+	assert.Equal(t, other, *samples.Actions["c"].Limits.Memory)
+	assert.Nil(t, samples.Actions["c"].Limits.Timeout)
+	assert.Equal(t, false, samples.Actions["c"].Annotations["final"])
+	assert.Equal(t, map[string]interface{}{"team": "web"}, samples.Actions["a"].Annotations)
+
+	// annotations set by some actions only are kept
+	_, ok := samples.Defaults.Annotations["team"]
+	assert.False(t, ok)
+
+	// and packages with fewer actions are left untouched
+	assert.Nil(t, manifest.Packages["small"].Defaults)
+
+	// the effective values of the actions are unchanged
+	defaults := manifest.PackageDefaults(samples)
+	assert.Equal(t, "nodejs:20", samples.Actions["a"].withDefaults(defaults).Runtime)
+	assert.Equal(t, other, *samples.Actions["c"].withDefaults(defaults).Limits.Memory)
+	assert.Equal(t, timeout, *samples.Actions["c"].withDefaults(defaults).Limits.Timeout)
+	assert.Equal(t, true, samples.Actions["d"].withDefaults(defaults).Annotations["final"])
+}
+
+func TestFactorDefaultsForMapAnnotations(t *testing.T) {
+	owner := func(team string) map[interface{}]interface{} {
+		return map[interface{}]interface{}{"team": team}
+	}
+	manifest := YAML{Packages: map[string]Package{
+		"samples": {Actions: map[string]Action{
+			"a": {Annotations: map[string]interface{}{"owner": owner("web"), "final": true}},
+			"b": {Annotations: map[string]interface{}{"owner": owner("web"), "final": true}},
+			"c": {Annotations: map[string]interface{}{"owner": map[interface{}]interface{}{"contact": "ops"}, "final": true}},
+		}},
+	}}
+
+	manifest.FactorDefaults(2)
+	samples := manifest.Packages["samples"]
+
+	// the owner of c would be merged with the factored one, so it is not factored
+	_, ok := samples.Defaults.Annotations["owner"]
+	assert.False(t, ok)
+	assert.Equal(t, true, samples.Defaults.Annotations["final"])
+
+	// deploying the exported manifest gives back the annotations of every action
+	defaults := manifest.PackageDefaults(samples)
+	assert.Equal(t, owner("web"), samples.Actions["a"].withDefaults(defaults).Annotations["owner"])
+	assert.Equal(t, map[interface{}]interface{}{"contact": "ops"}, samples.Actions["c"].withDefaults(defaults).Annotations["owner"])
+}
+
+func TestMergeDefaults(t *testing.T) {
+	project := &Defaults{Runtime: "nodejs:20", Annotations: map[string]interface{}{
+		"owner": map[interface{}]interface{}{"team": "platform", "contact": "ops"}}}
+	pkg := &Defaults{Web: "true", Annotations: map[string]interface{}{
+		"owner": map[interface{}]interface{}{"contact": "dev"}}}
+
+	merged := project.merge(pkg)
+	assert.Equal(t, "nodejs:20", merged.Runtime)
+	assert.Equal(t, "true", merged.Web)
+	assert.Equal(t, map[string]interface{}{"team": "platform", "contact": "dev"}, merged.Annotations["owner"])
+
+	var none *Defaults
+	assert.Equal(t, pkg, none.merge(pkg))
+	assert.Equal(t, project, project.merge(nil))
+
+	// docker actions do not inherit the runtime
+	action := Action{Docker: "openwhisk/skeleton"}.withDefaults(merged)
+	assert.Equal(t, "", action.Runtime)
+	assert.Equal(t, "true", action.Web)
+}
@@ -50,12 +50,11 @@ func (dm *YAMLParser) ParseDeployment(deploymentPath string) (*YAML, error) {
 	return dplyyamlEnvVar, nil
 }
 
-//********************Project functions*************************//
-//This is for parse the deployment yaml file.
+// ********************Project functions*************************//
+// This is for parse the deployment yaml file.
 func (app *Project) GetPackageList() []Package {
 	var s1 []Package = make([]Package, 0)
 	for _, pkg := range app.Packages {
-		pkg.Packagename = pkg.Packagename
 		s1 = append(s1, pkg)
 	}
 	return s1
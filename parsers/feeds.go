@@ -0,0 +1,170 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parsers
+
+import (
+	"strings"
+
+	"github.com/sciabarracom/openwhisk-wskdeploy/wskderrors"
+	"github.com/sciabarracom/openwhisk-wskdeploy/wski18n"
+)
+
+// lifecycle events passed to a feed action in its lifecycleEvent parameter
+const (
+	FEED_LIFECYCLE_CREATE  = "CREATE"
+	FEED_LIFECYCLE_UPDATE  = "UPDATE"
+	FEED_LIFECYCLE_DELETE  = "DELETE"
+	FEED_LIFECYCLE_READ    = "READ"
+	FEED_LIFECYCLE_PAUSE   = "PAUSE"
+	FEED_LIFECYCLE_UNPAUSE = "UNPAUSE"
+)
+
+// parameters every feed action receives
+const (
+	FEED_PARAM_AUTH_KEY        = "authKey"
+	FEED_PARAM_LIFECYCLE_EVENT = "lifecycleEvent"
+	FEED_PARAM_TRIGGER_NAME    = "triggerName"
+)
+
+var FEED_LIFECYCLE_EVENTS = []string{
+	FEED_LIFECYCLE_CREATE,
+	FEED_LIFECYCLE_UPDATE,
+	FEED_LIFECYCLE_DELETE,
+	FEED_LIFECYCLE_READ,
+	FEED_LIFECYCLE_PAUSE,
+	FEED_LIFECYCLE_UNPAUSE,
+}
+
+// the lifecycle events wskdeploy invokes feed actions with, the only ones feeds
+// may declare operations for; READ, PAUSE and UNPAUSE are never invoked on deploy
+var FEED_OPERATION_EVENTS = []string{
+	FEED_LIFECYCLE_CREATE,
+	FEED_LIFECYCLE_UPDATE,
+	FEED_LIFECYCLE_DELETE,
+}
+
+// FeedOperation holds the inputs added to the parameters of a feed action
+// when it is invoked for the lifecycle event naming the operation
+type FeedOperation struct {
+	Inputs map[string]Parameter `yaml:"inputs"`
+}
+
+// feedLifecycleEvent returns the lifecycle event of an operation name, which may be lowercase
+func feedLifecycleEvent(operation string) (string, bool) {
+	event := strings.ToUpper(operation)
+	for _, e := range FEED_LIFECYCLE_EVENTS {
+		if e == event {
+			return event, true
+		}
+	}
+	return "", false
+}
+
+func isFeedOperationEvent(event string) bool {
+	for _, e := range FEED_OPERATION_EVENTS {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// expandFeeds adds the feed actions declared in the feeds of the packages of the manifest
+// and points the triggers naming one of those feeds to its feed action
+func expandFeeds(manifest *YAML, manifestPath string) error {
+	var err error
+	for name, pkg := range manifest.Packages {
+		if manifest.Packages[name], err = pkg.expandFeeds(name, manifestPath); err != nil {
+			return err
+		}
+	}
+	for name, pkg := range manifest.Project.Packages {
+		if manifest.Project.Packages[name], err = pkg.expandFeeds(name, manifestPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// a feed with a location is deployed as a new action named after the feed,
+// a feed with an action marks that action of the package as its feed action
+func (pkg Package) expandFeeds(packageName string, manifestPath string) (Package, error) {
+	if len(pkg.Feeds) == 0 {
+		return pkg, nil
+	}
+	actions := make(map[string]Action, len(pkg.Actions))
+	for name, action := range pkg.Actions {
+		actions[name] = action
+	}
+
+	for name, feed := range pkg.Feeds {
+		feedAction := mergeAction(Action{Annotations: feed.Annotations}, Action{
+			Inputs:      feed.Inputs,
+			Annotations: map[string]interface{}{YAML_KEY_FEED: true},
+		})
+		if len(feed.Location) != 0 {
+			if _, ok := actions[name]; ok {
+				errMessage := wski18n.T(wski18n.ID_ERR_FEED_NAME_COLLISION_X_feed_X,
+					map[string]interface{}{wski18n.KEY_TRIGGER_FEED: name})
+				return pkg, wskderrors.NewYAMLFileFormatError(manifestPath, errMessage)
+			}
+			feedAction.Function = feed.Location
+			feedAction.Runtime = feed.Runtime
+			actions[name] = feedAction
+		} else if len(feed.Action) != 0 {
+			action, ok := actions[feed.Action]
+			if !ok {
+				errMessage := wski18n.T(wski18n.ID_ERR_FEED_ACTION_NOT_FOUND_X_feed_X_action_X,
+					map[string]interface{}{
+						wski18n.KEY_TRIGGER_FEED: name,
+						wski18n.KEY_ACTION:       feed.Action})
+				return pkg, wskderrors.NewYAMLFileFormatError(manifestPath, errMessage)
+			}
+			actions[feed.Action] = mergeAction(action, feedAction)
+		}
+	}
+	pkg.Actions = actions
+
+	for name, trigger := range pkg.Triggers {
+		if feedName, ok := pkg.feedActionName(packageName, trigger.Feed); ok {
+			trigger.Feed = feedName
+			pkg.Triggers[name] = trigger
+		}
+	}
+	return pkg, nil
+}
+
+// feedActionName returns the name of the action of a feed declared in the package,
+// qualified by the package name unless it is the default package
+func (pkg Package) feedActionName(packageName string, feedName string) (string, bool) {
+	feed, ok := pkg.Feeds[feedName]
+	if !ok {
+		return "", false
+	}
+	actionName := feedName
+	if len(feed.Location) == 0 {
+		if len(feed.Action) == 0 {
+			return "", false
+		}
+		actionName = feed.Action
+	}
+	if packageName == DEFAULT_PACKAGE {
+		return actionName, true
+	}
+	return packageName + "/" + actionName, true
+}
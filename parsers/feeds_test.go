@@ -0,0 +1,90 @@
+// +build unit
+
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parsers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+func TestFeedLifecycleEvent(t *testing.T) {
+	event, ok := feedLifecycleEvent("unpause")
+	assert.True(t, ok)
+	assert.Equal(t, FEED_LIFECYCLE_UNPAUSE, event)
+
+	event, ok = feedLifecycleEvent("DELETE")
+	assert.True(t, ok)
+	assert.Equal(t, FEED_LIFECYCLE_DELETE, event)
+
+	_, ok = feedLifecycleEvent("restart")
+	assert.False(t, ok)
+}
+
+func TestExpandFeedsErrors(t *testing.T) {
+	var pkg Package
+	err := yaml.UnmarshalStrict([]byte("actions:\n  ticker:\n    function: ticker.js\nfeeds:\n  ticker:\n    location: feed.js\n"), &pkg)
+	assert.Nil(t, err)
+	_, err = pkg.expandFeeds("events", "manifest.yaml")
+	assert.NotNil(t, err, "Expected an error for a feed with the name of an action")
+	assert.Contains(t, err.Error(), "[ticker]")
+
+	pkg = Package{}
+	err = yaml.UnmarshalStrict([]byte("feeds:\n  ticker:\n    action: missing\n"), &pkg)
+	assert.Nil(t, err)
+	_, err = pkg.expandFeeds("events", "manifest.yaml")
+	assert.NotNil(t, err, "Expected an error for a feed of an undeclared action")
+	assert.Contains(t, err.Error(), "[missing]")
+
+	pkg = Package{}
+	err = yaml.UnmarshalStrict([]byte("feeds:\n  ticker:\n    location: feed.js\n    operations:\n      restart:\n"), &pkg)
+	assert.Nil(t, err)
+	_, err = NewYAMLParser().ComposeFeeds("manifest.yaml", pkg, "events", PackageInputs{})
+	assert.NotNil(t, err, "Expected an error for an operation which is not a lifecycle event")
+	assert.Contains(t, err.Error(), "[restart]")
+
+	pkg = Package{}
+	err = yaml.UnmarshalStrict([]byte("feeds:\n  ticker:\n    location: feed.js\n    operations:\n      pause:\n"), &pkg)
+	assert.Nil(t, err)
+	_, err = NewYAMLParser().ComposeFeeds("manifest.yaml", pkg, "events", PackageInputs{})
+	assert.NotNil(t, err, "Expected an error for an operation which is never invoked")
+	assert.Contains(t, err.Error(), "[pause]")
+}
+
+func TestFeedActionName(t *testing.T) {
+	pkg := Package{Feeds: map[string]Feed{
+		"ticker":   {Location: "feed.js"},
+		"events":   {Action: "provider"},
+		"external": {},
+	}}
+	name, ok := pkg.feedActionName("events", "ticker")
+	assert.True(t, ok)
+	assert.Equal(t, "events/ticker", name)
+
+	name, ok = pkg.feedActionName(DEFAULT_PACKAGE, "events")
+	assert.True(t, ok)
+	assert.Equal(t, "provider", name)
+
+	_, ok = pkg.feedActionName("events", "external")
+	assert.False(t, ok, "A feed without location or action has no feed action")
+	_, ok = pkg.feedActionName("events", "/whisk.system/alarms/alarm")
+	assert.False(t, ok)
+}
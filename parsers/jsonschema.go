@@ -0,0 +1,615 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parsers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/ghodss/yaml"
+	"github.com/sciabarracom/openwhisk-wskdeploy/wskderrors"
+	"github.com/sciabarracom/openwhisk-wskdeploy/wski18n"
+)
+
+// JSON Schema keywords supported for the validation of json inputs
+const (
+	SCHEMA_REF                   = "$ref"
+	SCHEMA_TYPE                  = "type"
+	SCHEMA_ENUM                  = "enum"
+	SCHEMA_CONST                 = "const"
+	SCHEMA_PROPERTIES            = "properties"
+	SCHEMA_PATTERN_PROPERTIES    = "patternProperties"
+	SCHEMA_ADDITIONAL_PROPERTIES = "additionalProperties"
+	SCHEMA_REQUIRED              = "required"
+	SCHEMA_MIN_PROPERTIES        = "minProperties"
+	SCHEMA_MAX_PROPERTIES        = "maxProperties"
+	SCHEMA_ITEMS                 = "items"
+	SCHEMA_MIN_ITEMS             = "minItems"
+	SCHEMA_MAX_ITEMS             = "maxItems"
+	SCHEMA_UNIQUE_ITEMS          = "uniqueItems"
+	SCHEMA_MIN_LENGTH            = "minLength"
+	SCHEMA_MAX_LENGTH            = "maxLength"
+	SCHEMA_PATTERN               = "pattern"
+	SCHEMA_MINIMUM               = "minimum"
+	SCHEMA_MAXIMUM               = "maximum"
+	SCHEMA_EXCLUSIVE_MINIMUM     = "exclusiveMinimum"
+	SCHEMA_EXCLUSIVE_MAXIMUM     = "exclusiveMaximum"
+	SCHEMA_MULTIPLE_OF           = "multipleOf"
+	SCHEMA_ALL_OF                = "allOf"
+	SCHEMA_ANY_OF                = "anyOf"
+	SCHEMA_ONE_OF                = "oneOf"
+	SCHEMA_NOT                   = "not"
+)
+
+// keywords holding schemas which are only reached through $ref
+var schemaDefinitionKeys = []string{"definitions", "$defs"}
+
+// nesting of $ref beyond this depth is considered a cycle
+const schemaMaxDepth = 64
+
+// schemaDocument is a schema file, or the manifest for inline schemas, used to resolve $ref
+type schemaDocument struct {
+	root    interface{}
+	baseDir string
+}
+
+type schemaViolation struct {
+	pointer string
+	message string
+}
+
+type schemaValidator struct {
+	violations []schemaViolation
+	documents  map[string]*schemaDocument
+	err        error
+}
+
+/*
+   ValidateJSONSchema validates the value of a json input against its JSON Schema.
+
+   The schema is either inline or a $ref to a local JSON or YAML file, relative to the file
+   which declared the input. References within the schema, e.g. "#/definitions/db", and to
+   other local files, e.g. "common.json#/definitions/db", are resolved as well.
+
+   Inputs:
+   - filePath: the path of the file which declared the input, used to resolve $ref and for error reporting
+   - paramName: name of the input for error reporting
+   - schema: the schema of the input
+   - value: the resolved value of the input
+
+   Returns:
+   - (error) a JSONSchemaValidationError listing the JSON pointer of every violation, or of
+     every invalid regular expression of the schema, e.g. "#/properties/name/pattern"
+*/
+func ValidateJSONSchema(filePath string, paramName string, schema interface{}, value interface{}) error {
+	v := &schemaValidator{documents: make(map[string]*schemaDocument)}
+	schema = normalizeJSONValue(schema)
+	doc := &schemaDocument{root: schema, baseDir: filepath.Dir(filePath)}
+
+	// an invalid regular expression is an error of the schema whatever the value
+	v.checkPatterns(schema, "#")
+	if len(v.violations) == 0 {
+		v.validate(schema, doc, normalizeJSONValue(value), "", 0)
+	}
+	if v.err != nil {
+		return v.err
+	}
+	if len(v.violations) == 0 {
+		return nil
+	}
+	pointers := make([]string, 0, len(v.violations))
+	msgs := make([]string, 0, len(v.violations))
+	for _, violation := range v.violations {
+		pointer := violation.pointer
+		if len(pointer) == 0 {
+			pointer = "/"
+		}
+		pointers = append(pointers, pointer)
+		msgs = append(msgs, violation.message)
+	}
+	return wskderrors.NewJSONSchemaValidationError(filePath, paramName, pointers, msgs)
+}
+
+// ValidateInputSchemas validates the final values of the json inputs which declare a schema
+func ValidateInputSchemas(filePath string, inputs map[string]Parameter, values whisk.KeyValueArr) error {
+	for _, kv := range values {
+		param, ok := inputs[kv.Key]
+		if !ok || param.Type != JSON || param.Schema == nil {
+			continue
+		}
+		value := kv.Value
+		if str, ok := value.(string); ok {
+			var parsed interface{}
+			if err := json.Unmarshal([]byte(str), &parsed); err == nil {
+				value = parsed
+			}
+		}
+		// an input which was never set is left to the check of required inputs
+		if m, ok := value.(map[string]interface{}); value == nil || (ok && len(m) == 0 && !param.Required) {
+			continue
+		}
+		if err := ValidateJSONSchema(filePath, kv.Key, param.Schema, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// normalizeJSONValue converts the maps decoded from YAML into the maps decoded from JSON
+func normalizeJSONValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			m[fmt.Sprintf("%v", key)] = normalizeJSONValue(item)
+		}
+		return m
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			m[key] = normalizeJSONValue(item)
+		}
+		return m
+	case []interface{}:
+		a := make([]interface{}, len(v))
+		for i, item := range v {
+			a[i] = normalizeJSONValue(item)
+		}
+		return a
+	}
+	return value
+}
+
+func (v *schemaValidator) fail(pointer string, id string, args map[string]interface{}) {
+	v.violations = append(v.violations, schemaViolation{pointer: pointer, message: wski18n.T(id, args)})
+}
+
+// escapes a property name as a JSON pointer reference token
+func pointerToken(name string) string {
+	return strings.Replace(strings.Replace(name, "~", "~0", -1), "/", "~1", -1)
+}
+
+func (v *schemaValidator) validate(schema interface{}, doc *schemaDocument, value interface{}, pointer string, depth int) {
+	if v.err != nil {
+		return
+	}
+	if depth > schemaMaxDepth {
+		v.fail(pointer, wski18n.ID_ERR_JSON_SCHEMA_REF_X_value_X, map[string]interface{}{wski18n.KEY_VALUE: SCHEMA_REF})
+		return
+	}
+
+	// boolean schemas either accept or reject any value
+	if b, ok := schema.(bool); ok {
+		if !b {
+			v.fail(pointer, wski18n.ID_ERR_JSON_SCHEMA_KEYWORD_X_key_X, map[string]interface{}{wski18n.KEY_KEY: "false"})
+		}
+		return
+	}
+	s, ok := schema.(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	// as in draft 7, the keywords next to $ref are ignored
+	if ref, ok := s[SCHEMA_REF].(string); ok {
+		refSchema, refDoc := v.resolveRef(ref, doc)
+		if refDoc == nil {
+			if v.err == nil {
+				v.fail(pointer, wski18n.ID_ERR_JSON_SCHEMA_REF_X_value_X, map[string]interface{}{wski18n.KEY_VALUE: ref})
+			}
+			return
+		}
+		v.validate(refSchema, refDoc, value, pointer, depth+1)
+		return
+	}
+
+	if t, ok := s[SCHEMA_TYPE]; ok && !matchesSchemaType(t, value) {
+		v.fail(pointer, wski18n.ID_ERR_JSON_SCHEMA_TYPE_X_type_X, map[string]interface{}{wski18n.KEY_TYPE: schemaTypeNames(t)})
+		// the other keywords would only repeat the type mismatch
+		return
+	}
+
+	if enum, ok := s[SCHEMA_ENUM].([]interface{}); ok {
+		found := false
+		values := make([]string, 0, len(enum))
+		for _, e := range enum {
+			found = found || jsonEqual(e, value)
+			values = append(values, jsonString(e))
+		}
+		if !found {
+			v.fail(pointer, wski18n.ID_ERR_PARAMETER_VALUE_NOT_IN_ENUM_X_value_X, map[string]interface{}{wski18n.KEY_VALUE: strings.Join(values, ", ")})
+		}
+	}
+	if c, ok := s[SCHEMA_CONST]; ok && !jsonEqual(c, value) {
+		v.fail(pointer, wski18n.ID_ERR_JSON_SCHEMA_CONST_X_value_X, map[string]interface{}{wski18n.KEY_VALUE: jsonString(c)})
+	}
+
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		v.validateObject(s, doc, typed, pointer, depth)
+	case []interface{}:
+		v.validateArray(s, doc, typed, pointer, depth)
+	case string:
+		v.validateString(s, typed, pointer)
+	default:
+		if number, ok := jsonNumber(value); ok {
+			v.validateNumber(s, number, pointer)
+		}
+	}
+
+	if all, ok := s[SCHEMA_ALL_OF].([]interface{}); ok {
+		for _, sub := range all {
+			v.validate(sub, doc, value, pointer, depth+1)
+		}
+	}
+	if anyOf, ok := s[SCHEMA_ANY_OF].([]interface{}); ok && v.countMatches(anyOf, doc, value, depth) == 0 {
+		v.fail(pointer, wski18n.ID_ERR_JSON_SCHEMA_KEYWORD_X_key_X, map[string]interface{}{wski18n.KEY_KEY: SCHEMA_ANY_OF})
+	}
+	if oneOf, ok := s[SCHEMA_ONE_OF].([]interface{}); ok && v.countMatches(oneOf, doc, value, depth) != 1 {
+		v.fail(pointer, wski18n.ID_ERR_JSON_SCHEMA_KEYWORD_X_key_X, map[string]interface{}{wski18n.KEY_KEY: SCHEMA_ONE_OF})
+	}
+	if not, ok := s[SCHEMA_NOT]; ok && v.countMatches([]interface{}{not}, doc, value, depth) == 1 {
+		v.fail(pointer, wski18n.ID_ERR_JSON_SCHEMA_KEYWORD_X_key_X, map[string]interface{}{wski18n.KEY_KEY: SCHEMA_NOT})
+	}
+}
+
+// countMatches returns how many of the schemas accept the value, without reporting their violations
+func (v *schemaValidator) countMatches(schemas []interface{}, doc *schemaDocument, value interface{}, depth int) int {
+	matches := 0
+	for _, sub := range schemas {
+		probe := &schemaValidator{documents: v.documents}
+		probe.validate(sub, doc, value, "", depth+1)
+		if probe.err != nil {
+			v.err = probe.err
+			return 0
+		}
+		if len(probe.violations) == 0 {
+			matches++
+		}
+	}
+	return matches
+}
+
+func (v *schemaValidator) validateObject(s map[string]interface{}, doc *schemaDocument, value map[string]interface{}, pointer string, depth int) {
+	if required, ok := s[SCHEMA_REQUIRED].([]interface{}); ok {
+		for _, r := range required {
+			name := fmt.Sprintf("%v", r)
+			if _, ok := value[name]; !ok {
+				v.fail(pointer, wski18n.ID_ERR_JSON_SCHEMA_REQUIRED_X_key_X, map[string]interface{}{wski18n.KEY_KEY: name})
+			}
+		}
+	}
+	if min, ok := jsonNumber(s[SCHEMA_MIN_PROPERTIES]); ok && float64(len(value)) < min {
+		v.fail(pointer, wski18n.ID_ERR_JSON_SCHEMA_MIN_PROPERTIES_X_min_X, map[string]interface{}{wski18n.KEY_VALUE_MIN: normalizedNumber(min)})
+	}
+	if max, ok := jsonNumber(s[SCHEMA_MAX_PROPERTIES]); ok && float64(len(value)) > max {
+		v.fail(pointer, wski18n.ID_ERR_JSON_SCHEMA_MAX_PROPERTIES_X_max_X, map[string]interface{}{wski18n.KEY_VALUE_MAX: normalizedNumber(max)})
+	}
+
+	properties, _ := s[SCHEMA_PROPERTIES].(map[string]interface{})
+	patternProperties, _ := s[SCHEMA_PATTERN_PROPERTIES].(map[string]interface{})
+	additional, hasAdditional := s[SCHEMA_ADDITIONAL_PROPERTIES]
+
+	// visit the properties in order to report violations deterministically
+	names := make([]string, 0, len(value))
+	for name := range value {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		item := value[name]
+		itemPointer := pointer + "/" + pointerToken(name)
+		matched := false
+		if sub, ok := properties[name]; ok {
+			matched = true
+			v.validate(sub, doc, item, itemPointer, depth+1)
+		}
+		for pattern, sub := range patternProperties {
+			// invalid patterns are reported by checkPatterns when the schema is loaded
+			if re, err := regexp.Compile(pattern); err == nil && re.MatchString(name) {
+				matched = true
+				v.validate(sub, doc, item, itemPointer, depth+1)
+			}
+		}
+		if !matched && hasAdditional {
+			if b, ok := additional.(bool); ok && !b {
+				v.fail(itemPointer, wski18n.ID_ERR_JSON_SCHEMA_ADDITIONAL_PROPERTY_X_key_X, map[string]interface{}{wski18n.KEY_KEY: name})
+			} else {
+				v.validate(additional, doc, item, itemPointer, depth+1)
+			}
+		}
+	}
+}
+
+func (v *schemaValidator) validateArray(s map[string]interface{}, doc *schemaDocument, value []interface{}, pointer string, depth int) {
+	if min, ok := jsonNumber(s[SCHEMA_MIN_ITEMS]); ok && float64(len(value)) < min {
+		v.fail(pointer, wski18n.ID_ERR_JSON_SCHEMA_MIN_ITEMS_X_min_X, map[string]interface{}{wski18n.KEY_VALUE_MIN: normalizedNumber(min)})
+	}
+	if max, ok := jsonNumber(s[SCHEMA_MAX_ITEMS]); ok && float64(len(value)) > max {
+		v.fail(pointer, wski18n.ID_ERR_JSON_SCHEMA_MAX_ITEMS_X_max_X, map[string]interface{}{wski18n.KEY_VALUE_MAX: normalizedNumber(max)})
+	}
+	if unique, ok := s[SCHEMA_UNIQUE_ITEMS].(bool); ok && unique {
+	duplicates:
+		for i := range value {
+			for j := i + 1; j < len(value); j++ {
+				if jsonEqual(value[i], value[j]) {
+					v.fail(pointer, wski18n.ID_ERR_JSON_SCHEMA_UNIQUE_ITEMS, nil)
+					break duplicates
+				}
+			}
+		}
+	}
+	switch items := s[SCHEMA_ITEMS].(type) {
+	case nil:
+	case []interface{}:
+		// tuple validation, each item has its own schema
+		for i, item := range value {
+			if i < len(items) {
+				v.validate(items[i], doc, item, pointer+"/"+strconv.Itoa(i), depth+1)
+			}
+		}
+	default:
+		for i, item := range value {
+			v.validate(items, doc, item, pointer+"/"+strconv.Itoa(i), depth+1)
+		}
+	}
+}
+
+func (v *schemaValidator) validateString(s map[string]interface{}, value string, pointer string) {
+	length := float64(utf8.RuneCountInString(value))
+	if min, ok := jsonNumber(s[SCHEMA_MIN_LENGTH]); ok && length < min {
+		v.fail(pointer, wski18n.ID_ERR_JSON_SCHEMA_TOO_SHORT_X_min_X, map[string]interface{}{wski18n.KEY_VALUE_MIN: normalizedNumber(min)})
+	}
+	if max, ok := jsonNumber(s[SCHEMA_MAX_LENGTH]); ok && length > max {
+		v.fail(pointer, wski18n.ID_ERR_PARAMETER_VALUE_TOO_LONG_X_max_X, map[string]interface{}{wski18n.KEY_VALUE_MAX: normalizedNumber(max)})
+	}
+	if pattern, ok := s[SCHEMA_PATTERN].(string); ok {
+		// invalid patterns are reported by checkPatterns when the schema is loaded
+		if re, err := regexp.Compile(pattern); err == nil && !re.MatchString(value) {
+			v.fail(pointer, wski18n.ID_ERR_JSON_SCHEMA_PATTERN_X_value_X, map[string]interface{}{wski18n.KEY_VALUE: pattern})
+		}
+	}
+}
+
+func (v *schemaValidator) validateNumber(s map[string]interface{}, value float64, pointer string) {
+	if min, ok := jsonNumber(s[SCHEMA_MINIMUM]); ok && value < min {
+		v.fail(pointer, wski18n.ID_ERR_PARAMETER_VALUE_BELOW_MIN_X_min_X, map[string]interface{}{wski18n.KEY_VALUE_MIN: normalizedNumber(min)})
+	}
+	if max, ok := jsonNumber(s[SCHEMA_MAXIMUM]); ok && value > max {
+		v.fail(pointer, wski18n.ID_ERR_PARAMETER_VALUE_ABOVE_MAX_X_max_X, map[string]interface{}{wski18n.KEY_VALUE_MAX: normalizedNumber(max)})
+	}
+	if min, ok := jsonNumber(s[SCHEMA_EXCLUSIVE_MINIMUM]); ok && value <= min {
+		v.fail(pointer, wski18n.ID_ERR_PARAMETER_VALUE_BELOW_MIN_X_min_X, map[string]interface{}{wski18n.KEY_VALUE_MIN: normalizedNumber(min)})
+	}
+	if max, ok := jsonNumber(s[SCHEMA_EXCLUSIVE_MAXIMUM]); ok && value >= max {
+		v.fail(pointer, wski18n.ID_ERR_PARAMETER_VALUE_ABOVE_MAX_X_max_X, map[string]interface{}{wski18n.KEY_VALUE_MAX: normalizedNumber(max)})
+	}
+	if multiple, ok := jsonNumber(s[SCHEMA_MULTIPLE_OF]); ok && multiple > 0 && !isWholeNumber(value/multiple) {
+		v.fail(pointer, wski18n.ID_ERR_JSON_SCHEMA_MULTIPLE_OF_X_value_X, map[string]interface{}{wski18n.KEY_VALUE: normalizedNumber(multiple)})
+	}
+}
+
+// checkPatterns reports the pattern and patternProperties of a schema, and of its
+// subschemas, which are not valid regular expressions, pointer locates the schema
+func (v *schemaValidator) checkPatterns(schema interface{}, pointer string) {
+	s, ok := schema.(map[string]interface{})
+	if !ok {
+		return
+	}
+	if pattern, ok := s[SCHEMA_PATTERN].(string); ok {
+		v.checkPattern(pattern, pointer+"/"+SCHEMA_PATTERN)
+	}
+	for _, key := range append([]string{SCHEMA_PROPERTIES, SCHEMA_PATTERN_PROPERTIES}, schemaDefinitionKeys...) {
+		subschemas, _ := s[key].(map[string]interface{})
+		names := make([]string, 0, len(subschemas))
+		for name := range subschemas {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			subPointer := pointer + "/" + key + "/" + pointerToken(name)
+			if key == SCHEMA_PATTERN_PROPERTIES {
+				v.checkPattern(name, subPointer)
+			}
+			v.checkPatterns(subschemas[name], subPointer)
+		}
+	}
+	for _, key := range []string{SCHEMA_ITEMS, SCHEMA_ADDITIONAL_PROPERTIES, SCHEMA_NOT} {
+		v.checkPatterns(s[key], pointer+"/"+key)
+	}
+	for _, key := range []string{SCHEMA_ITEMS, SCHEMA_ALL_OF, SCHEMA_ANY_OF, SCHEMA_ONE_OF} {
+		subschemas, _ := s[key].([]interface{})
+		for i, sub := range subschemas {
+			v.checkPatterns(sub, pointer+"/"+key+"/"+strconv.Itoa(i))
+		}
+	}
+}
+
+func (v *schemaValidator) checkPattern(pattern string, pointer string) {
+	if _, err := regexp.Compile(pattern); err != nil {
+		v.fail(pointer, wski18n.ID_ERR_JSON_SCHEMA_INVALID_PATTERN_X_value_X_err_X,
+			map[string]interface{}{
+				wski18n.KEY_VALUE: pattern,
+				wski18n.KEY_ERR:   err.Error()})
+	}
+}
+
+// resolveRef returns the schema referenced by ref and the document it belongs to,
+// the document is nil if the reference cannot be resolved
+func (v *schemaValidator) resolveRef(ref string, doc *schemaDocument) (interface{}, *schemaDocument) {
+	location, fragment := ref, ""
+	if i := strings.Index(ref, "#"); i >= 0 {
+		location, fragment = ref[:i], ref[i+1:]
+	}
+	if len(location) != 0 {
+		if doc = v.loadDocument(location, doc.baseDir); doc == nil {
+			return nil, nil
+		}
+	}
+	schema := doc.root
+	if len(fragment) == 0 {
+		return schema, doc
+	}
+	for _, token := range strings.Split(strings.TrimPrefix(fragment, "/"), "/") {
+		token = strings.Replace(strings.Replace(token, "~1", "/", -1), "~0", "~", -1)
+		switch node := schema.(type) {
+		case map[string]interface{}:
+			var ok bool
+			if schema, ok = node[token]; !ok {
+				return nil, nil
+			}
+		case []interface{}:
+			i, err := strconv.Atoi(token)
+			if err != nil || i < 0 || i >= len(node) {
+				return nil, nil
+			}
+			schema = node[i]
+		default:
+			return nil, nil
+		}
+	}
+	return schema, doc
+}
+
+// loadDocument reads a JSON or YAML schema file once
+func (v *schemaValidator) loadDocument(location string, baseDir string) *schemaDocument {
+	path := location
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(baseDir, path)
+	}
+	if doc, ok := v.documents[path]; ok {
+		return doc
+	}
+	var root interface{}
+	data, err := ioutil.ReadFile(path)
+	if err == nil {
+		// YAML is a superset of JSON
+		if data, err = yaml.YAMLToJSON(data); err == nil {
+			err = json.Unmarshal(data, &root)
+		}
+	}
+	if err != nil {
+		v.err = wskderrors.NewFileReadError(path, wski18n.T(wski18n.ID_ERR_JSON_SCHEMA_READ_X_path_X_err_X,
+			map[string]interface{}{
+				wski18n.KEY_PATH: path,
+				wski18n.KEY_ERR:  err.Error()}))
+		return nil
+	}
+	doc := &schemaDocument{root: root, baseDir: filepath.Dir(path)}
+	v.documents[path] = doc
+	v.checkPatterns(root, location+"#")
+	return doc
+}
+
+func matchesSchemaType(t interface{}, value interface{}) bool {
+	switch typed := t.(type) {
+	case string:
+		return matchesSchemaTypeName(typed, value)
+	case []interface{}:
+		for _, name := range typed {
+			if matchesSchemaTypeName(fmt.Sprintf("%v", name), value) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func matchesSchemaTypeName(name string, value interface{}) bool {
+	switch name {
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "null":
+		return value == nil
+	case "number":
+		_, ok := jsonNumber(value)
+		return ok
+	case "integer":
+		number, ok := jsonNumber(value)
+		return ok && isWholeNumber(number)
+	}
+	return true
+}
+
+func schemaTypeNames(t interface{}) string {
+	if names, ok := t.([]interface{}); ok {
+		strs := make([]string, 0, len(names))
+		for _, name := range names {
+			strs = append(strs, fmt.Sprintf("%v", name))
+		}
+		return strings.Join(strs, ", ")
+	}
+	return fmt.Sprintf("%v", t)
+}
+
+// jsonNumber returns the value of any of the numeric types decoded from YAML or JSON
+func jsonNumber(value interface{}) (float64, bool) {
+	if value == nil {
+		return 0, false
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		f := rv.Float()
+		return f, !math.IsNaN(f)
+	}
+	return 0, false
+}
+
+// jsonEqual compares values as JSON does, e.g. 1 and 1.0 are equal
+func jsonEqual(a interface{}, b interface{}) bool {
+	if x, ok := jsonNumber(a); ok {
+		y, ok := jsonNumber(b)
+		return ok && x == y
+	}
+	return jsonString(a) == jsonString(b)
+}
+
+func jsonString(value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
@@ -0,0 +1,124 @@
+// +build unit
+
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parsers
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/sciabarracom/openwhisk-wskdeploy/wskderrors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateJSONSchema(t *testing.T) {
+	schema := map[interface{}]interface{}{
+		"type":     "object",
+		"required": []interface{}{"name"},
+		"properties": map[interface{}]interface{}{
+			"name": map[interface{}]interface{}{"type": "string", "pattern": "^[a-z]+$"},
+			"tags": map[interface{}]interface{}{
+				"type":     "array",
+				"maxItems": 2,
+				"items":    map[interface{}]interface{}{"type": "string"},
+			},
+			"a/b": map[interface{}]interface{}{"type": "boolean"},
+		},
+	}
+
+	err := ValidateJSONSchema("", "config", schema, map[string]interface{}{"name": "ok", "tags": []interface{}{"x"}})
+	assert.Nil(t, err)
+
+	value := map[string]interface{}{
+		"name": "Not OK",
+		"tags": []interface{}{"x", 1, "z"},
+		"a/b":  "yes",
+	}
+	err = ValidateJSONSchema("", "config", schema, value)
+	assert.IsType(t, &wskderrors.JSONSchemaValidationError{}, err)
+	assert.Equal(t, []string{"/a~1b", "/name", "/tags", "/tags/1"}, err.(*wskderrors.JSONSchemaValidationError).Pointers)
+
+	err = ValidateJSONSchema("", "config", schema, map[string]interface{}{})
+	assert.Equal(t, []string{"/"}, err.(*wskderrors.JSONSchemaValidationError).Pointers)
+}
+
+func TestValidateJSONSchemaCombinators(t *testing.T) {
+	schema := map[string]interface{}{
+		"oneOf": []interface{}{
+			map[string]interface{}{"type": "integer", "multipleOf": 5},
+			map[string]interface{}{"type": "string", "maxLength": 3},
+		},
+		"not": map[string]interface{}{"const": 10},
+	}
+	assert.Nil(t, ValidateJSONSchema("", "p", schema, 15))
+	assert.Nil(t, ValidateJSONSchema("", "p", schema, "abc"))
+	assert.NotNil(t, ValidateJSONSchema("", "p", schema, 10))
+	assert.NotNil(t, ValidateJSONSchema("", "p", schema, 7))
+	assert.NotNil(t, ValidateJSONSchema("", "p", schema, "abcd"))
+}
+
+func TestValidateJSONSchemaRef(t *testing.T) {
+	_, m, _ := testLoadParseManifest(t, "../tests/dat/manifest_validate_json_schema.yaml")
+	inputs := m.Packages["validate_json_schema"].Actions["json_schema"].Inputs
+
+	values := make(whisk.KeyValueArr, 0)
+	for name, param := range inputs {
+		value, err := ResolveParameter(name, &param, m.Filepath)
+		assert.Nil(t, err)
+		values = append(values, whisk.KeyValue{Key: name, Value: value})
+	}
+	assert.Nil(t, ValidateInputSchemas(m.Filepath, inputs, values))
+
+	// a value bound later, e.g. by the deployment file, is validated against the file schema
+	database := whisk.KeyValue{Key: "database", Value: `{"host": "db", "port": 70000, "user": "admin"}`}
+	err := ValidateInputSchemas(m.Filepath, inputs, whisk.KeyValueArr{database})
+	assert.IsType(t, &wskderrors.JSONSchemaValidationError{}, err)
+	assert.Equal(t, []string{"/port", "/user"}, err.(*wskderrors.JSONSchemaValidationError).Pointers)
+
+	logging := whisk.KeyValue{Key: "logging", Value: map[string]interface{}{"level": "trace"}}
+	err = ValidateInputSchemas(m.Filepath, inputs, whisk.KeyValueArr{logging})
+	assert.Equal(t, []string{"/level"}, err.(*wskderrors.JSONSchemaValidationError).Pointers)
+
+	// a missing schema file is reported as such
+	missing := map[string]interface{}{"$ref": "schemas/missing.json"}
+	err = ValidateJSONSchema(m.Filepath, "database", missing, map[string]interface{}{"host": "db"})
+	assert.IsType(t, &wskderrors.FileReadError{}, err)
+}
+
+func TestValidateJSONSchemaInvalidPattern(t *testing.T) {
+	schema := map[string]interface{}{
+		"properties": map[string]interface{}{
+			"name": map[string]interface{}{"type": "string", "pattern": "^[a-z+$"},
+		},
+		"patternProperties": map[string]interface{}{"(x": map[string]interface{}{}},
+	}
+	// the schema is in error even when the value does not reach the patterns
+	err := ValidateJSONSchema("", "config", schema, map[string]interface{}{})
+	assert.IsType(t, &wskderrors.JSONSchemaValidationError{}, err)
+	assert.Equal(t, []string{"#/properties/name/pattern", "#/patternProperties/(x"}, err.(*wskderrors.JSONSchemaValidationError).Pointers)
+
+	dir := t.TempDir()
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "user.json"), []byte(`{"type": "string", "pattern": "a**"}`), 0644))
+	ref := map[string]interface{}{"$ref": "user.json"}
+	err = ValidateJSONSchema(filepath.Join(dir, "manifest.yaml"), "user", ref, "admin")
+	assert.IsType(t, &wskderrors.JSONSchemaValidationError{}, err)
+	assert.Equal(t, []string{"user.json#/pattern"}, err.(*wskderrors.JSONSchemaValidationError).Pointers)
+}
@@ -18,14 +18,15 @@
 package parsers
 
 import (
-	"encoding/base64"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"gopkg.in/yaml.v2"
 
@@ -119,20 +120,69 @@ func (dm *YAMLParser) ParseManifest(manifestPath string) (*YAML, error) {
 	}
 
 	err = mm.Unmarshal(content, &maniyaml)
+	if scalarUnitErr, ok := err.(*wskderrors.InvalidScalarUnitError); ok {
+		// limits are converted while they are unmarshalled, without the path of the manifest
+		scalarUnitErr.SetErrorFilePath(manifestPath)
+		return &maniyaml, scalarUnitErr
+	}
 	if err != nil {
 		return &maniyaml, wskderrors.NewYAMLParserErr(manifestPath, err)
 	}
 	maniyaml.Filepath = manifestPath
+	dm.projectName = maniyaml.Project.Name
+	if err = expandActionsFrom(&maniyaml, manifestPath); err != nil {
+		return &maniyaml, err
+	}
+	if err = expandFeeds(&maniyaml, manifestPath); err != nil {
+		return &maniyaml, err
+	}
+	if err = expandCompositions(&maniyaml, manifestPath); err != nil {
+		return &maniyaml, err
+	}
+	if err = expandSequenceSteps(&maniyaml, manifestPath); err != nil {
+		return &maniyaml, err
+	}
 	manifest := ReadEnvVariable(&maniyaml)
 
 	return manifest, nil
 }
 
-func (dm *YAMLParser) composeInputs(inputs map[string]Parameter, packageInputs PackageInputs, manifestFilePath string) (whisk.KeyValueArr, error) {
+// interpolationContext returns the context used to resolve expressions in manifest values,
+// ${inputs.x} refers to the inputs of the package, including the ones inherited from the project
+func (dm *YAMLParser) interpolationContext(manifestFilePath string, packageInputs PackageInputs) *wskenv.Context {
+	inputs := make(map[string]interface{}, len(packageInputs.Inputs))
+	for name, param := range packageInputs.Inputs {
+		inputs[name] = param.Value
+	}
+	projectName := dm.projectName
+	if len(utils.Flags.ProjectName) != 0 {
+		projectName = utils.Flags.ProjectName
+	}
+	return &wskenv.Context{
+		ProjectName: projectName,
+		BaseDir:     filepath.Dir(manifestFilePath),
+		Inputs:      inputs,
+	}
+}
+
+// interpolateName resolves the name of an entity, the name can either be
+// the name of a package input or contain expressions
+func (dm *YAMLParser) interpolateName(name string, packageInputs PackageInputs, ctx *wskenv.Context) (string, error) {
+	if i, ok := packageInputs.Inputs[wskenv.GetEnvVarName(name)]; ok {
+		if value, ok := i.Value.(string); ok {
+			return value, nil
+		}
+	}
+	return wskenv.InterpolateString(name, ctx)
+}
+
+// composeInputs resolves the inputs of an entity and records the source of their values
+func (dm *YAMLParser) composeInputs(entity string, inputs map[string]Parameter, packageInputs PackageInputs, manifestFilePath string) (whisk.KeyValueArr, error) {
 	var errorParser error
 	keyValArr := make(whisk.KeyValueArr, 0)
 	var inputsWithoutValue []string
 	var paramsCLI interface{}
+	ctx := dm.interpolationContext(manifestFilePath, packageInputs)
 
 	if len(utils.Flags.Param) > 0 {
 		paramsCLI, errorParser = utils.GetJSONFromStrings(utils.Flags.Param, false)
@@ -150,27 +200,36 @@ func (dm *YAMLParser) composeInputs(inputs map[string]Parameter, packageInputs P
 		if paramsCLI != nil {
 			// check if this particular input is specified on CLI
 			if v, ok := paramsCLI.(map[string]interface{})[name]; ok {
-				keyVal.Value = wskenv.ConvertSingleName(v.(string))
+				if keyVal.Value, errorParser = wskenv.InterpolateString(v.(string), ctx); errorParser != nil {
+					return nil, errorParser
+				}
+				if keyVal.Value, errorParser = CoerceParameterValue(name, &param, keyVal.Value, manifestFilePath, CLISource(name)); errorParser != nil {
+					return nil, errorParser
+				}
+				RecordParameterSource(entity, name, CLISource(name))
 			}
 		}
 		// if those inputs are not specified on CLI,
 		// read their values from the manifest file
 		if keyVal.Value == nil {
-			keyVal.Value, errorParser = ResolveParameter(name, &param, manifestFilePath)
+			keyVal.Value, errorParser = resolveParameter(name, &param, manifestFilePath, ctx)
 			if errorParser != nil {
 				return nil, errorParser
 			}
+			RecordParameterSource(entity, name, manifestParameterSource(manifestFilePath, param))
 			if param.Type == STRING && param.Value != nil {
 				if keyVal.Value == getTypeDefaultValue(param.Type) {
 					if packageInputs.Inputs != nil {
 						n := wskenv.GetEnvVarName(param.Value.(string))
 						if v, ok := packageInputs.Inputs[n]; ok {
 							keyVal.Value = v.Value.(string)
+							RecordParameterSource(entity, name, ParameterSource(PARAM_SOURCE_INPUTS, n))
 						}
 					}
 				}
 			}
 		}
+		RegisterSecretValue(param, keyVal.Value)
 		if keyVal.Value != nil {
 			keyValArr = append(keyValArr, keyVal)
 		}
@@ -188,16 +247,19 @@ func (dm *YAMLParser) composeInputs(inputs map[string]Parameter, packageInputs P
 	return keyValArr, nil
 }
 
-func (dm *YAMLParser) composeAnnotations(annotations map[string]interface{}) whisk.KeyValueArr {
+func (dm *YAMLParser) composeAnnotations(annotations map[string]interface{}, ctx *wskenv.Context) (whisk.KeyValueArr, error) {
 	listOfAnnotations := make(whisk.KeyValueArr, 0)
 	for name, value := range annotations {
 		var keyVal whisk.KeyValue
+		var err error
 		keyVal.Key = name
-		value = wskenv.InterpolateStringWithEnvVar(value)
+		if value, err = wskenv.Interpolate(value, ctx); err != nil {
+			return nil, err
+		}
 		keyVal.Value = utils.ConvertInterfaceValue(value)
 		listOfAnnotations = append(listOfAnnotations, keyVal)
 	}
-	return listOfAnnotations
+	return listOfAnnotations, nil
 }
 
 func (dm *YAMLParser) ComposeDependenciesFromAllPackages(manifest *YAML, projectPath string, filePath string, managedAnnotations whisk.KeyValue, packageInputs map[string]PackageInputs) (map[string]dependencies.DependencyRecord, error) {
@@ -246,7 +308,9 @@ func (dm *YAMLParser) ComposeDependencies(pkg Package, projectPath string, fileP
 			_, err := url.ParseRequestURI(location)
 			if err != nil {
 				location = HTTPS + dependency.Location
-				location = wskenv.InterpolateStringWithEnvVar(location).(string)
+				if location, err = wskenv.InterpolateString(location, dm.interpolationContext(filePath, packageInputs)); err != nil {
+					return nil, err
+				}
 			}
 			isBinding = false
 		} else {
@@ -254,16 +318,22 @@ func (dm *YAMLParser) ComposeDependencies(pkg Package, projectPath string, fileP
 			return nil, errors.New(wski18n.T(wski18n.ID_ERR_DEPENDENCY_UNKNOWN_TYPE))
 		}
 
-		inputs, err := dm.composeInputs(dependency.Inputs, packageInputs, filePath)
+		inputs, err := dm.composeInputs(ParameterEntity(YAML_KEY_DEPENDENCY, packageName+":"+key), dependency.Inputs, packageInputs, filePath)
 		if err != nil {
 			return nil, err
 		}
 
-		annotations := dm.composeAnnotations(dependency.Annotations)
+		annotations, err := dm.composeAnnotations(dependency.Annotations, dm.interpolationContext(filePath, packageInputs))
+		if err != nil {
+			return nil, err
+		}
 
 		if utils.Flags.Managed || utils.Flags.Sync {
 			annotations = append(annotations, managedAnnotations)
 		}
+		if names := secretInputs(dependency.Inputs); len(names) != 0 {
+			annotations = append(annotations, utils.SecretInputsAnnotation(names))
+		}
 
 		packDir := path.Join(projectPath, strings.Title(YAML_KEY_PACKAGES))
 		depName := packageName + ":" + key
@@ -305,7 +375,7 @@ func (dm *YAMLParser) ComposeAllPackages(projectInputs map[string]Parameter, man
 	return packages, inputs, nil
 }
 
-func (dm *YAMLParser) composePackageInputs(projectInputs map[string]Parameter, rawInputs map[string]Parameter, filepath string) (map[string]Parameter, whisk.KeyValueArr, error) {
+func (dm *YAMLParser) composePackageInputs(entity string, projectInputs map[string]Parameter, rawInputs map[string]Parameter, filepath string) (map[string]Parameter, whisk.KeyValueArr, error) {
 	inputs := make(map[string]Parameter, 0)
 
 	// package inherits all project inputs
@@ -313,16 +383,26 @@ func (dm *YAMLParser) composePackageInputs(projectInputs map[string]Parameter, r
 		inputs[n] = param
 	}
 
+	// package inputs can refer to project inputs with ${inputs.x}
+	ctx := dm.interpolationContext(filepath, PackageInputs{Inputs: projectInputs})
+
 	// iterate over package inputs
 	for name, i := range rawInputs {
-		value, err := ResolveParameter(name, &i, filepath)
+		value, err := resolveParameter(name, &i, filepath, ctx)
 		if err != nil {
 			return nil, nil, err
 		}
+		source := manifestParameterSource(filepath, i)
 		// if value is set to default value for its type,
 		// check for input key being an env. variable itself
 		if value == getTypeDefaultValue(i.Type) {
 			value = wskenv.InterpolateStringWithEnvVar("${" + name + "}")
+			if value, err = CoerceParameterValue(name, &i, value, filepath, EnvSource(name)); err != nil {
+				return nil, nil, err
+			}
+			if _, ok := wskenv.LookupEnv(name); ok {
+				source = EnvSource(name)
+			}
 		}
 
 		// if at this point, still value is set to default value of its type
@@ -332,9 +412,11 @@ func (dm *YAMLParser) composePackageInputs(projectInputs map[string]Parameter, r
 				n := wskenv.GetEnvVarName(i.Value.(string))
 				if v, ok := projectInputs[n]; ok {
 					value = v.Value.(string)
+					source = ParameterSource(PARAM_SOURCE_INPUTS, n)
 				}
 			}
 		}
+		RecordParameterSource(entity, name, source)
 
 		// create a Parameter object based on the package inputs
 		// resolve the value using env. variables
@@ -344,6 +426,7 @@ func (dm *YAMLParser) composePackageInputs(projectInputs map[string]Parameter, r
 		// the input value will be updated if its specified in deployment
 		// or on CLI using --param or --param-file
 		i.Value = value
+		RegisterSecretValue(i, value)
 		inputs[name] = i
 	}
 
@@ -389,7 +472,12 @@ func (dm *YAMLParser) ComposePackage(pkg Package, packageName string, filePath s
 		wskprint.PrintOpenWhiskWarning(warningString)
 		pkg.Version = DEFAULT_PACKAGE_VERSION
 	}
-	pag.Version = wskenv.ConvertSingleName(pkg.Version)
+	ctx := dm.interpolationContext(filePath, PackageInputs{PackageName: packageName, Inputs: projectInputs})
+	version, err := wskenv.InterpolateString(pkg.Version, ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	pag.Version = version
 
 	//License is a mandatory value
 	//set license to unknown if it is an empty string
@@ -417,7 +505,7 @@ func (dm *YAMLParser) ComposePackage(pkg Package, packageName string, filePath s
 	// package inputs are set as package inputs of type Parameter{}
 	// read all package inputs, interpolate their values using env. variables
 	// check if input variable itself is an env. variable
-	packageInputs, inputs, err := dm.composePackageInputs(projectInputs, pkg.Inputs, filePath)
+	packageInputs, inputs, err := dm.composePackageInputs(ParameterEntity(YAML_KEY_PACKAGE, packageName), projectInputs, pkg.Inputs, filePath)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -426,7 +514,11 @@ func (dm *YAMLParser) ComposePackage(pkg Package, packageName string, filePath s
 	}
 
 	// set Package Annotations
-	listOfAnnotations := dm.composeAnnotations(pkg.Annotations)
+	ctx = dm.interpolationContext(filePath, PackageInputs{PackageName: packageName, Inputs: packageInputs})
+	listOfAnnotations, err := dm.composeAnnotations(pkg.Annotations, ctx)
+	if err != nil {
+		return nil, nil, err
+	}
 	if len(listOfAnnotations) > 0 {
 		pag.Annotations = append(pag.Annotations, listOfAnnotations...)
 	}
@@ -436,6 +528,11 @@ func (dm *YAMLParser) ComposePackage(pkg Package, packageName string, filePath s
 		pag.Annotations = append(pag.Annotations, managedAnnotations)
 	}
 
+	// list the secret inputs, including the ones inherited from the project
+	if names := secretInputs(packageInputs); len(names) != 0 {
+		pag.Annotations = append(pag.Annotations, utils.SecretInputsAnnotation(names))
+	}
+
 	// "default" package is a reserved package name
 	// and in this case wskdeploy deploys openwhisk entities under
 	// /namespace instead of /namespace/package
@@ -465,8 +562,12 @@ func (dm *YAMLParser) ComposeSequencesFromAllPackages(namespace string, mani *YA
 		manifestPackages = mani.GetProject().Packages
 	}
 
+	if err := checkSequenceCycles(manifestFilePath, manifestPackages); err != nil {
+		return nil, err
+	}
+
 	for n, p := range manifestPackages {
-		s, err := dm.ComposeSequences(namespace, p.Sequences, n, manifestFilePath, managedAnnotations, packageInputs[n])
+		s, err := dm.ComposeSequences(namespace, p.Sequences, n, manifestFilePath, managedAnnotations, packageInputs[n], manifestPackages, mani.PackageDefaults(p))
 		if err == nil {
 			sequences = append(sequences, s...)
 		} else {
@@ -476,37 +577,51 @@ func (dm *YAMLParser) ComposeSequencesFromAllPackages(namespace string, mani *YA
 	return sequences, nil
 }
 
-func (dm *YAMLParser) ComposeSequences(namespace string, sequences map[string]Sequence, packageName string, manifestFilePath string, managedAnnotations whisk.KeyValue, packageInputs PackageInputs) ([]utils.ActionRecord, error) {
+// manifestPackages are used to check the outputs of each step of a sequence against the inputs of the next one,
+// defaults are inherited by the sequences that do not override them
+func (dm *YAMLParser) ComposeSequences(namespace string, sequences map[string]Sequence, packageName string, manifestFilePath string, managedAnnotations whisk.KeyValue, packageInputs PackageInputs, manifestPackages map[string]Package, defaults *Defaults) ([]utils.ActionRecord, error) {
 	var listOfSequences []utils.ActionRecord = make([]utils.ActionRecord, 0)
 	var errorParser error
+	ctx := dm.interpolationContext(manifestFilePath, packageInputs)
 
 	for key, sequence := range sequences {
+		sequence = sequence.withDefaults(defaults)
 		wskaction := new(whisk.Action)
 		wskaction.Exec = new(whisk.Exec)
 		wskaction.Exec.Kind = YAML_KEY_SEQUENCE
-		actionList := strings.Split(sequence.Actions, ",")
+		steps := sequence.Components()
 
 		var components []string
-		for _, a := range actionList {
-			act := strings.TrimSpace(a)
-			if !strings.ContainsRune(act, []rune(PATH_SEPARATOR)[0]) && !strings.HasPrefix(act, packageName+PATH_SEPARATOR) &&
-				strings.ToLower(packageName) != DEFAULT_PACKAGE {
-				act = path.Join(packageName, act)
+		for _, step := range steps {
+			act, ok := resolveSequenceStep(step, packageName, manifestPackages)
+			if !ok {
+				errMessage := wski18n.T(wski18n.ID_ERR_SEQUENCE_COMPONENT_UNRESOLVED_X_sequence_X_action_X,
+					map[string]interface{}{
+						wski18n.KEY_SEQUENCE: key,
+						wski18n.KEY_ACTION:   step})
+				return nil, wskderrors.NewYAMLFileFormatError(manifestFilePath, errMessage)
+			}
+			if !strings.HasPrefix(act, PATH_SEPARATOR) {
+				act = path.Join(PATH_SEPARATOR+namespace, act)
 			}
-			components = append(components, path.Join(PATH_SEPARATOR+namespace, act))
+			components = append(components, act)
 		}
 
 		wskaction.Exec.Components = components
-		if i, ok := packageInputs.Inputs[wskenv.GetEnvVarName(key)]; ok {
-			wskaction.Name = i.Value.(string)
-		} else {
-			wskaction.Name = wskenv.ConvertSingleName(key)
+		if wskaction.Name, errorParser = dm.interpolateName(key, packageInputs, ctx); errorParser != nil {
+			return nil, errorParser
+		}
+		if errorParser = dm.checkSequenceOutputs(manifestFilePath, wskaction.Name, packageName, steps, manifestPackages); errorParser != nil {
+			return nil, errorParser
 		}
 		pub := false
 		wskaction.Publish = &pub
 		wskaction.Namespace = namespace
 
-		annotations := dm.composeAnnotations(sequence.Annotations)
+		annotations, err := dm.composeAnnotations(sequence.Annotations, ctx)
+		if err != nil {
+			return nil, err
+		}
 		if len(annotations) > 0 {
 			wskaction.Annotations = annotations
 		}
@@ -546,8 +661,14 @@ func (dm *YAMLParser) ComposeActionsFromAllPackages(manifest *YAML, filePath str
 		manifestPackages = manifest.GetProject().Packages
 	}
 
+	if !dm.IsUndeploy {
+		if err := dm.buildActions(manifest, filePath); err != nil {
+			return nil, err
+		}
+	}
+
 	for n, p := range manifestPackages {
-		a, err := dm.ComposeActions(filePath, p.Actions, n, managedAnnotations, packageInputs[n])
+		a, err := dm.ComposeActions(filePath, p.Actions, n, managedAnnotations, packageInputs[n], manifest.PackageDefaults(p))
 		if err == nil {
 			actions = append(actions, a...)
 		} else {
@@ -635,12 +756,13 @@ func (dm *YAMLParser) validateActionFunction(manifestFileName string, action Act
 	return nil
 }
 
-func (dm *YAMLParser) readActionFunction(manifestFilePath string, manifestFileName string, action Action) (string, *whisk.Exec, error) {
+func (dm *YAMLParser) readActionFunction(manifestFilePath string, manifestFileName string, action Action, ctx *wskenv.Context) (string, *whisk.Exec, *utils.Artifact, error) {
 	var actionFilePath string
-	var zipFileName string
 	exec := new(whisk.Exec)
-	f := wskenv.InterpolateStringWithEnvVar(action.Function)
-	interpolatedActionFunction := f.(string)
+	interpolatedActionFunction, err := wskenv.InterpolateString(action.Function, ctx)
+	if err != nil {
+		return actionFilePath, nil, nil, err
+	}
 
 	// check if action function is pointing to an URL
 	// we do not support if function is pointing to remote directory
@@ -651,21 +773,37 @@ func (dm *YAMLParser) readActionFunction(manifestFilePath string, manifestFileNa
 				map[string]interface{}{
 					wski18n.KEY_ACTION: action.Name,
 					wski18n.KEY_URL:    interpolatedActionFunction})
-			return actionFilePath, nil, wskderrors.NewYAMLFileFormatError(manifestFilePath, err)
+			return actionFilePath, nil, nil, wskderrors.NewYAMLFileFormatError(manifestFilePath, err)
 		}
 		actionFilePath = interpolatedActionFunction
 	} else {
 		actionFilePath = strings.TrimRight(manifestFilePath, manifestFileName) + interpolatedActionFunction
+
+		// native actions are archived with their executable at the root
+		if action.Native {
+			archive, tmpDir, err := dm.packageNativeAction(action, actionFilePath)
+			if len(tmpDir) != 0 {
+				utils.AddTempArtifact(tmpDir)
+			}
+			if err != nil {
+				return actionFilePath, nil, nil, err
+			}
+			actionFilePath = archive
+		}
 	}
 
+	// a directory is archived into a temporary file, nothing is written to it
+	var artifact *utils.Artifact
 	if utils.IsDirectory(actionFilePath) {
-		zipFileName = actionFilePath + "." + runtimes.ZIP_FILE_EXTENSION
-		err := utils.NewZipWritter(actionFilePath, zipFileName, action.Include, action.Exclude, filepath.Dir(manifestFilePath)).Zip()
+		zipWritter := utils.NewZipWritter(actionFilePath, "", action.Include, action.Exclude, filepath.Dir(manifestFilePath))
+		artifact, err = utils.NewTempArtifact(true, zipWritter.ZipTo)
 		if err != nil {
-			return actionFilePath, nil, err
+			return actionFilePath, nil, nil, err
 		}
-		defer os.Remove(zipFileName)
-		actionFilePath = zipFileName
+		if utils.Flags.ListZipContents {
+			printZipContents(action.Name, actionFilePath, zipWritter.Contents())
+		}
+		actionFilePath = actionFilePath + "." + runtimes.ZIP_FILE_EXTENSION
 	}
 
 	action.Function = actionFilePath
@@ -678,23 +816,20 @@ func (dm *YAMLParser) readActionFunction(manifestFilePath string, manifestFileNa
 	}
 
 	// determine default runtime for the given file extension
-	var kind string
-	r := runtimes.FileExtensionRuntimeKindMap[ext]
-	kind = runtimes.DefaultRunTimes[r]
+	kind := runtimes.ExtensionKind(ext)
 	if err := dm.validateActionFunction(manifestFileName, action, ext, kind); err != nil {
-		return actionFilePath, nil, err
+		return actionFilePath, nil, nil, err
 	}
 	exec.Kind = kind
 
-	dat, err := utils.Read(actionFilePath)
-	if err != nil {
-		return actionFilePath, nil, err
-	}
-	code := string(dat)
-	if ext == runtimes.ZIP_FILE_EXTENSION || ext == runtimes.JAR_FILE_EXTENSION {
-		code = base64.StdEncoding.EncodeToString([]byte(dat))
+	// the code is read from the artifact when the action is deployed
+	if artifact == nil {
+		binary := ext == runtimes.ZIP_FILE_EXTENSION || ext == runtimes.JAR_FILE_EXTENSION
+		artifact, err = utils.NewArtifact(actionFilePath, binary)
+		if err != nil {
+			return actionFilePath, nil, nil, err
+		}
 	}
-	exec.Code = &code
 
 	/*
 	*  Action.Runtime
@@ -742,7 +877,7 @@ func (dm *YAMLParser) readActionFunction(manifestFilePath string, manifestFileNa
 			if ext == runtimes.ZIP_FILE_EXTENSION {
 				// for zip action, error out if specified runtime is not supported by
 				// OpenWhisk server
-				return actionFilePath, nil, wskderrors.NewInvalidRuntimeError(warnStr,
+				return actionFilePath, nil, nil, wskderrors.NewInvalidRuntimeError(warnStr,
 					manifestFileName,
 					action.Name,
 					action.Runtime,
@@ -766,24 +901,44 @@ func (dm *YAMLParser) readActionFunction(manifestFilePath string, manifestFileNa
 		exec.Main = action.Main
 	}
 
-	return actionFilePath, exec, nil
+	return actionFilePath, exec, artifact, nil
 }
 
-func (dm *YAMLParser) composeActionExec(manifestFilePath string, manifestFileName string, action Action) (string, *whisk.Exec, error) {
+// printZipContents prints the files in the archive of an action, as the name in the archive and the file it
+// comes from, followed by the files left out, each with the reason
+func printZipContents(actionName string, actionFilePath string, contents []utils.ZipContent) {
+	wskprint.PrintlnOpenWhiskOutput(wski18n.T(wski18n.ID_MSG_ZIP_CONTENTS_X_action_X_path_X,
+		map[string]interface{}{
+			wski18n.KEY_ACTION: actionName,
+			wski18n.KEY_PATH:   actionFilePath,
+		}))
+	for _, content := range contents {
+		if len(content.Name) != 0 {
+			wskprint.PrintlnOpenWhiskOutput(fmt.Sprintf("    + %s <- %s (%s)", content.Name, content.Path, content.Reason))
+		} else {
+			wskprint.PrintlnOpenWhiskOutput(fmt.Sprintf("    - %s (%s)", content.Path, content.Reason))
+		}
+	}
+}
+
+// composeActionExec also returns the artifact with the code of the function of the action, if any,
+// the code of the exec being read from the artifact only when the action is deployed
+func (dm *YAMLParser) composeActionExec(manifestFilePath string, manifestFileName string, action Action, ctx *wskenv.Context) (string, *whisk.Exec, *utils.Artifact, error) {
 	var actionFilePath string
+	var artifact *utils.Artifact
 	exec := new(whisk.Exec)
 	var err error
 
 	if len(action.Code) != 0 {
 		exec, err = dm.readActionCode(manifestFilePath, action)
 		if err != nil {
-			return actionFilePath, nil, err
+			return actionFilePath, nil, nil, err
 		}
 	}
 	if len(action.Function) != 0 {
-		actionFilePath, exec, err = dm.readActionFunction(manifestFilePath, manifestFileName, action)
+		actionFilePath, exec, artifact, err = dm.readActionFunction(manifestFilePath, manifestFileName, action, ctx)
 		if err != nil {
-			return actionFilePath, nil, err
+			return actionFilePath, nil, nil, err
 		}
 	}
 
@@ -797,11 +952,18 @@ func (dm *YAMLParser) composeActionExec(manifestFilePath string, manifestFileNam
 		if action.Native {
 			exec.Image = NATIVE_DOCKER_IMAGE
 		} else {
-			exec.Image = wskenv.InterpolateStringWithEnvVar(action.Docker).(string)
+			exec.Image, err = wskenv.InterpolateString(action.Docker, ctx)
+			if err == nil && !utils.ValidDockerImage(exec.Image) {
+				errMessage := wski18n.T(wski18n.ID_ERR_ACTION_DOCKER_IMAGE_INVALID_X_action_X_image_X,
+					map[string]interface{}{
+						wski18n.KEY_IMAGE:  exec.Image,
+						wski18n.KEY_ACTION: action.Name})
+				err = wskderrors.NewYAMLFileFormatError(manifestFilePath, errMessage)
+			}
 		}
 	}
 
-	return actionFilePath, exec, err
+	return actionFilePath, exec, artifact, err
 }
 
 func (dm *YAMLParser) validateActionLimits(limits Limits) {
@@ -813,43 +975,52 @@ func (dm *YAMLParser) validateActionLimits(limits Limits) {
 	utils.NotSupportLimits(limits.ParameterSize, LIMIT_VALUE_PARAMETER_SIZE)
 }
 
-func (dm *YAMLParser) composeActionLimits(limits Limits) *whisk.Limits {
+func (dm *YAMLParser) composeActionLimits(limits Limits, filePath string) (*whisk.Limits, error) {
+	var err error
 	dm.validateActionLimits(limits)
 	wsklimits := new(whisk.Limits)
 	for _, t := range LIMITS_SUPPORTED {
 		switch t {
 		case LIMIT_VALUE_TIMEOUT:
-			if utils.LimitsTimeoutValidation(limits.Timeout) {
-				wsklimits.Timeout = limits.Timeout
-			} else {
-				warningString := wski18n.T(wski18n.ID_WARN_LIMIT_IGNORED_X_limit_X,
-					map[string]interface{}{wski18n.KEY_LIMIT: LIMIT_VALUE_TIMEOUT})
-				wskprint.PrintOpenWhiskWarning(warningString)
-			}
+			wsklimits.Timeout, err = composeActionLimit(filePath, t, limits.Timeout, utils.ActionLimitRanges.Timeout)
 		case LIMIT_VALUE_MEMORY_SIZE:
-			if utils.LimitsMemoryValidation(limits.Memory) {
-				wsklimits.Memory = limits.Memory
-			} else {
-				warningString := wski18n.T(wski18n.ID_WARN_LIMIT_IGNORED_X_limit_X,
-					map[string]interface{}{wski18n.KEY_LIMIT: LIMIT_VALUE_MEMORY_SIZE})
-				wskprint.PrintOpenWhiskWarning(warningString)
-
-			}
+			wsklimits.Memory, err = composeActionLimit(filePath, t, limits.Memory, utils.ActionLimitRanges.Memory)
 		case LIMIT_VALUE_LOG_SIZE:
-			if utils.LimitsLogsizeValidation(limits.Logsize) {
-				wsklimits.Logsize = limits.Logsize
-			} else {
-				warningString := wski18n.T(wski18n.ID_WARN_LIMIT_IGNORED_X_limit_X,
-					map[string]interface{}{wski18n.KEY_LIMIT: LIMIT_VALUE_LOG_SIZE})
-				wskprint.PrintOpenWhiskWarning(warningString)
-
-			}
+			wsklimits.Logsize, err = composeActionLimit(filePath, t, limits.Logsize, utils.ActionLimitRanges.Logsize)
+		case LIMIT_VALUE_CONCURRENCY:
+			wsklimits.Concurrency, err = composeActionLimit(filePath, t, limits.Concurrency, utils.ActionLimitRanges.Concurrency)
+		}
+		if err != nil {
+			return nil, err
 		}
 	}
-	if wsklimits.Timeout != nil || wsklimits.Memory != nil || wsklimits.Logsize != nil {
-		return wsklimits
+	if wsklimits.Timeout != nil || wsklimits.Memory != nil || wsklimits.Logsize != nil || wsklimits.Concurrency != nil {
+		return wsklimits, nil
 	}
-	return nil
+	return nil, nil
+}
+
+// composeActionLimit validates a limit against the range supported by the provider,
+// a value above the maximum is passed through to the provider unless in strict mode
+func composeActionLimit(filePath string, name string, value *int, limitRange utils.LimitRange) (*int, error) {
+	if value == nil {
+		return nil, nil
+	}
+	if utils.Flags.Strict && *value > limitRange.Max {
+		errMessage := wski18n.T(wski18n.ID_ERR_LIMIT_ABOVE_MAX_X_limit_X_value_X_max_X,
+			map[string]interface{}{
+				wski18n.KEY_LIMIT:     name,
+				wski18n.KEY_VALUE:     *value,
+				wski18n.KEY_VALUE_MAX: limitRange.Max})
+		return nil, wskderrors.NewYAMLFileFormatError(filePath, errMessage)
+	}
+	if !utils.LimitValidation(name, value, limitRange) {
+		warningString := wski18n.T(wski18n.ID_WARN_LIMIT_IGNORED_X_limit_X,
+			map[string]interface{}{wski18n.KEY_LIMIT: name})
+		wskprint.PrintOpenWhiskWarning(warningString)
+		return nil, nil
+	}
+	return value, nil
 }
 
 func (dm *YAMLParser) warnIfRedundantWebActionFlags(action Action) {
@@ -862,19 +1033,23 @@ func (dm *YAMLParser) warnIfRedundantWebActionFlags(action Action) {
 	}
 }
 
-func (dm *YAMLParser) ComposeActions(manifestFilePath string, actions map[string]Action, packageName string, managedAnnotations whisk.KeyValue, packageInputs PackageInputs) ([]utils.ActionRecord, error) {
+// defaults are inherited by the actions that do not override them
+func (dm *YAMLParser) ComposeActions(manifestFilePath string, actions map[string]Action, packageName string, managedAnnotations whisk.KeyValue, packageInputs PackageInputs, defaults *Defaults) ([]utils.ActionRecord, error) {
 
 	var errorParser error
 	var listOfActions []utils.ActionRecord = make([]utils.ActionRecord, 0)
 	splitManifestFilePath := strings.Split(manifestFilePath, string(PATH_SEPARATOR))
 	manifestFileName := splitManifestFilePath[len(splitManifestFilePath)-1]
+	ctx := dm.interpolationContext(manifestFilePath, packageInputs)
 
 	for actionName, action := range actions {
 		var actionFilePath string
+		var artifact *utils.Artifact
 
 		// update the action (of type Action) to set its name
 		// here key name is the action name
 		action.Name = actionName
+		action = action.withDefaults(defaults)
 
 		// Create action data object from client library
 		wskaction := new(whisk.Action)
@@ -885,13 +1060,29 @@ func (dm *YAMLParser) ComposeActions(manifestFilePath string, actions map[string
 			action.Function = action.Location
 		}
 
-		actionFilePath, wskaction.Exec, errorParser = dm.composeActionExec(manifestFilePath, manifestFileName, action)
-		if errorParser != nil {
-			return nil, errorParser
+		// the output of the build is the function of the action unless it sets one
+		built := len(action.Function) == 0 && len(action.Code) == 0 && action.Build != nil
+		if built {
+			action.Function, errorParser = buildFunction(manifestFilePath, action)
+			if errorParser != nil {
+				return nil, wskderrors.NewYAMLFileFormatError(manifestFilePath, errorParser.Error())
+			}
+		}
+
+		// the output of a build is not read when the action is undeployed, it may not exist
+		if action.Native && (dm.IsUndeploy || utils.Flags.Preview) {
+			wskaction.Exec = nativeExecWithoutCode()
+		} else if built && dm.IsUndeploy {
+			wskaction.Exec = undeployBuildExec(action)
+		} else {
+			actionFilePath, wskaction.Exec, artifact, errorParser = dm.composeActionExec(manifestFilePath, manifestFileName, action, ctx)
+			if errorParser != nil {
+				return nil, errorParser
+			}
 		}
 
 		// Action.Inputs
-		listOfInputs, err := dm.composeInputs(action.Inputs, packageInputs, manifestFilePath)
+		listOfInputs, err := dm.composeInputs(ParameterEntity(YAML_KEY_ACTION, packageName+"/"+actionName), action.Inputs, packageInputs, manifestFilePath)
 		if err != nil {
 			return nil, err
 		}
@@ -900,20 +1091,24 @@ func (dm *YAMLParser) ComposeActions(manifestFilePath string, actions map[string
 		}
 
 		// Action.Outputs
-		// TODO{} add outputs as annotations (work to discuss officially supporting for compositions)
-		//listOfOutputs, err := dm.composeOutputs(action.Outputs, manifestFilePath)
-		//if err != nil {
-		//	return nil, err
-		//}
-		//if len(listOfOutputs) > 0 {
-		//	wskaction.Annotations = listOfOutputs
-		//}
+		// declared outputs are published with the "outputs" annotation
+		if len(action.Outputs) > 0 {
+			outputs, err := dm.composeOutputs(action.Outputs, manifestFilePath)
+			if err != nil {
+				return nil, err
+			}
+			wskaction.Annotations = append(wskaction.Annotations, whisk.KeyValue{Key: ANNOTATION_OUTPUTS, Value: outputs})
+		}
 
 		// Action.Annotations
 		// ==================
 		// WARNING!  Processing of explicit Annotations MUST occur before handling of Action keys, as these
 		// keys often need to check for inconsistencies (and raise errors).
-		if listOfAnnotations := dm.composeAnnotations(action.Annotations); len(listOfAnnotations) > 0 {
+		listOfAnnotations, err := dm.composeAnnotations(action.Annotations, ctx)
+		if err != nil {
+			return nil, err
+		}
+		if len(listOfAnnotations) > 0 {
 			wskaction.Annotations = append(wskaction.Annotations, listOfAnnotations...)
 		}
 
@@ -921,6 +1116,9 @@ func (dm *YAMLParser) ComposeActions(manifestFilePath string, actions map[string
 		if utils.Flags.Managed || utils.Flags.Sync {
 			wskaction.Annotations = append(wskaction.Annotations, managedAnnotations)
 		}
+		if names := secretInputs(action.Inputs); len(names) != 0 {
+			wskaction.Annotations = append(wskaction.Annotations, utils.SecretInputsAnnotation(names))
+		}
 
 		// Web Export (i.e., "web-export" annotation)
 		// ==========
@@ -956,7 +1154,11 @@ func (dm *YAMLParser) ComposeActions(manifestFilePath string, actions map[string
 
 		// Action.Limits
 		if action.Limits != nil {
-			if wsklimits := dm.composeActionLimits(*(action.Limits)); wsklimits != nil {
+			wsklimits, err := dm.composeActionLimits(*(action.Limits), manifestFilePath)
+			if err != nil {
+				return nil, err
+			}
+			if wsklimits != nil {
 				wskaction.Limits = wsklimits
 			}
 		}
@@ -967,14 +1169,23 @@ func (dm *YAMLParser) ComposeActions(manifestFilePath string, actions map[string
 		}
 
 		// Set other top-level values for the action (e.g., name, version, publish, etc.)
-		wskaction.Name = actionName
+		if wskaction.Name, err = dm.interpolateName(actionName, packageInputs, ctx); err != nil {
+			return nil, err
+		}
 		pub := false
 		wskaction.Publish = &pub
-		wskaction.Version = wskenv.ConvertSingleName(action.Version)
+		if wskaction.Version, err = wskenv.InterpolateString(action.Version, ctx); err != nil {
+			return nil, err
+		}
 
 		// create a "record" of the Action relative to its package and function filepath
 		// which will be used to compose the REST API calls
-		record := utils.ActionRecord{Action: wskaction, Packagename: packageName, Filepath: actionFilePath}
+		record := utils.ActionRecord{Action: wskaction, Packagename: packageName, Filepath: actionFilePath, Artifact: artifact}
+		if artifact != nil {
+			record.Digest = artifact.Digest
+		} else if wskaction.Exec.Code != nil {
+			record.Digest = utils.Digest([]byte(*wskaction.Exec.Code))
+		}
 		listOfActions = append(listOfActions, record)
 	}
 
@@ -1005,13 +1216,12 @@ func (dm *YAMLParser) ComposeTriggersFromAllPackages(manifest *YAML, filePath st
 func (dm *YAMLParser) ComposeTriggers(filePath string, pkg Package, managedAnnotations whisk.KeyValue, packageInputs PackageInputs) ([]*whisk.Trigger, error) {
 	var errorParser error
 	var listOfTriggers []*whisk.Trigger = make([]*whisk.Trigger, 0)
+	ctx := dm.interpolationContext(filePath, packageInputs)
 
 	for _, trigger := range pkg.GetTriggerList() {
 		wsktrigger := new(whisk.Trigger)
-		if i, ok := packageInputs.Inputs[wskenv.GetEnvVarName(trigger.Name)]; ok {
-			wsktrigger.Name = i.Value.(string)
-		} else {
-			wsktrigger.Name = wskenv.ConvertSingleName(trigger.Name)
+		if wsktrigger.Name, errorParser = dm.interpolateName(trigger.Name, packageInputs, ctx); errorParser != nil {
+			return nil, errorParser
 		}
 		wsktrigger.Namespace = trigger.Namespace
 		pub := false
@@ -1033,7 +1243,19 @@ func (dm *YAMLParser) ComposeTriggers(filePath string, pkg Package, managedAnnot
 
 		// replacing env. variables here in the trigger feed name
 		// to support trigger feed with $READ_FROM_ENV_TRIGGER_FEED
-		trigger.Feed = wskenv.ConvertSingleName(trigger.Feed)
+		if trigger.Feed, errorParser = wskenv.InterpolateString(trigger.Feed, ctx); errorParser != nil {
+			return nil, errorParser
+		}
+
+		// a schedule is deployed through the alarm feed
+		if trigger.Schedule != nil {
+			if len(trigger.Feed) != 0 {
+				errMessage := wski18n.T(wski18n.ID_ERR_SCHEDULE_WITH_FEED_X_trigger_X,
+					map[string]interface{}{wski18n.KEY_TRIGGER: wsktrigger.Name})
+				return nil, wskderrors.NewYAMLFileFormatError(filePath, errMessage)
+			}
+			trigger.Feed = ScheduleFeed()
+		}
 
 		keyValArr := make(whisk.KeyValueArr, 0)
 		if len(trigger.Feed) != 0 {
@@ -1044,15 +1266,27 @@ func (dm *YAMLParser) ComposeTriggers(filePath string, pkg Package, managedAnnot
 			wsktrigger.Annotations = keyValArr
 		}
 
-		inputs, err := dm.composeInputs(trigger.Inputs, packageInputs, filePath)
+		inputs, err := dm.composeInputs(ParameterEntity(YAML_KEY_TRIGGER, wsktrigger.Name), trigger.Inputs, packageInputs, filePath)
 		if err != nil {
-			return nil, errorParser
+			return nil, err
+		}
+		if trigger.Schedule != nil {
+			scheduleInputs, err := dm.composeSchedule(wsktrigger.Name, *trigger.Schedule, ctx, filePath, time.Now())
+			if err != nil {
+				return nil, err
+			}
+			for i := range scheduleInputs {
+				inputs = inputs.AddOrReplace(&scheduleInputs[i])
+			}
 		}
 		if len(inputs) > 0 {
 			wsktrigger.Parameters = inputs
 		}
 
-		listOfAnnotations := dm.composeAnnotations(trigger.Annotations)
+		listOfAnnotations, err := dm.composeAnnotations(trigger.Annotations, ctx)
+		if err != nil {
+			return nil, err
+		}
 		if len(listOfAnnotations) > 0 {
 			wsktrigger.Annotations = append(wsktrigger.Annotations, listOfAnnotations...)
 		}
@@ -1061,12 +1295,78 @@ func (dm *YAMLParser) ComposeTriggers(filePath string, pkg Package, managedAnnot
 		if utils.Flags.Managed || utils.Flags.Sync {
 			wsktrigger.Annotations = append(wsktrigger.Annotations, managedAnnotations)
 		}
+		if names := secretInputs(trigger.Inputs); len(names) != 0 {
+			wsktrigger.Annotations = append(wsktrigger.Annotations, utils.SecretInputsAnnotation(names))
+		}
 
 		listOfTriggers = append(listOfTriggers, wsktrigger)
 	}
 	return listOfTriggers, nil
 }
 
+func (dm *YAMLParser) ComposeFeedsFromAllPackages(manifest *YAML, filePath string, inputs map[string]PackageInputs) ([]utils.FeedRecord, error) {
+	var feeds []utils.FeedRecord = make([]utils.FeedRecord, 0)
+	manifestPackages := make(map[string]Package)
+
+	if len(manifest.Packages) != 0 {
+		manifestPackages = manifest.Packages
+	} else {
+		manifestPackages = manifest.GetProject().Packages
+	}
+
+	for packageName, pkg := range manifestPackages {
+		f, err := dm.ComposeFeeds(filePath, pkg, packageName, inputs[packageName])
+		if err == nil {
+			feeds = append(feeds, f...)
+		} else {
+			return nil, err
+		}
+	}
+	return feeds, nil
+}
+
+// ComposeFeeds returns the feeds of the package deployed as feed actions,
+// with the inputs of their operations keyed by lifecycle event
+func (dm *YAMLParser) ComposeFeeds(filePath string, pkg Package, packageName string, packageInputs PackageInputs) ([]utils.FeedRecord, error) {
+	var listOfFeeds []utils.FeedRecord = make([]utils.FeedRecord, 0)
+
+	for _, feed := range pkg.GetFeedList() {
+		feedName, ok := pkg.feedActionName(packageName, feed.Name)
+		if !ok {
+			continue
+		}
+		record := utils.FeedRecord{
+			Name:        feedName,
+			Packagename: packageName,
+			Operations:  make(map[string]whisk.KeyValueArr),
+		}
+		for operation, op := range feed.Operations {
+			event, ok := feedLifecycleEvent(operation)
+			if !ok {
+				errMessage := wski18n.T(wski18n.ID_ERR_FEED_OPERATION_X_feed_X_key_X,
+					map[string]interface{}{
+						wski18n.KEY_TRIGGER_FEED: feed.Name,
+						wski18n.KEY_KEY:          operation})
+				return nil, wskderrors.NewYAMLFileFormatError(filePath, errMessage)
+			}
+			if !isFeedOperationEvent(event) {
+				errMessage := wski18n.T(wski18n.ID_ERR_FEED_OPERATION_NOT_INVOKED_X_feed_X_key_X,
+					map[string]interface{}{
+						wski18n.KEY_TRIGGER_FEED: feed.Name,
+						wski18n.KEY_KEY:          operation})
+				return nil, wskderrors.NewYAMLFileFormatError(filePath, errMessage)
+			}
+			inputs, err := dm.composeInputs(ParameterEntity(YAML_KEY_FEED, feedName+" "+event), op.Inputs, packageInputs, filePath)
+			if err != nil {
+				return nil, err
+			}
+			record.Operations[event] = inputs
+		}
+		listOfFeeds = append(listOfFeeds, record)
+	}
+	return listOfFeeds, nil
+}
+
 func (dm *YAMLParser) ComposeRulesFromAllPackages(manifest *YAML, managedAnnotations whisk.KeyValue, packageInputs map[string]PackageInputs) ([]*whisk.Rule, error) {
 	var rules []*whisk.Rule = make([]*whisk.Rule, 0)
 	manifestPackages := make(map[string]Package)
@@ -1078,7 +1378,7 @@ func (dm *YAMLParser) ComposeRulesFromAllPackages(manifest *YAML, managedAnnotat
 	}
 
 	for n, p := range manifestPackages {
-		r, err := dm.ComposeRules(p, n, managedAnnotations, packageInputs[n])
+		r, err := dm.ComposeRules(manifest.Filepath, p, n, managedAnnotations, packageInputs[n])
 		if err == nil {
 			rules = append(rules, r...)
 		} else {
@@ -1088,36 +1388,37 @@ func (dm *YAMLParser) ComposeRulesFromAllPackages(manifest *YAML, managedAnnotat
 	return rules, nil
 }
 
-func (dm *YAMLParser) ComposeRules(pkg Package, packageName string, managedAnnotations whisk.KeyValue, packageInputs PackageInputs) ([]*whisk.Rule, error) {
+func (dm *YAMLParser) ComposeRules(filePath string, pkg Package, packageName string, managedAnnotations whisk.KeyValue, packageInputs PackageInputs) ([]*whisk.Rule, error) {
 	var rules []*whisk.Rule = make([]*whisk.Rule, 0)
+	var err error
+	ctx := dm.interpolationContext(filePath, packageInputs)
 
 	for _, rule := range pkg.GetRuleList() {
 		wskrule := new(whisk.Rule)
-		if i, ok := packageInputs.Inputs[wskenv.GetEnvVarName(rule.Name)]; ok {
-			wskrule.Name = i.Value.(string)
-		} else {
-			wskrule.Name = wskenv.ConvertSingleName(rule.Name)
+		if wskrule.Name, err = dm.interpolateName(rule.Name, packageInputs, ctx); err != nil {
+			return nil, err
 		}
 		//wskrule.Namespace = rule.Namespace
 		pub := false
 		wskrule.Publish = &pub
-		if i, ok := packageInputs.Inputs[wskenv.GetEnvVarName(rule.Trigger)]; ok {
-			wskrule.Trigger = i.Value.(string)
-		} else {
-			wskrule.Trigger = wskenv.ConvertSingleName(rule.Trigger)
+		var trigger, action string
+		if trigger, err = dm.interpolateName(rule.Trigger, packageInputs, ctx); err != nil {
+			return nil, err
 		}
-		if i, ok := packageInputs.Inputs[wskenv.GetEnvVarName(rule.Action)]; ok {
-			wskrule.Action = i.Value.(string)
-		} else {
-			wskrule.Action = wskenv.ConvertSingleName(rule.Action)
+		if action, err = dm.interpolateName(rule.Action, packageInputs, ctx); err != nil {
+			return nil, err
 		}
-		act := strings.TrimSpace(wskrule.Action.(string))
+		wskrule.Trigger = trigger
+		act := strings.TrimSpace(action)
 		if !strings.ContainsRune(act, []rune(PATH_SEPARATOR)[0]) && !strings.HasPrefix(act, packageName+PATH_SEPARATOR) &&
 			strings.ToLower(packageName) != DEFAULT_PACKAGE {
 			act = path.Join(packageName, act)
 		}
 		wskrule.Action = act
-		listOfAnnotations := dm.composeAnnotations(rule.Annotations)
+		listOfAnnotations, err := dm.composeAnnotations(rule.Annotations, ctx)
+		if err != nil {
+			return nil, err
+		}
 		if len(listOfAnnotations) > 0 {
 			wskrule.Annotations = append(wskrule.Annotations, listOfAnnotations...)
 		}
@@ -1134,7 +1435,7 @@ func (dm *YAMLParser) ComposeRules(pkg Package, packageName string, managedAnnot
 
 func (dm *YAMLParser) ComposeApiRecordsFromAllPackages(client *whisk.Config, manifest *YAML,
 	actionrecords []utils.ActionRecord,
-	sequencerecords []utils.ActionRecord) ([]*whisk.ApiCreateRequest, map[string]*whisk.ApiCreateRequestOptions, error) {
+	sequencerecords []utils.ActionRecord, packageInputs map[string]PackageInputs) ([]*whisk.ApiCreateRequest, map[string]*whisk.ApiCreateRequestOptions, error) {
 	var requests = make([]*whisk.ApiCreateRequest, 0)
 	var responses = make(map[string]*whisk.ApiCreateRequestOptions, 0)
 	manifestPackages := make(map[string]Package)
@@ -1147,7 +1448,7 @@ func (dm *YAMLParser) ComposeApiRecordsFromAllPackages(client *whisk.Config, man
 
 	for packageName, p := range manifestPackages {
 		r, response, err := dm.ComposeApiRecords(client, packageName, p, manifest.Filepath,
-			actionrecords, sequencerecords)
+			actionrecords, sequencerecords, packageInputs[packageName])
 		if err == nil {
 			requests = append(requests, r...)
 			for k, v := range response {
@@ -1186,7 +1487,7 @@ func (dm *YAMLParser) ComposeApiRecordsFromAllPackages(client *whisk.Config, man
  * }
  */
 func (dm *YAMLParser) ComposeApiRecords(client *whisk.Config, packageName string, pkg Package, manifestPath string,
-	actionrecords []utils.ActionRecord, sequencerecords []utils.ActionRecord) ([]*whisk.ApiCreateRequest, map[string]*whisk.ApiCreateRequestOptions, error) {
+	actionrecords []utils.ActionRecord, sequencerecords []utils.ActionRecord, packageInputs PackageInputs) ([]*whisk.ApiCreateRequest, map[string]*whisk.ApiCreateRequestOptions, error) {
 	var requests = make([]*whisk.ApiCreateRequest, 0)
 
 	// supply a dummy API GW token as it is optional
@@ -1200,9 +1501,17 @@ func (dm *YAMLParser) ComposeApiRecords(client *whisk.Config, packageName string
 	}
 
 	requestOptions := make(map[string]*whisk.ApiCreateRequestOptions, 0)
+	ctx := dm.interpolationContext(manifestPath, packageInputs)
+	var err error
 
 	for apiName, apiDoc := range pkg.Apis {
+		if apiName, err = wskenv.InterpolateString(apiName, ctx); err != nil {
+			return requests, requestOptions, err
+		}
 		for gatewayBasePath, gatewayBasePathMap := range apiDoc {
+			if gatewayBasePath, err = wskenv.InterpolateString(gatewayBasePath, ctx); err != nil {
+				return requests, requestOptions, err
+			}
 			// Base Path
 			// validate base path should not have any path parameters
 			if !isGatewayBasePathValid(gatewayBasePath) {
@@ -1216,6 +1525,9 @@ func (dm *YAMLParser) ComposeApiRecords(client *whisk.Config, packageName string
 				gatewayBasePath = PATH_SEPARATOR + gatewayBasePath
 			}
 			for gatewayRelPath, gatewayRelPathMap := range gatewayBasePathMap {
+				if gatewayRelPath, err = wskenv.InterpolateString(gatewayRelPath, ctx); err != nil {
+					return requests, requestOptions, err
+				}
 				// Relative Path
 				// append "/" to the gateway relative path if its missing
 				if !strings.HasPrefix(gatewayRelPath, PATH_SEPARATOR) {
@@ -30,6 +30,7 @@ import (
 	"testing"
 
 	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/sciabarracom/openwhisk-wskdeploy/conductor"
 	"github.com/sciabarracom/openwhisk-wskdeploy/runtimes"
 	"github.com/sciabarracom/openwhisk-wskdeploy/utils"
 	"github.com/sciabarracom/openwhisk-wskdeploy/wskderrors"
@@ -67,13 +68,21 @@ const (
 	TEST_ERROR_COMPOSE_DEPENDENCY_FAILURE = "Manifest [%s]: Failed to compose dependencies."
 )
 
-func init() {
+func TestMain(m *testing.M) {
+	// the catalog of runtimes is cached in a temporary directory, not in the user config directory
+	dir, err := ioutil.TempDir("", "runtimes")
+	if err == nil {
+		runtimes.CatalogCacheDir = dir
+	}
 	op, error := runtimes.ParseOpenWhisk("")
 	if error == nil {
 		runtimes.SupportedRunTimes = runtimes.ConvertToMap(op)
 		runtimes.DefaultRunTimes = runtimes.DefaultRuntimes(op)
 		runtimes.FileExtensionRuntimeKindMap = runtimes.FileExtensionRuntimes(op)
 	}
+	code := m.Run()
+	os.RemoveAll(dir)
+	os.Exit(code)
 }
 
 func testLoadParseManifest(t *testing.T, manifestFile string) (*YAMLParser, *YAML, error) {
@@ -900,7 +909,7 @@ func TestComposeActionsForFunction(t *testing.T) {
 			actualResult, _ = filepath.Abs(actions[i].Filepath)
 			assert.Equal(t, expectedResult, actualResult, "Expected "+expectedResult+" but got "+actualResult)
 		} else if actions[i].Action.Name == "hello2" {
-			assert.NotNil(t, actions[i].Action.Exec.Code, "Expected source code from an action file but found it empty")
+			assert.NotNil(t, actions[i].Artifact, "Expected source code from an action file but found it empty")
 		}
 	}
 }
@@ -953,16 +962,16 @@ func TestComposeActionsForDocker(t *testing.T) {
 			assert.Equal(t, NATIVE_DOCKER_IMAGE, action.Action.Exec.Image, TEST_MSG_ACTION_DOCKER_IMAGE_MISMATCH)
 		case "CustomDockerAction3":
 		case "CustomDockerAction4":
-			assert.NotNil(t, action.Action.Exec.Code, TEST_MSG_ACTION_CODE_MISSING)
+			assert.NotNil(t, action.Artifact, TEST_MSG_ACTION_CODE_MISSING)
 			assert.Equal(t, runtimes.BLACKBOX, action.Action.Exec.Kind, fmt.Sprintf(TEST_MSG_ACTION_DOCKER_KIND_MISMATCH, action.Action.Exec.Kind))
 			assert.Equal(t, NATIVE_DOCKER_IMAGE, action.Action.Exec.Image, TEST_MSG_ACTION_DOCKER_IMAGE_MISMATCH)
 		case "CustomDockerAction5":
-			assert.NotNil(t, action.Action.Exec.Code, TEST_MSG_ACTION_CODE_MISSING)
+			assert.NotNil(t, action.Artifact, TEST_MSG_ACTION_CODE_MISSING)
 			assert.Equal(t, runtimes.BLACKBOX, action.Action.Exec.Kind, fmt.Sprintf(TEST_MSG_ACTION_DOCKER_KIND_MISMATCH, action.Action.Exec.Kind))
 			assert.Equal(t, "mydockerhub/myimage", action.Action.Exec.Image, TEST_MSG_ACTION_DOCKER_IMAGE_MISMATCH)
 		case "CustomDockerAction6":
 			println(action.Action.Exec.Image)
-			assert.NotNil(t, action.Action.Exec.Code, TEST_MSG_ACTION_CODE_MISSING)
+			assert.NotNil(t, action.Artifact, TEST_MSG_ACTION_CODE_MISSING)
 			assert.Equal(t, runtimes.BLACKBOX, action.Action.Exec.Kind, fmt.Sprintf(TEST_MSG_ACTION_DOCKER_KIND_MISMATCH, action.Action.Exec.Kind))
 			assert.Equal(t, os.Getenv("image_name"), action.Action.Exec.Image, TEST_MSG_ACTION_DOCKER_IMAGE_MISMATCH)
 		}
@@ -1008,6 +1017,182 @@ func TestComposeActionsForLimits(t *testing.T) {
 			assert.Equal(t, 180, *actions[i].Action.Limits.Timeout, "Failed to get Timeout")
 			assert.Equal(t, 128, *actions[i].Action.Limits.Memory, "Failed to get Memory")
 			assert.Equal(t, 1, *actions[i].Action.Limits.Logsize, "Failed to get Logsize")
+			assert.Equal(t, 10, *actions[i].Action.Limits.Concurrency, "Failed to get Concurrency")
+		}
+	}
+}
+
+// validate limits against the ranges reported by the provider
+func TestComposeActionsForLimitRanges(t *testing.T) {
+
+	file := "../tests/dat/manifest_data_compose_actions_for_limits.yaml"
+	p, m, _ := testLoadParseManifest(t, file)
+
+	defer func() {
+		utils.ActionLimitRanges = utils.DefaultActionLimitRanges
+		utils.Flags.Strict = false
+	}()
+	utils.ActionLimitRanges.Timeout = utils.LimitRange{Min: 100, Max: 300000}
+
+	// values above the maximum are passed through to the provider
+	actions, err := p.ComposeActionsFromAllPackages(m, m.Filepath, whisk.KeyValue{}, map[string]PackageInputs{})
+	assert.Nil(t, err, fmt.Sprintf(TEST_ERROR_COMPOSE_ACTION_FAILURE, file))
+	for _, action := range actions {
+		if action.Action.Name == "hello1" {
+			assert.Equal(t, 600000, *action.Action.Limits.Timeout, "Failed to get Timeout")
+		}
+	}
+
+	// and are errors in strict mode
+	utils.Flags.Strict = true
+	_, err = p.ComposeActionsFromAllPackages(m, m.Filepath, whisk.KeyValue{}, map[string]PackageInputs{})
+	assert.NotNil(t, err, "Expected an error for a timeout above the maximum")
+	assert.Contains(t, err.Error(), "[300000]")
+}
+
+// validate that actions and sequences inherit the defaults of their package and project
+func TestComposeActionsForDefaults(t *testing.T) {
+
+	file := "../tests/dat/manifest_data_compose_actions_for_defaults.yaml"
+	p, m, _ := testLoadParseManifest(t, file)
+
+	actions, err := p.ComposeActionsFromAllPackages(m, m.Filepath, whisk.KeyValue{}, map[string]PackageInputs{})
+	assert.Nil(t, err, fmt.Sprintf(TEST_ERROR_COMPOSE_ACTION_FAILURE, file))
+	assert.Equal(t, 3, len(actions))
+
+	for _, action := range actions {
+		owner := action.Action.Annotations.GetValue("owner").(map[string]interface{})
+		assert.Equal(t, "platform", owner["team"], "Failed to inherit a nested annotation")
+		assert.Equal(t, "samples", action.Action.Annotations.GetValue("category"), "Failed to inherit an annotation")
+		assert.Equal(t, 512, *action.Action.Limits.Memory, "Failed to inherit the memorySize limit")
+
+		switch action.Action.Name {
+		case "hello":
+			assert.Equal(t, "nodejs:default", action.Action.Exec.Kind, "Failed to inherit the runtime")
+			assert.Equal(t, 60000, *action.Action.Limits.Timeout, "Failed to inherit the project timeout")
+			assert.Equal(t, "ops@example.com", owner["contact"])
+			assert.Equal(t, true, action.Action.Annotations.GetValue("web-export"), "Failed to inherit web")
+		case "hellopy":
+			assert.Equal(t, "python:3", action.Action.Exec.Kind, "Failed to override the runtime")
+			assert.Equal(t, 180000, *action.Action.Limits.Timeout, "Failed to override the timeout")
+			assert.Equal(t, "dev@example.com", owner["contact"], "Failed to merge a nested annotation")
+			assert.Equal(t, false, action.Action.Annotations.GetValue("web-export"), "Failed to override web")
+		case "hellodocker":
+			assert.Equal(t, runtimes.BLACKBOX, action.Action.Exec.Kind, "The runtime must not apply to docker actions")
+		}
+	}
+
+	sequences, err := p.ComposeSequencesFromAllPackages("", m, m.Filepath, whisk.KeyValue{}, map[string]PackageInputs{})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(sequences))
+	assert.Equal(t, "samples", sequences[0].Action.Annotations.GetValue("category"), "Failed to inherit an annotation")
+	assert.Equal(t, true, sequences[0].Action.Annotations.GetValue("web-export"), "Failed to inherit web")
+	assert.Nil(t, sequences[0].Action.Limits)
+}
+
+// validate that actions-from declares an action for each matching file or directory
+func TestComposeActionsForActionsFrom(t *testing.T) {
+
+	file := "../tests/dat/manifest_data_actions_from.yaml"
+	p, m, _ := testLoadParseManifest(t, file)
+
+	pkg := m.Packages["helloworld"]
+	assert.Equal(t, 4, len(pkg.Actions))
+	assert.Equal(t, "actionsfrom/greet.js", pkg.Actions["greet"].Function)
+	assert.Equal(t, "nodejs:default", pkg.Actions["greet"].Runtime)
+	assert.Equal(t, "Amy", pkg.Actions["greet"].Inputs["name"].Value)
+	assert.Equal(t, "shout", pkg.Actions["shout"].Main, "Failed to apply the override")
+	assert.Equal(t, "Amy", pkg.Actions["shout"].Inputs["name"].Value, "Failed to merge the inputs of the override")
+	assert.Equal(t, "Paris", pkg.Actions["shout"].Inputs["place"].Value, "Failed to merge the inputs of the override")
+	_, ok := pkg.Actions["greet"].Inputs["place"]
+	assert.False(t, ok, "The override of an action must not apply to the others")
+	assert.Equal(t, "actionsfrom/bundle", pkg.Actions["actionsfrom-bundle"].Function)
+
+	actions, err := p.ComposeActionsFromAllPackages(m, m.Filepath, whisk.KeyValue{}, map[string]PackageInputs{})
+	assert.Nil(t, err, fmt.Sprintf(TEST_ERROR_COMPOSE_ACTION_FAILURE, file))
+	for _, action := range actions {
+		if action.Action.Name == "actionsfrom-bundle" {
+			assert.Equal(t, "nodejs:default", action.Action.Exec.Kind)
+			assert.True(t, strings.HasSuffix(action.Filepath, ".zip"), "Expected the directory to be deployed as a zip action")
+		}
+	}
+
+	file = "../tests/dat/manifest_data_actions_from_collision.yaml"
+	_, err = p.ParseManifest(file)
+	assert.NotNil(t, err, "Expected an error for an action generated with the name of a declared action")
+	assert.Contains(t, err.Error(), "[greet]")
+}
+
+func TestComposeTriggersForSchedule(t *testing.T) {
+
+	file := "../tests/dat/manifest_data_compose_triggers_for_schedule.yaml"
+	p, m, _ := testLoadParseManifest(t, file)
+
+	triggers, err := p.ComposeTriggersFromAllPackages(m, m.Filepath, whisk.KeyValue{}, map[string]PackageInputs{})
+	assert.Nil(t, err, "Failed to compose triggers of "+file)
+	assert.Equal(t, 2, len(triggers))
+	for _, trigger := range triggers {
+		feed, isFeed := utils.IsFeedAction(trigger)
+		assert.True(t, isFeed)
+		assert.Equal(t, SCHEDULE_ALARMS_PACKAGE+"/"+SCHEDULE_ALARM_FEED, feed)
+		switch trigger.Name {
+		case "every-morning":
+			assert.Equal(t, "0 8 * * mon-fri", trigger.Parameters.GetValue(SCHEDULE_PARAM_CRON), "The schedule must replace the inputs of the alarm feed")
+			assert.Equal(t, "Amy", trigger.Parameters.GetValue("name"))
+			assert.Equal(t, "Europe/Rome", trigger.Parameters.GetValue(SCHEDULE_PARAM_TIMEZONE))
+			payload := trigger.Parameters.GetValue(SCHEDULE_PARAM_PAYLOAD).(map[string]interface{})
+			assert.Equal(t, "Good morning", payload["greeting"])
+			assert.Equal(t, []interface{}{"Amy"}, payload["people"])
+			assert.Equal(t, "2098-12-31T23:00:00Z", trigger.Parameters.GetValue(SCHEDULE_PARAM_START_DATE))
+			assert.Equal(t, "2099-12-31T17:00:00Z", trigger.Parameters.GetValue(SCHEDULE_PARAM_STOP_DATE))
+			assert.Equal(t, 100, trigger.Parameters.GetValue(SCHEDULE_PARAM_MAX_TRIGGERS))
+		case "every-minute":
+			assert.Equal(t, 1, len(trigger.Parameters))
+		default:
+			t.Error("Unexpected trigger " + trigger.Name)
+		}
+	}
+
+	utils.Flags.AlarmsPackage = "/acme/alarms/"
+	defer func() { utils.Flags.AlarmsPackage = "" }()
+	triggers, err = p.ComposeTriggersFromAllPackages(m, m.Filepath, whisk.KeyValue{}, map[string]PackageInputs{})
+	assert.Nil(t, err)
+	feed, _ := utils.IsFeedAction(triggers[0])
+	assert.Equal(t, "/acme/alarms/alarm", feed)
+}
+
+func TestComposeFeeds(t *testing.T) {
+
+	file := "../tests/dat/manifest_data_compose_feeds.yaml"
+	p, m, _ := testLoadParseManifest(t, file)
+
+	pkg := m.Packages["eventsource"]
+	assert.Equal(t, 2, len(pkg.Actions))
+	assert.Equal(t, "../src/integration/helloworld/actions/hello.js", pkg.Actions["ticker"].Function)
+	assert.Equal(t, true, pkg.Actions["ticker"].Annotations[YAML_KEY_FEED])
+	assert.Equal(t, 60, pkg.Actions["ticker"].Inputs["interval"].Value)
+	assert.Equal(t, true, pkg.Actions["provider"].Annotations[YAML_KEY_FEED])
+	assert.Equal(t, "https://events.example.com", pkg.Actions["provider"].Inputs["provider_url"].Value)
+
+	assert.Equal(t, "eventsource/ticker", pkg.Triggers["every-minute"].Feed)
+	assert.Equal(t, "eventsource/provider", pkg.Triggers["on-event"].Feed)
+	assert.Equal(t, "/whisk.system/alarms/alarm", pkg.Triggers["on-alarm"].Feed, "Feeds of other packages must be left unchanged")
+
+	feeds, err := p.ComposeFeedsFromAllPackages(m, m.Filepath, map[string]PackageInputs{})
+	assert.Nil(t, err, "Failed to compose feeds of "+file)
+	assert.Equal(t, 2, len(feeds))
+	for _, feed := range feeds {
+		assert.Equal(t, "eventsource", feed.Packagename)
+		switch feed.Name {
+		case "eventsource/ticker":
+			assert.Equal(t, 3, len(feed.Operations))
+			assert.Equal(t, "now", feed.Operations[FEED_LIFECYCLE_CREATE].GetValue("startAt"))
+			assert.Equal(t, 0, len(feed.Operations[FEED_LIFECYCLE_UPDATE]))
+			assert.Equal(t, true, feed.Operations[FEED_LIFECYCLE_DELETE].GetValue("purge"))
+		case "eventsource/provider":
+			assert.Equal(t, 0, len(feed.Operations))
+		default:
+			t.Error("Unexpected feed " + feed.Name)
 		}
 	}
 }
@@ -1393,6 +1578,68 @@ func TestComposeActionsForInputs(t *testing.T) {
 	}
 }
 
+const inputsManifest = `packages:
+  demo:
+    inputs:
+      src: actions
+      image: myorg/myimage
+      base: /v1
+    actions:
+      hello:
+        function: ${inputs.src}/hello.js
+        web: true
+      custom:
+        docker: ${inputs.image}
+    rules:
+      daily:
+        trigger: ${file:trigger.txt}
+        action: hello
+    apis:
+      demo-api:
+        ${inputs.base}:
+          /hello:
+            hello:
+              method: GET
+`
+
+// inputs and files are resolved in functions, docker images, rules and api paths
+func TestComposeWithPackageInputs(t *testing.T) {
+	dir := t.TempDir()
+	manifestPath := filepath.Join(dir, "manifest.yaml")
+	assert.Nil(t, ioutil.WriteFile(manifestPath, []byte(inputsManifest), 0644))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "trigger.txt"), []byte("everyday\n"), 0644))
+	assert.Nil(t, os.Mkdir(filepath.Join(dir, "actions"), 0755))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "actions", "hello.js"), []byte("function main() {}"), 0644))
+
+	p, m, _ := testLoadParseManifest(t, manifestPath)
+	_, inputs, err := p.ComposeAllPackages(map[string]Parameter{}, m, m.Filepath, whisk.KeyValue{})
+	assert.Nil(t, err)
+
+	actions, err := p.ComposeActionsFromAllPackages(m, m.Filepath, whisk.KeyValue{}, inputs)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(actions))
+	for _, action := range actions {
+		switch action.Action.Name {
+		case "hello":
+			assert.Equal(t, filepath.Join(dir, "actions", "hello.js"), action.Filepath)
+		case "custom":
+			assert.Equal(t, "myorg/myimage", action.Action.Exec.Image)
+		}
+	}
+
+	rules, err := p.ComposeRulesFromAllPackages(m, whisk.KeyValue{}, inputs)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(rules))
+	assert.Equal(t, "everyday", rules[0].Trigger, "files in rules are relative to the manifest")
+
+	config := whisk.Config{Namespace: "test", AuthToken: "user:pass", Host: "host", ApigwAccessToken: "token"}
+	apis, _, err := p.ComposeApiRecordsFromAllPackages(&config, m, actions, nil, inputs)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(apis))
+	assert.Equal(t, "/v1", apis[0].ApiDoc.GatewayBasePath)
+	assert.Equal(t, "/hello", apis[0].ApiDoc.GatewayRelPath)
+}
+
 func TestComposePackage(t *testing.T) {
 
 	file := "../tests/dat/manifest_data_compose_packages.yaml"
@@ -1520,6 +1767,156 @@ func TestComposeSequences(t *testing.T) {
 	}
 }
 
+func TestComposeSequencesForResolution(t *testing.T) {
+
+	file := "../tests/dat/manifest_data_compose_sequences_resolution.yaml"
+	p, m, _ := testLoadParseManifest(t, file)
+
+	seqList, err := p.ComposeSequencesFromAllPackages("ns", m, file, whisk.KeyValue{}, map[string]PackageInputs{})
+	assert.Nil(t, err, "Failed to compose sequences")
+	assert.Equal(t, 4, len(seqList), "Failed to get sequences")
+	expected := map[string][]string{
+		"local":    {"/ns/pipeline/greet", "/ns/shared/shout"},
+		"nested":   {"/ns/pipeline/local", "/ns/pipeline/greet"},
+		"binding":  {"/ns/pipeline/greet", "/ns/utils/echo"},
+		"absolute": {"/ns/pipeline/greet", "/whisk.system/utils/echo"},
+	}
+	for _, seq := range seqList {
+		assert.Equal(t, expected[seq.Action.Name], seq.Action.Exec.Components, "Failed to resolve the components of "+seq.Action.Name)
+	}
+}
+
+func TestComposeSequencesForSteps(t *testing.T) {
+
+	file := "../tests/dat/manifest_data_compose_sequences_steps.yaml"
+	p, m, _ := testLoadParseManifest(t, file)
+
+	seqList, err := p.ComposeSequencesFromAllPackages("ns", m, file, whisk.KeyValue{}, map[string]PackageInputs{})
+	assert.Nil(t, err, "Failed to compose sequences")
+	assert.Equal(t, 1, len(seqList), "Failed to get sequences")
+	expected := []string{"/ns/pipeline/greet", "/ns/pipeline/steps-2-greet", "/ns/pipeline-steps-3/echo", "/ns/pipeline-steps-4/echo"}
+	assert.Equal(t, expected, seqList[0].Action.Exec.Components, "Failed to compose the steps of the sequence")
+
+	actions, err := p.ComposeActionsFromAllPackages(m, file, whisk.KeyValue{}, map[string]PackageInputs{})
+	assert.Nil(t, err, fmt.Sprintf(TEST_ERROR_COMPOSE_ACTION_FAILURE, file))
+	assert.Equal(t, 2, len(actions), "Failed to generate the action of a step")
+	for _, action := range actions {
+		if action.Action.Name == "steps-2-greet" {
+			assert.Equal(t, "Bob", action.Action.Parameters.GetValue("name"), "Failed to bind the inputs of a step")
+			assert.Equal(t, "Paris", action.Action.Parameters.GetValue("place"), "Failed to keep the inputs of the action")
+		}
+	}
+
+	depdList, err := p.ComposeDependenciesFromAllPackages(m, "/project_folder", m.Filepath, whisk.KeyValue{}, map[string]PackageInputs{})
+	assert.Nil(t, err, fmt.Sprintf(TEST_ERROR_COMPOSE_DEPENDENCY_FAILURE, file))
+	assert.Equal(t, 3, len(depdList), "Failed to generate the bindings of the steps")
+	for _, name := range []string{"pipeline:pipeline-steps-3", "pipeline:pipeline-steps-4"} {
+		assert.True(t, depdList[name].IsBinding, "Failed to generate binding "+name)
+		assert.Equal(t, "/whisk.system/utils", depdList[name].Location)
+	}
+	assert.Equal(t, ";", depdList["pipeline:pipeline-steps-3"].Parameters.GetValue("separator"))
+	assert.Equal(t, true, depdList["pipeline:pipeline-steps-4"].Parameters.GetValue("extra"))
+}
+
+func TestComposeActionsForCompositions(t *testing.T) {
+
+	file := "../tests/dat/manifest_data_compose_compositions.yaml"
+	p, m, _ := testLoadParseManifest(t, file)
+
+	actions, err := p.ComposeActionsFromAllPackages(m, file, whisk.KeyValue{}, map[string]PackageInputs{})
+	assert.Nil(t, err, fmt.Sprintf(TEST_ERROR_COMPOSE_ACTION_FAILURE, file))
+	assert.Equal(t, 5, len(actions), "Failed to compose the conductor actions of the compositions")
+	for _, action := range actions {
+		switch action.Action.Name {
+		case "checkout":
+			assert.Equal(t, "orders", action.Packagename)
+			assert.Equal(t, "nodejs:default", action.Action.Exec.Kind)
+			assert.Equal(t, true, action.Action.Annotations.GetValue(conductor.CONDUCTOR_ANNOTATION), "Failed to annotate the conductor action")
+			assert.Equal(t, "EUR", action.Action.Parameters.GetValue("currency"))
+			code := *action.Action.Exec.Code
+			assert.Contains(t, code, `{"op":"invoke","action":"orders/validate","next":0}`)
+			assert.Contains(t, code, `{"op":"invoke","action":"orders/notify","next":0}`)
+			assert.Contains(t, code, `{"op":"invoke","action":"utils/echo","next":0}`)
+		case "notify":
+			code := *action.Action.Exec.Code
+			assert.Contains(t, code, `{"op":"invoke","action":"shared/mail","next":0}`)
+			assert.Contains(t, code, `{"op":"invoke","action":"/whisk.system/utils/echo","next":0}`)
+		}
+	}
+}
+
+func TestComposeActionsForSecrets(t *testing.T) {
+
+	file := "../tests/dat/manifest_data_compose_secrets.yaml"
+	os.Setenv("WSKDEPLOY_TEST_TOKEN", "token-from-env")
+	defer os.Unsetenv("WSKDEPLOY_TEST_TOKEN")
+	p, m, _ := testLoadParseManifest(t, file)
+
+	actions, err := p.ComposeActionsFromAllPackages(m, file, whisk.KeyValue{}, map[string]PackageInputs{})
+	assert.Nil(t, err, fmt.Sprintf(TEST_ERROR_COMPOSE_ACTION_FAILURE, file))
+	assert.Equal(t, 1, len(actions))
+	action := actions[0].Action
+	assert.Equal(t, "admin", action.Parameters.GetValue("user"))
+	assert.Equal(t, "s3cr3t-pass", action.Parameters.GetValue("password"), "Failed to read the secret from a file")
+	assert.Equal(t, "token-from-env", action.Parameters.GetValue("token"), "Failed to read the secret from the environment")
+	assert.Equal(t, 3, action.Parameters.GetValue("retries"), "Failed to read the secret from a command")
+	assert.Equal(t, []string{"password", "retries", "token"}, action.Annotations.GetValue(utils.SECRET_INPUTS))
+	assert.Equal(t, wskprint.SECRET_MASK, wskprint.Redact("s3cr3t-pass"), "Failed to redact the secret from the output")
+
+	packages, _, err := p.ComposeAllPackages(nil, m, file, whisk.KeyValue{})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"apikey"}, packages["store"].Annotations.GetValue(utils.SECRET_INPUTS))
+	assert.Equal(t, "store-api-key", packages["store"].Parameters.GetValue("apikey"))
+}
+
+func TestComposeActionsForOutputs(t *testing.T) {
+	file := "../tests/dat/manifest_validate_sequence_outputs.yaml"
+	p, m, _ := testLoadParseManifest(t, file)
+
+	actions, err := p.ComposeActionsFromAllPackages(m, m.Filepath, whisk.KeyValue{}, map[string]PackageInputs{})
+	assert.Nil(t, err, fmt.Sprintf(TEST_ERROR_COMPOSE_ACTION_FAILURE, file))
+	for _, action := range actions {
+		if action.Action.Name != "greet" {
+			continue
+		}
+		outputs := action.Action.Annotations.GetValue(ANNOTATION_OUTPUTS)
+		expected := map[string]interface{}{
+			"greeting": map[string]interface{}{"type": "string", "description": "the greeting"},
+			"length":   map[string]interface{}{"type": "integer"},
+		}
+		assert.Equal(t, expected, outputs)
+
+		// outputs are exported back from the annotation
+		exported := m.ComposeParsersAction(*action.Action)
+		assert.Equal(t, INTEGER, exported.Outputs["length"].Type)
+		assert.Equal(t, "the greeting", exported.Outputs["greeting"].Description)
+		assert.NotContains(t, exported.Annotations, ANNOTATION_OUTPUTS)
+	}
+}
+
+func TestComposeSequencesForOutputs(t *testing.T) {
+	file := "../tests/dat/manifest_validate_sequence_outputs.yaml"
+	p, m, _ := testLoadParseManifest(t, file)
+
+	// mismatches between outputs and inputs are warnings
+	seqList, err := p.ComposeSequencesFromAllPackages("", m, file, whisk.KeyValue{}, map[string]PackageInputs{})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(seqList))
+
+	// and errors in strict mode
+	utils.Flags.Strict = true
+	defer func() { utils.Flags.Strict = false }()
+	sequences := m.Packages["pipeline"].Sequences
+
+	_, err = p.ComposeSequences("", map[string]Sequence{"matching": sequences["matching"]}, "pipeline", file, whisk.KeyValue{}, PackageInputs{}, m.Packages, nil)
+	assert.NotNil(t, err, "input [length] has type string but [greet] outputs an integer")
+	assert.Contains(t, err.Error(), "[length]")
+
+	_, err = p.ComposeSequences("", map[string]Sequence{"mismatching": sequences["mismatching"]}, "pipeline", file, whisk.KeyValue{}, PackageInputs{}, m.Packages, nil)
+	assert.NotNil(t, err, "input [message] is not an output of [greet]")
+	assert.Contains(t, err.Error(), "[message]")
+}
+
 func TestComposeTriggers(t *testing.T) {
 	// set env variables needed for the trigger feed
 	os.Setenv("KAFKA_INSTANCE", "kafka-broker")
@@ -1583,7 +1980,7 @@ func TestComposeApiRecords(t *testing.T) {
 		ApigwAccessToken: "token",
 	}
 
-	apiList, apiRequestOptions, err := p.ComposeApiRecordsFromAllPackages(&config, m, nil, nil)
+	apiList, apiRequestOptions, err := p.ComposeApiRecordsFromAllPackages(&config, m, nil, nil, map[string]PackageInputs{})
 	if err != nil {
 		assert.Fail(t, "Failed to compose api records: "+err.Error())
 	}
@@ -0,0 +1,113 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parsers
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/sciabarracom/openwhisk-wskdeploy/builder"
+	"github.com/sciabarracom/openwhisk-wskdeploy/runtimes"
+	"github.com/sciabarracom/openwhisk-wskdeploy/utils"
+	"github.com/sciabarracom/openwhisk-wskdeploy/wski18n"
+	"github.com/sciabarracom/openwhisk-wskdeploy/wskprint"
+)
+
+/*
+   packageNativeAction returns the archive of a native action, which has its executable at the root as exec:
+   a Go main package without exec is compiled for Linux and any other file is archived as exec,
+   while directories and archives are used as they are.
+
+   The archives are built in a temporary directory, returned to be removed.
+*/
+func (dm *YAMLParser) packageNativeAction(action Action, actionFilePath string) (string, string, error) {
+	execPath := actionFilePath
+	if utils.IsDirectory(actionFilePath) {
+		execPath = filepath.Join(actionFilePath, builder.NATIVE_EXEC)
+	}
+	compile := builder.IsGoMain(actionFilePath) && !utils.FileExists(execPath)
+
+	if !compile {
+		if utils.IsDirectory(actionFilePath) {
+			warnNativeExec(action, actionFilePath, execPath)
+			return actionFilePath, "", nil
+		}
+		if filepath.Ext(actionFilePath) == "."+runtimes.ZIP_FILE_EXTENSION {
+			header, found, err := builder.ArchiveExecHeader(actionFilePath)
+			if err != nil {
+				return actionFilePath, "", err
+			}
+			warnNativeHeader(action, actionFilePath, header, found)
+			return actionFilePath, "", nil
+		}
+		warnNativeExec(action, actionFilePath, execPath)
+	}
+
+	tmpDir, err := ioutil.TempDir("", "wskdeploy-native")
+	if err != nil {
+		return actionFilePath, "", err
+	}
+	if compile {
+		src, err := filepath.Abs(actionFilePath)
+		if err != nil {
+			return actionFilePath, tmpDir, err
+		}
+		execPath = filepath.Join(tmpDir, builder.NATIVE_EXEC)
+		if err := builder.CompileGo(action.Name, src, execPath); err != nil {
+			return actionFilePath, tmpDir, err
+		}
+	}
+	archive := filepath.Join(tmpDir, builder.NATIVE_EXEC+"."+runtimes.ZIP_FILE_EXTENSION)
+	return archive, tmpDir, builder.ZipExecutable(execPath, archive)
+}
+
+// nativeExecWithoutCode returns the exec of a native action which is previewed or undeployed,
+// its executable is neither compiled nor archived
+func nativeExecWithoutCode() *whisk.Exec {
+	return &whisk.Exec{Kind: runtimes.BLACKBOX, Image: NATIVE_DOCKER_IMAGE}
+}
+
+// warnNativeExec warns when the exec of a native action is missing or is not a Linux executable
+func warnNativeExec(action Action, actionFilePath string, execPath string) {
+	file, err := os.Open(execPath)
+	if err != nil {
+		warnNativeHeader(action, actionFilePath, nil, false)
+		return
+	}
+	defer file.Close()
+	header, err := builder.ReadHeader(file)
+	if err == nil {
+		warnNativeHeader(action, actionFilePath, header, true)
+	}
+}
+
+func warnNativeHeader(action Action, actionFilePath string, header []byte, found bool) {
+	id := wski18n.ID_WARN_NATIVE_EXEC_MISSING_X_action_X_path_X
+	if found {
+		if builder.IsLinuxExecutable(header) {
+			return
+		}
+		id = wski18n.ID_WARN_NATIVE_EXEC_NOT_LINUX_X_action_X_path_X
+	}
+	wskprint.PrintOpenWhiskWarning(wski18n.T(id,
+		map[string]interface{}{
+			wski18n.KEY_ACTION: action.Name,
+			wski18n.KEY_PATH:   actionFilePath}))
+}
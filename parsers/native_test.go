@@ -0,0 +1,95 @@
+// +build unit
+
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parsers
+
+import (
+	"archive/zip"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/sciabarracom/openwhisk-wskdeploy/builder"
+	"github.com/sciabarracom/openwhisk-wskdeploy/runtimes"
+	"github.com/sciabarracom/openwhisk-wskdeploy/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComposeActionsForNativeExecutable(t *testing.T) {
+	dir := t.TempDir()
+	manifest := "packages:\n  native:\n    actions:\n      hello:\n        function: hello.sh\n        native: true\n"
+	manifestPath := filepath.Join(dir, "manifest.yaml")
+	assert.Nil(t, ioutil.WriteFile(manifestPath, []byte(manifest), 0644))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "hello.sh"), []byte("#!/bin/sh\necho {}\n"), 0644))
+
+	p, m, _ := testLoadParseManifest(t, manifestPath)
+	actions, err := p.ComposeActionsFromAllPackages(m, m.Filepath, whisk.KeyValue{}, map[string]PackageInputs{})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(actions))
+	exec := actions[0].Action.Exec
+	assert.Equal(t, runtimes.BLACKBOX, exec.Kind)
+	assert.Equal(t, NATIVE_DOCKER_IMAGE, exec.Image)
+
+	t.Cleanup(utils.RemoveTempArtifacts)
+	assert.NotNil(t, actions[0].Artifact)
+	assert.True(t, actions[0].Artifact.Binary, "the code of the action must be an archive")
+	archive, err := ioutil.ReadFile(actions[0].Artifact.Path)
+	assert.Nil(t, err)
+	reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(reader.File))
+	assert.Equal(t, builder.NATIVE_EXEC, reader.File[0].Name)
+	assert.Equal(t, 0755, int(reader.File[0].Mode().Perm()))
+	assert.Equal(t, utils.Digest(archive), actions[0].Digest, "the digest is the one of the archive")
+}
+
+func TestComposeActionsForNativeWithoutCode(t *testing.T) {
+	dir := t.TempDir()
+	manifest := "packages:\n  native:\n    actions:\n      hello:\n        function: src\n        native: true\n"
+	manifestPath := filepath.Join(dir, "manifest.yaml")
+	assert.Nil(t, ioutil.WriteFile(manifestPath, []byte(manifest), 0644))
+	// a Go main package which does not compile, it must not be built
+	assert.Nil(t, os.MkdirAll(filepath.Join(dir, "src"), 0755))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "src", "main.go"), []byte("package main\n\nfunc main() {\n"), 0644))
+
+	defer func() { utils.Flags.Preview = false }()
+	for _, preview := range []bool{false, true} {
+		utils.Flags.Preview = preview
+		p, m, _ := testLoadParseManifest(t, manifestPath)
+		p.IsUndeploy = !preview
+		actions, err := p.ComposeActionsFromAllPackages(m, m.Filepath, whisk.KeyValue{}, map[string]PackageInputs{})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(actions))
+		assert.Equal(t, runtimes.BLACKBOX, actions[0].Action.Exec.Kind)
+		assert.Equal(t, NATIVE_DOCKER_IMAGE, actions[0].Action.Exec.Image)
+		assert.Nil(t, actions[0].Artifact)
+	}
+}
+
+func TestComposeActionsForInvalidDockerImage(t *testing.T) {
+	m := &YAML{Filepath: "manifest.yaml"}
+	m.Packages = map[string]Package{"docker": {Actions: map[string]Action{
+		"hello": {Docker: "OpenWhisk/skeleton:"},
+	}}}
+	_, err := NewYAMLParser().ComposeActionsFromAllPackages(m, m.Filepath, whisk.KeyValue{}, map[string]PackageInputs{})
+	assert.NotNil(t, err, "an invalid docker image must be an error")
+}
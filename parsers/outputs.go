@@ -0,0 +1,204 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parsers
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/sciabarracom/openwhisk-wskdeploy/utils"
+	"github.com/sciabarracom/openwhisk-wskdeploy/wskderrors"
+	"github.com/sciabarracom/openwhisk-wskdeploy/wski18n"
+	"github.com/sciabarracom/openwhisk-wskdeploy/wskprint"
+)
+
+// the annotation which publishes the declared outputs of an action
+const (
+	ANNOTATION_OUTPUTS     = "outputs"
+	OUTPUT_KEY_TYPE        = "type"
+	OUTPUT_KEY_DESCRIPTION = "description"
+	OUTPUT_KEY_SCHEMA      = "schema"
+)
+
+// outputType returns the declared type of an output, or of an input expected by a sequence step,
+// with the same rules as inputs, e.g. "payload: string" declares an output of type string; the
+// value is not resolved, so neither environment variables nor constraints are evaluated
+func outputType(name string, param Parameter, filePath string) (string, error) {
+	if !param.multiline {
+		if typ, ok := param.Value.(string); ok && isValidParameterType(typ) {
+			return typ, nil
+		}
+		return ResolveParamTypeFromValue(name, param.Value, filePath)
+	}
+	if len(param.Type) != 0 {
+		if !isValidParameterType(param.Type) {
+			return "", wskderrors.NewInvalidParameterTypeError(filePath, name, param.Type)
+		}
+		return param.Type, nil
+	}
+	value := param.Value
+	if value == nil {
+		value = param.Default
+	}
+	return ResolveParamTypeFromValue(name, value, filePath)
+}
+
+/*
+   composeOutputs renders the outputs of an action as the value of the outputs annotation, e.g.
+
+   {"payload": {"type": "string", "description": "the greeting"}}
+*/
+func (dm *YAMLParser) composeOutputs(outputs map[string]Parameter, filePath string) (map[string]interface{}, error) {
+	annotation := make(map[string]interface{}, len(outputs))
+	for name, param := range outputs {
+		typ, err := outputType(name, param, filePath)
+		if err != nil {
+			return nil, err
+		}
+		output := map[string]interface{}{OUTPUT_KEY_TYPE: typ}
+		if len(param.Description) != 0 {
+			output[OUTPUT_KEY_DESCRIPTION] = param.Description
+		}
+		if param.Schema != nil {
+			output[OUTPUT_KEY_SCHEMA] = normalizeJSONValue(param.Schema)
+		}
+		annotation[name] = output
+	}
+	return annotation, nil
+}
+
+// parseOutputsAnnotation converts the outputs annotation back into the outputs of an action
+func parseOutputsAnnotation(value interface{}) map[string]Parameter {
+	outputs := make(map[string]Parameter)
+	annotation, ok := normalizeJSONValue(value).(map[string]interface{})
+	if !ok {
+		return outputs
+	}
+	for name, o := range annotation {
+		param := Parameter{multiline: true}
+		if output, ok := o.(map[string]interface{}); ok {
+			if typ, ok := output[OUTPUT_KEY_TYPE].(string); ok {
+				param.Type = typ
+			}
+			if description, ok := output[OUTPUT_KEY_DESCRIPTION].(string); ok {
+				param.Description = description
+			}
+			param.Schema = output[OUTPUT_KEY_SCHEMA]
+		}
+		outputs[name] = param
+	}
+	return outputs
+}
+
+// expectedInput tells if a step of a sequence expects an input from the previous step,
+// i.e. the input is required or it is declared without a value
+func expectedInput(param Parameter) bool {
+	if param.multiline {
+		return param.Required || (param.Value == nil && param.Default == nil)
+	}
+	// in single-line format, a type name declares an input without a value
+	if str, ok := param.Value.(string); ok {
+		return isValidParameterType(str)
+	}
+	return param.Value == nil
+}
+
+// sequenceStep finds the action of a sequence step in the manifest, steps are either
+// local to the package of the sequence or refer to the action of another package
+func sequenceStep(step string, packageName string, manifestPackages map[string]Package) (Action, bool) {
+	parts := strings.Split(strings.TrimPrefix(step, PATH_SEPARATOR), PATH_SEPARATOR)
+	switch len(parts) {
+	case 1:
+		action, ok := manifestPackages[packageName].Actions[parts[0]]
+		return action, ok
+	case 2:
+		action, ok := manifestPackages[parts[0]].Actions[parts[1]]
+		return action, ok
+	}
+	return Action{}, false
+}
+
+/*
+   checkSequenceOutputs verifies that the outputs declared by each step of a sequence cover the
+   inputs expected by the next step and that their types match.
+
+   Steps which do not declare outputs, or which are not in the manifest, are not checked.
+   Mismatches are reported as warnings, or as errors in strict mode.
+*/
+func (dm *YAMLParser) checkSequenceOutputs(filePath string, sequenceName string, packageName string, steps []string, manifestPackages map[string]Package) error {
+	for i := 0; i+1 < len(steps); i++ {
+		prev, ok := sequenceStep(steps[i], packageName, manifestPackages)
+		if !ok || len(prev.Outputs) == 0 {
+			continue
+		}
+		next, ok := sequenceStep(steps[i+1], packageName, manifestPackages)
+		if !ok {
+			continue
+		}
+
+		names := make([]string, 0, len(next.Inputs))
+		for name := range next.Inputs {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			input := next.Inputs[name]
+			if !expectedInput(input) {
+				continue
+			}
+			var msg string
+			if output, ok := prev.Outputs[name]; !ok {
+				msg = wski18n.T(wski18n.ID_WARN_SEQUENCE_INPUT_MISSING_X_sequence_X_name_X,
+					map[string]interface{}{
+						wski18n.KEY_SEQUENCE: sequenceName,
+						wski18n.KEY_NAME:     name,
+						wski18n.KEY_ACTION:   steps[i+1],
+						wski18n.KEY_SOURCE:   steps[i]})
+			} else {
+				inputType, err := outputType(name, input, filePath)
+				if err != nil {
+					return err
+				}
+				outType, err := outputType(name, output, filePath)
+				if err != nil {
+					return err
+				}
+				if inputType != outType {
+					msg = wski18n.T(wski18n.ID_WARN_SEQUENCE_INPUT_TYPE_X_sequence_X_name_X_type_X,
+						map[string]interface{}{
+							wski18n.KEY_SEQUENCE: sequenceName,
+							wski18n.KEY_NAME:     name,
+							wski18n.KEY_ACTION:   steps[i+1],
+							wski18n.KEY_TYPE:     inputType,
+							wski18n.KEY_SOURCE:   steps[i],
+							wski18n.KEY_VALUE:    outType})
+				}
+			}
+			if len(msg) == 0 {
+				continue
+			}
+			if utils.Flags.Strict {
+				return wskderrors.NewYAMLFileFormatError(filePath, msg)
+			}
+			wskprint.PrintlnOpenWhiskWarning(msg)
+		}
+	}
+	return nil
+}
+
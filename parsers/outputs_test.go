@@ -0,0 +1,54 @@
+// +build unit
+
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parsers
+
+import (
+	"os"
+	"testing"
+
+	"github.com/sciabarracom/openwhisk-wskdeploy/wskderrors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutputType(t *testing.T) {
+	types := map[string]Parameter{
+		STRING:  {Value: "string"},
+		INTEGER: {Value: 42},
+		JSON:    {Value: "json"},
+		FLOAT:   {Default: 0.5, multiline: true},
+		BOOLEAN: {Type: BOOLEAN, multiline: true},
+	}
+	for expected, param := range types {
+		typ, err := outputType("output", param, "")
+		assert.Nil(t, err)
+		assert.Equal(t, expected, typ)
+	}
+
+	// the value is not resolved, an unset required variable or a constraint is not an error
+	os.Unsetenv("OUTPUT_NOT_SET")
+	param := Parameter{Type: INTEGER, Value: "${OUTPUT_NOT_SET:?must be set}", Min: float64Ptr(1), multiline: true}
+	typ, err := outputType("count", param, "")
+	assert.Nil(t, err)
+	assert.Equal(t, INTEGER, typ)
+	assert.Equal(t, "${OUTPUT_NOT_SET:?must be set}", param.Value)
+
+	_, err = outputType("count", Parameter{Type: "counter", multiline: true}, "")
+	assert.IsType(t, &wskderrors.ParameterTypeMismatchError{}, err)
+}
@@ -22,20 +22,24 @@ import (
 	"github.com/sciabarracom/openwhisk-wskdeploy/utils"
 	"github.com/sciabarracom/openwhisk-wskdeploy/wskderrors"
 	"github.com/sciabarracom/openwhisk-wskdeploy/wskenv"
+	"path/filepath"
 	"reflect"
 )
 
-// TODO(): Support other valid Package Manifest types
-// TODO(): i.e., timestamp, version, string256, string64, string16
-// TODO(): Support JSON schema validation for type: json
+// TODO(): Support other valid Package Manifest types, i.e., null and object
 // TODO(): Support OpenAPI schema validation
 const (
-	STRING  string = "string"
-	INTEGER string = "integer"
-	FLOAT   string = "float"
-	BOOLEAN string = "boolean"
-	JSON    string = "json"
-	SLICE   string = "slice"
+	STRING    string = "string"
+	INTEGER   string = "integer"
+	FLOAT     string = "float"
+	BOOLEAN   string = "boolean"
+	JSON      string = "json"
+	SLICE     string = "slice"
+	TIMESTAMP string = "timestamp"
+	VERSION   string = "version"
+	STRING256 string = "string256"
+	STRING64  string = "string64"
+	STRING16  string = "string16"
 )
 
 var validParameterNameMap = map[string]string{
@@ -57,19 +61,20 @@ var validParameterNameMap = map[string]string{
 }
 
 var typeDefaultValueMap = map[string]interface{}{
-	STRING:  "",
-	INTEGER: 0,
-	FLOAT:   0.0,
-	BOOLEAN: false,
-	JSON:    make(map[string]interface{}),
+	STRING:           "",
+	INTEGER:          0,
+	FLOAT:            0.0,
+	BOOLEAN:          false,
+	JSON:             make(map[string]interface{}),
+	SCALAR_UNIT_SIZE: 0,
+	SCALAR_UNIT_TIME: 0,
+	TIMESTAMP:        "",
+	VERSION:          "",
+	STRING256:        "",
+	STRING64:         "",
+	STRING16:         "",
 	// TODO() Support these types + their validation
-	// timestamp
 	// null
-	// version
-	// string256
-	// string64
-	// string16
-	// scalar-unit
 	// schema
 	// object
 }
@@ -232,15 +237,20 @@ func resolveMultiLineParameter(filePath string, paramName string, param *Paramet
 
 	return param.Value, errorParser
 }
-func interpolateJSON(data map[string]interface{}) map[string]interface{} {
+func interpolateJSON(data map[string]interface{}, ctx *wskenv.Context) (map[string]interface{}, error) {
+	var err error
 	for key, value := range data {
 		if reflect.TypeOf(value).Kind() == reflect.String {
-			data[key] = wskenv.InterpolateStringWithEnvVar(value)
+			if data[key], err = wskenv.Interpolate(value, ctx); err != nil {
+				return data, err
+			}
 		} else if reflect.TypeOf(value).Kind() == reflect.Map {
-			data[key] = interpolateJSON(value.(map[string]interface{}))
+			if data[key], err = interpolateJSON(value.(map[string]interface{}), ctx); err != nil {
+				return data, err
+			}
 		}
 	}
-	return data
+	return data, nil
 }
 
 /*
@@ -259,7 +269,7 @@ func interpolateJSON(data map[string]interface{}) map[string]interface{} {
    Returns:
    - (interface{}) the parameter's resolved value
 */
-func resolveJSONParameter(filePath string, paramName string, param *Parameter, value interface{}) (interface{}, error) {
+func resolveJSONParameter(filePath string, paramName string, param *Parameter, value interface{}, ctx *wskenv.Context) (interface{}, error) {
 	var errorParser error
 
 	// TODO() Is the "value" function parameter really needed with the current logic (use param.Value)?
@@ -280,7 +290,7 @@ func resolveJSONParameter(filePath string, paramName string, param *Parameter, v
 		if param.Value != nil && reflect.TypeOf(param.Value).Kind() == reflect.Map {
 			if _, ok := param.Value.(map[interface{}]interface{}); ok {
 				var temp map[string]interface{} = utils.ConvertInterfaceMap(param.Value.(map[interface{}]interface{}))
-				temp = interpolateJSON(temp)
+				temp, errorParser = interpolateJSON(temp, ctx)
 				//fmt.Printf("EXIT: Parameter [%s] type=[%v] value=[%v]\n", paramName, param.Type, temp)
 				return temp, errorParser
 			}
@@ -318,6 +328,12 @@ func resolveJSONParameter(filePath string, paramName string, param *Parameter, v
    - (interface{}) the parameter's resolved value
 */
 func ResolveParameter(paramName string, param *Parameter, filePath string) (interface{}, error) {
+	return resolveParameter(paramName, param, filePath, &wskenv.Context{BaseDir: filepath.Dir(filePath)})
+}
+
+// resolveParameter is ResolveParameter with the context used to interpolate
+// ${inputs.x}, ${project.name} and the other expressions in parameter values
+func resolveParameter(paramName string, param *Parameter, filePath string, ctx *wskenv.Context) (interface{}, error) {
 
 	var errorParser error
 	// default resolved parameter value to empty string
@@ -326,6 +342,11 @@ func ResolveParameter(paramName string, param *Parameter, filePath string) (inte
 	// Trace Parameter struct before any resolution
 	//dumpParameter(paramName, param, "BEFORE")
 
+	// secrets read from a provider are taken as they are, without interpolation
+	if len(param.Secret.From) != 0 {
+		return resolveSecret(paramName, param, filePath)
+	}
+
 	// Parameters can be single OR multi-line declarations which must be processed/validated differently
 	// Regardless, the following functions will assure that param.Value and param.Type are correctly set
 	if !param.multiline {
@@ -341,19 +362,61 @@ func ResolveParameter(paramName string, param *Parameter, filePath string) (inte
 	// Make sure the parameter's value is a valid, non-empty string
 	if param.Value != nil && param.Type == "string" {
 		// perform $ notation replacement on string if any exist
-		value = wskenv.InterpolateStringWithEnvVar(param.Value)
+		if str, ok := param.Value.(string); ok {
+			var err error
+			if value, err = wskenv.InterpolateString(str, ctx); err != nil {
+				return value, err
+			}
+		} else {
+			value = param.Value
+		}
+	}
+
+	// Other types declared with a string value, e.g. "$AGE" for an integer, are interpolated
+	// as well and converted to the declared type below
+	if str, ok := param.Value.(string); ok && isInterpolatedType(param.Type) {
+		var err error
+		if value, err = wskenv.InterpolateString(str, ctx); err != nil {
+			return value, err
+		}
+		// an unset variable leaves the value to the default for the type
+		if value == "" {
+			value = nil
+		}
 	}
 
 	// JSON - Handle both cases, where value 1) is a string containing JSON, 2) is a map of JSON
 	if param.Value != nil && param.Type == "json" {
-		value, errorParser = resolveJSONParameter(filePath, paramName, param, value)
+		value, errorParser = resolveJSONParameter(filePath, paramName, param, value, ctx)
+	}
+
+	// scalar-unit values are normalized to their base unit, i.e. bytes or seconds
+	if param.Value != nil && isScalarUnitType(param.Type) {
+		var err error
+		if value, err = wskenv.Interpolate(param.Value, ctx); err != nil {
+			return value, err
+		}
+		if value, err = ResolveScalarUnit(filePath, paramName, param.Type, value); err != nil {
+			return value, err
+		}
 	}
 
 	if param.Value != nil && param.Type == "slice" {
-		value = wskenv.InterpolateStringWithEnvVar(param.Value)
+		var err error
+		if value, err = wskenv.Interpolate(param.Value, ctx); err != nil {
+			return value, err
+		}
 		value = utils.ConvertInterfaceValue(value)
 	}
 
+	// convert the value to the declared type and check it against the type and its constraints
+	if errorParser == nil {
+		var err error
+		if value, err = CoerceParameterValue(paramName, param, value, filePath, manifestValueSource(filePath, param.Value)); err != nil {
+			return value, err
+		}
+	}
+
 	// Default value to zero value for the Type
 	// Do NOT error/terminate as Value may be provided later by a Deployment file.
 	if value == nil {
@@ -385,6 +448,10 @@ func (n *Parameter) UnmarshalYAML(unmarshal func(interface{}) error) error {
 		n.Default = aux.Default
 		n.Status = aux.Status
 		n.Schema = aux.Schema
+		n.Enum = aux.Enum
+		n.Min = aux.Min
+		n.Max = aux.Max
+		n.Secret = aux.Secret
 		return nil
 	}
 
@@ -401,7 +468,7 @@ func (n *Parameter) UnmarshalYAML(unmarshal func(interface{}) error) error {
 
 func (n *Parameter) MarshalYAML() (interface{}, error) {
 	if _, ok := n.Value.(string); len(n.Type) == 0 && len(n.Description) == 0 && ok {
-		if !n.Required && len(n.Status) == 0 && n.Schema == nil {
+		if !n.Required && len(n.Status) == 0 && n.Schema == nil && len(n.Enum) == 0 && n.Min == nil && n.Max == nil && !n.Secret.Enabled {
 			return n.Value.(string), nil
 		}
 	}
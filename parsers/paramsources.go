@@ -0,0 +1,68 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parsers
+
+import (
+	"sync"
+)
+
+// where the final value of each parameter of each entity comes from, reported by --explain-params
+var parameterSources = struct {
+	sync.Mutex
+	entities map[string]map[string]string
+}{entities: make(map[string]map[string]string)}
+
+// ParameterEntity names an entity whose parameter sources are recorded, e.g. "action [hello/greet]"
+func ParameterEntity(kind string, name string) string {
+	return ParameterSource(kind, name)
+}
+
+// RecordParameterSource records the source of the value of a parameter of an entity.
+// Values are recorded in order of precedence, i.e. manifest, deployment file and CLI,
+// so that the last record of a parameter is the source of its final value.
+func RecordParameterSource(entity string, name string, source string) {
+	parameterSources.Lock()
+	defer parameterSources.Unlock()
+	if _, ok := parameterSources.entities[entity]; !ok {
+		parameterSources.entities[entity] = make(map[string]string)
+	}
+	parameterSources.entities[entity][name] = source
+}
+
+// GetParameterSource returns the recorded source of the value of a parameter of an entity
+func GetParameterSource(entity string, name string) (string, bool) {
+	parameterSources.Lock()
+	defer parameterSources.Unlock()
+	source, ok := parameterSources.entities[entity][name]
+	return source, ok
+}
+
+// ResetParameterSources forgets all the recorded sources
+func ResetParameterSources() {
+	parameterSources.Lock()
+	defer parameterSources.Unlock()
+	parameterSources.entities = make(map[string]map[string]string)
+}
+
+// manifestParameterSource reports where the value of a parameter declared in the manifest is resolved from
+func manifestParameterSource(filePath string, param Parameter) string {
+	if len(param.Secret.From) != 0 {
+		return ParameterSource(PARAM_SOURCE_SECRET, param.Secret.From)
+	}
+	return manifestValueSource(filePath, param.Value)
+}
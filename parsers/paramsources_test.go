@@ -0,0 +1,85 @@
+// +build unit
+
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parsers
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/sciabarracom/openwhisk-wskdeploy/utils"
+	"github.com/sciabarracom/openwhisk-wskdeploy/wskenv"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComposeParameterSources(t *testing.T) {
+	file := "../tests/dat/manifest_data_compose_param_sources.yaml"
+
+	dir, _ := ioutil.TempDir("", "paramsources")
+	defer os.RemoveAll(dir)
+	dotenv := filepath.Join(dir, wskenv.DOTENV_FILE)
+	ioutil.WriteFile(dotenv, []byte("WSKDEPLOY_TEST_REGION=eu-de\nWSKDEPLOY_TEST_PLACE=ignored\n"), 0644)
+	_, err := wskenv.LoadDotenv(dotenv)
+	assert.Nil(t, err)
+	defer wskenv.ResetDotenv()
+
+	os.Setenv("WSKDEPLOY_TEST_PLACE", "Paris")
+	defer os.Unsetenv("WSKDEPLOY_TEST_PLACE")
+	utils.Flags.Param = []string{`{"count": "5"}`}
+	utils.ParamSources["count"] = ParameterSource(PARAM_SOURCE_PARAM_FILE, "params.yaml")
+	defer func() {
+		utils.Flags.Param = nil
+		delete(utils.ParamSources, "count")
+		ResetParameterSources()
+	}()
+
+	p, m, _ := testLoadParseManifest(t, file)
+	packages, _, err := p.ComposeAllPackages(nil, m, file, whisk.KeyValue{})
+	assert.Nil(t, err)
+	assert.Equal(t, "eu-de", packages["sources"].Parameters.GetValue("region"))
+
+	actions, err := p.ComposeActionsFromAllPackages(m, file, whisk.KeyValue{}, map[string]PackageInputs{})
+	assert.Nil(t, err)
+	action := actions[0].Action
+	assert.Equal(t, "Amy", action.Parameters.GetValue("name"))
+	assert.Equal(t, "Paris", action.Parameters.GetValue("place"), "the environment takes precedence over the .env file")
+	assert.Equal(t, 5, action.Parameters.GetValue("count"), "the CLI takes precedence over the manifest")
+
+	expected := map[string]map[string]string{
+		ParameterEntity(YAML_KEY_PACKAGE, "sources"): {
+			"region": ParameterSource(PARAM_SOURCE_DOTENV, dotenv),
+			"tier":   ParameterSource(PARAM_SOURCE_MANIFEST, file),
+		},
+		ParameterEntity(YAML_KEY_ACTION, "sources/greet"): {
+			"name":  ParameterSource(PARAM_SOURCE_MANIFEST, file),
+			"place": ParameterSource(PARAM_SOURCE_ENV, "WSKDEPLOY_TEST_PLACE"),
+			"count": ParameterSource(PARAM_SOURCE_PARAM_FILE, "params.yaml"),
+		},
+	}
+	for entity, sources := range expected {
+		for name, source := range sources {
+			actual, ok := GetParameterSource(entity, name)
+			assert.True(t, ok, entity+" "+name)
+			assert.Equal(t, source, actual, entity+" "+name)
+		}
+	}
+}
@@ -0,0 +1,271 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parsers
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/sciabarracom/openwhisk-wskdeploy/utils"
+	"github.com/sciabarracom/openwhisk-wskdeploy/wskderrors"
+	"github.com/sciabarracom/openwhisk-wskdeploy/wskenv"
+	"github.com/sciabarracom/openwhisk-wskdeploy/wski18n"
+)
+
+// sources of parameter values, reported when a value is not valid for its declared type
+// and by --explain-params
+const (
+	PARAM_SOURCE_MANIFEST   = "manifest"
+	PARAM_SOURCE_DEPLOYMENT = "deployment"
+	PARAM_SOURCE_ENV        = "environment variable"
+	PARAM_SOURCE_DOTENV     = "dotenv"
+	PARAM_SOURCE_CLI        = "--param"
+	PARAM_SOURCE_PARAM_FILE = "--param-file"
+	PARAM_SOURCE_INPUTS     = "package input"
+	PARAM_SOURCE_GENERATED  = "generated"
+)
+
+var stringTypeMaxLength = map[string]int{
+	STRING256: 256,
+	STRING64:  64,
+	STRING16:  16,
+}
+
+// semantic version, with an optional "v" prefix, pre-release and build metadata
+var versionRegex = regexp.MustCompile(`^v?(0|[1-9][0-9]*)\.(0|[1-9][0-9]*)\.(0|[1-9][0-9]*)` +
+	`(-[0-9A-Za-z-]+(\.[0-9A-Za-z-]+)*)?(\+[0-9A-Za-z-]+(\.[0-9A-Za-z-]+)*)?$`)
+
+var envVarRegex = regexp.MustCompile(`^\$\{?([A-Za-z_][A-Za-z0-9_]*)\}?$`)
+
+// ParameterSource describes where a parameter value comes from, e.g. "manifest [manifest.yaml]"
+func ParameterSource(kind string, name string) string {
+	if len(name) == 0 {
+		return kind
+	}
+	return fmt.Sprintf("%s [%s]", kind, name)
+}
+
+// manifestValueSource reports a value which is a plain $VAR or ${VAR} reference as
+// coming from the environment and any other value as coming from the manifest
+func manifestValueSource(filePath string, raw interface{}) string {
+	return ValueSource(PARAM_SOURCE_MANIFEST, filePath, raw)
+}
+
+// ValueSource reports a value of a file which is a plain $VAR or ${VAR} reference as
+// coming from the environment or the .env file and any other value as coming from the file
+func ValueSource(kind string, filePath string, raw interface{}) string {
+	if str, ok := raw.(string); ok {
+		if m := envVarRegex.FindStringSubmatch(str); m != nil {
+			return EnvSource(m[1])
+		}
+	}
+	return ParameterSource(kind, filePath)
+}
+
+// EnvSource reports a variable as coming from the .env file which sets it, if any,
+// otherwise from the environment
+func EnvSource(name string) string {
+	if path := wskenv.DotenvSource(name); len(path) != 0 {
+		return ParameterSource(PARAM_SOURCE_DOTENV, path)
+	}
+	return ParameterSource(PARAM_SOURCE_ENV, name)
+}
+
+// CLISource reports the --param or --param-file argument which sets a parameter
+func CLISource(name string) string {
+	if source, ok := utils.ParamSources[name]; ok {
+		return source
+	}
+	return ParameterSource(PARAM_SOURCE_CLI, "")
+}
+
+/*
+   CoerceParameterValue converts a value into the declared type of the parameter and validates it.
+
+   Values from the CLI (--param) and from environment variables are strings, they are converted
+   to integer, float, boolean, json and scalar-unit types. The value is then checked against the
+   type (timestamp, version, string256, string64, string16) and the enum, min and max constraints.
+
+   Inputs:
+   - paramName: name of the parameter for error reporting
+   - param: the parameter declaration
+   - value: the value to coerce
+   - filePath: the path of the file which declared the parameter for error reporting
+   - source: where the value comes from for error reporting, see ParameterSource
+
+   Returns:
+   - (interface{}) the coerced value
+*/
+func CoerceParameterValue(paramName string, param *Parameter, value interface{}, filePath string, source string) (interface{}, error) {
+	// values which are not set are left to the check of required inputs
+	if value == nil {
+		return value, nil
+	}
+	if str, ok := value.(string); ok && len(str) == 0 {
+		return value, nil
+	}
+
+	invalid := func(id string, args map[string]interface{}) error {
+		return wskderrors.NewInvalidParameterValueError(filePath, paramName, fmt.Sprintf("%v", value),
+			param.Type, source, wski18n.T(id, args))
+	}
+	mismatch := func() error {
+		return invalid(wski18n.ID_ERR_PARAMETER_VALUE_TYPE_MISMATCH, nil)
+	}
+
+	switch param.Type {
+	case INTEGER:
+		switch v := value.(type) {
+		case string:
+			i, err := strconv.Atoi(strings.TrimSpace(v))
+			if err != nil {
+				return value, mismatch()
+			}
+			value = i
+		case float64:
+			if !isWholeNumber(v) {
+				return value, mismatch()
+			}
+			value = int(v)
+		case int:
+		default:
+			return value, mismatch()
+		}
+	case FLOAT:
+		switch v := value.(type) {
+		case string:
+			f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+			if err != nil {
+				return value, mismatch()
+			}
+			value = f
+		case float64, int:
+		default:
+			return value, mismatch()
+		}
+	case BOOLEAN:
+		switch v := value.(type) {
+		case string:
+			b, err := strconv.ParseBool(strings.TrimSpace(v))
+			if err != nil {
+				return value, mismatch()
+			}
+			value = b
+		case bool:
+		default:
+			return value, mismatch()
+		}
+	case JSON:
+		if str, ok := value.(string); ok {
+			var parsed interface{}
+			if err := json.Unmarshal([]byte(str), &parsed); err != nil {
+				return value, mismatch()
+			}
+			value = parsed
+		}
+	case SCALAR_UNIT_SIZE, SCALAR_UNIT_TIME:
+		// values from the manifest are already in the base unit
+		if _, ok := value.(string); ok {
+			v, err := ResolveScalarUnit(filePath, paramName, param.Type, value)
+			if err != nil {
+				return value, err
+			}
+			value = v
+		}
+	case TIMESTAMP:
+		switch v := value.(type) {
+		case time.Time:
+			value = v.Format(time.RFC3339)
+		case string:
+			if _, err := time.Parse(time.RFC3339, v); err != nil {
+				return value, invalid(wski18n.ID_ERR_PARAMETER_VALUE_TIMESTAMP, nil)
+			}
+		default:
+			return value, invalid(wski18n.ID_ERR_PARAMETER_VALUE_TIMESTAMP, nil)
+		}
+	case VERSION:
+		if str, ok := value.(string); !ok || !versionRegex.MatchString(str) {
+			return value, invalid(wski18n.ID_ERR_PARAMETER_VALUE_VERSION, nil)
+		}
+	case STRING256, STRING64, STRING16:
+		str, ok := value.(string)
+		if !ok {
+			return value, mismatch()
+		}
+		if max := stringTypeMaxLength[param.Type]; utf8.RuneCountInString(str) > max {
+			return value, invalid(wski18n.ID_ERR_PARAMETER_VALUE_TOO_LONG_X_max_X,
+				map[string]interface{}{wski18n.KEY_VALUE_MAX: max})
+		}
+	}
+
+	if len(param.Enum) > 0 && !inEnum(value, param.Enum) {
+		values := make([]string, 0, len(param.Enum))
+		for _, e := range param.Enum {
+			values = append(values, fmt.Sprintf("%v", e))
+		}
+		return value, invalid(wski18n.ID_ERR_PARAMETER_VALUE_NOT_IN_ENUM_X_value_X,
+			map[string]interface{}{wski18n.KEY_VALUE: strings.Join(values, ", ")})
+	}
+
+	if param.Min != nil || param.Max != nil {
+		var number float64
+		switch v := value.(type) {
+		case int:
+			number = float64(v)
+		case float64:
+			number = v
+		default:
+			return value, mismatch()
+		}
+		if param.Min != nil && number < *param.Min {
+			return value, invalid(wski18n.ID_ERR_PARAMETER_VALUE_BELOW_MIN_X_min_X,
+				map[string]interface{}{wski18n.KEY_VALUE_MIN: normalizedNumber(*param.Min)})
+		}
+		if param.Max != nil && number > *param.Max {
+			return value, invalid(wski18n.ID_ERR_PARAMETER_VALUE_ABOVE_MAX_X_max_X,
+				map[string]interface{}{wski18n.KEY_VALUE_MAX: normalizedNumber(*param.Max)})
+		}
+	}
+
+	return value, nil
+}
+
+// enum entries are compared by their string form so that 1 matches both 1 and "1"
+func inEnum(value interface{}, enum []interface{}) bool {
+	str := fmt.Sprintf("%v", value)
+	for _, e := range enum {
+		if fmt.Sprintf("%v", e) == str {
+			return true
+		}
+	}
+	return false
+}
+
+// types whose string values are interpolated before they are converted
+func isInterpolatedType(typeName string) bool {
+	switch typeName {
+	case INTEGER, FLOAT, BOOLEAN, TIMESTAMP, VERSION, STRING256, STRING64, STRING16:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,163 @@
+// +build unit
+
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parsers
+
+import (
+	"os"
+	"testing"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/sciabarracom/openwhisk-wskdeploy/wskderrors"
+	"github.com/stretchr/testify/assert"
+)
+
+func float64Ptr(f float64) *float64 {
+	return &f
+}
+
+func TestCoerceParameterValue(t *testing.T) {
+	source := ParameterSource(PARAM_SOURCE_CLI, "")
+
+	v, err := CoerceParameterValue("age", &Parameter{Type: INTEGER}, "42", "", source)
+	assert.Nil(t, err)
+	assert.Equal(t, 42, v)
+
+	v, err = CoerceParameterValue("ratio", &Parameter{Type: FLOAT}, "0.5", "", source)
+	assert.Nil(t, err)
+	assert.Equal(t, 0.5, v)
+
+	v, err = CoerceParameterValue("debug", &Parameter{Type: BOOLEAN}, "true", "", source)
+	assert.Nil(t, err)
+	assert.Equal(t, true, v)
+
+	v, err = CoerceParameterValue("config", &Parameter{Type: JSON}, `{"a": 1}`, "", source)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"a": 1.0}, v)
+
+	v, err = CoerceParameterValue("memory", &Parameter{Type: SCALAR_UNIT_SIZE}, "1 kB", "", source)
+	assert.Nil(t, err)
+	assert.Equal(t, 1000, v)
+
+	_, err = CoerceParameterValue("age", &Parameter{Type: INTEGER}, "forty", "", source)
+	assert.IsType(t, &wskderrors.InvalidParameterValueError{}, err)
+	assert.Equal(t, "age", err.(*wskderrors.InvalidParameterValueError).Parameter)
+	assert.Equal(t, PARAM_SOURCE_CLI, err.(*wskderrors.InvalidParameterValueError).Source)
+}
+
+func TestCoerceParameterValueTypes(t *testing.T) {
+	source := ParameterSource(PARAM_SOURCE_DEPLOYMENT, "deployment.yaml")
+
+	valid := map[string][]interface{}{
+		TIMESTAMP: {"2006-01-02T15:04:05Z", "2006-01-02T15:04:05+07:00"},
+		VERSION:   {"1.0.0", "v2.10.3", "1.0.0-alpha.1+build.5"},
+		STRING16:  {"sixteen chars ok"},
+		STRING64:  {"a short abstract"},
+	}
+	for typ, values := range valid {
+		for _, value := range values {
+			_, err := CoerceParameterValue("p", &Parameter{Type: typ}, value, "", source)
+			assert.Nil(t, err, "%s should be a valid %s", value, typ)
+		}
+	}
+
+	invalid := map[string][]interface{}{
+		TIMESTAMP: {"2006-01-02", "yesterday", 12},
+		VERSION:   {"1.0", "01.2.3", 1.5},
+		STRING16:  {"seventeen chars!!", 16},
+	}
+	for typ, values := range invalid {
+		for _, value := range values {
+			_, err := CoerceParameterValue("p", &Parameter{Type: typ}, value, "", source)
+			assert.IsType(t, &wskderrors.InvalidParameterValueError{}, err, "%v should not be a valid %s", value, typ)
+		}
+	}
+	_, err := CoerceParameterValue("p", &Parameter{Type: STRING16}, "seventeen chars!!", "", source)
+	assert.Equal(t, "deployment [deployment.yaml]", err.(*wskderrors.InvalidParameterValueError).Source)
+}
+
+func TestCoerceParameterValueConstraints(t *testing.T) {
+	source := ParameterSource(PARAM_SOURCE_MANIFEST, "manifest.yaml")
+
+	enum := &Parameter{Type: STRING, Enum: []interface{}{"red", "green"}}
+	_, err := CoerceParameterValue("color", enum, "green", "", source)
+	assert.Nil(t, err)
+	_, err = CoerceParameterValue("color", enum, "purple", "", source)
+	assert.IsType(t, &wskderrors.InvalidParameterValueError{}, err)
+
+	bounded := &Parameter{Type: INTEGER, Min: float64Ptr(1), Max: float64Ptr(10)}
+	v, err := CoerceParameterValue("replicas", bounded, "10", "", source)
+	assert.Nil(t, err)
+	assert.Equal(t, 10, v)
+	_, err = CoerceParameterValue("replicas", bounded, 0, "", source)
+	assert.IsType(t, &wskderrors.InvalidParameterValueError{}, err)
+	_, err = CoerceParameterValue("replicas", bounded, 11, "", source)
+	assert.IsType(t, &wskderrors.InvalidParameterValueError{}, err)
+
+	// unset values are left to the check of required inputs
+	v, err = CoerceParameterValue("replicas", bounded, "", "", source)
+	assert.Nil(t, err)
+	assert.Equal(t, "", v)
+}
+
+func TestResolveParameterForTypedInputs(t *testing.T) {
+	_, m, _ := testLoadParseManifest(t, "../tests/dat/manifest_validate_typed_inputs.yaml")
+	inputs := m.Packages["validate_typed_inputs"].Actions["typed_inputs"].Inputs
+
+	os.Setenv("REPLICAS", "3")
+	for name, param := range inputs {
+		_, err := ResolveParameter(name, &param, m.Filepath)
+		assert.Nil(t, err, "input [%s] should be valid", name)
+	}
+	replicas := inputs["replicas"]
+	v, _ := ResolveParameter("replicas", &replicas, m.Filepath)
+	assert.Equal(t, 3, v)
+
+	// the error names the environment variable which supplied the value
+	os.Setenv("REPLICAS", "30")
+	replicas = inputs["replicas"]
+	_, err := ResolveParameter("replicas", &replicas, m.Filepath)
+	assert.IsType(t, &wskderrors.InvalidParameterValueError{}, err)
+	assert.Equal(t, "environment variable [REPLICAS]", err.(*wskderrors.InvalidParameterValueError).Source)
+	os.Unsetenv("REPLICAS")
+
+	color := inputs["color"]
+	color.Value = "purple"
+	_, err = ResolveParameter("color", &color, m.Filepath)
+	assert.IsType(t, &wskderrors.InvalidParameterValueError{}, err)
+	assert.Equal(t, "manifest ["+m.Filepath+"]", err.(*wskderrors.InvalidParameterValueError).Source)
+}
+
+func TestComposeTriggersForTypedInputs(t *testing.T) {
+	p, m, _ := testLoadParseManifest(t, "../tests/dat/manifest_validate_typed_inputs.yaml")
+	pkg := m.Packages["validate_typed_inputs"]
+
+	os.Setenv("RETRIES", "3")
+	triggers, err := p.ComposeTriggers(m.Filepath, pkg, whisk.KeyValue{}, PackageInputs{})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(triggers))
+	assert.Equal(t, 3, triggers[0].Parameters.GetValue("retries"))
+
+	// a trigger input which fails validation is an error, the trigger is not dropped
+	os.Setenv("RETRIES", "50")
+	triggers, err = p.ComposeTriggers(m.Filepath, pkg, whisk.KeyValue{}, PackageInputs{})
+	assert.IsType(t, &wskderrors.InvalidParameterValueError{}, err)
+	assert.Nil(t, triggers)
+	os.Unsetenv("RETRIES")
+}
@@ -0,0 +1,91 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parsers
+
+import (
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/sciabarracom/openwhisk-wskdeploy/runtimes"
+	"github.com/sciabarracom/openwhisk-wskdeploy/wskderrors"
+)
+
+// RuntimeIssue is an action of the manifest whose runtime is deprecated or not available at the apihost
+type RuntimeIssue struct {
+	Package    string `json:"package"`
+	Action     string `json:"action"`
+	Runtime    string `json:"runtime"`
+	Status     string `json:"status"`
+	Suggestion string `json:"suggestion,omitempty"`
+}
+
+// CheckManifestRuntimes returns the actions of the manifest which declare a deprecated or unavailable
+// runtime, sorted by package and action. The feeds and the compositions of a manifest read by
+// ParseManifest are among its actions, and their runtimes are checked as well.
+// Actions without a runtime are reported only when their file extension is mapped to a kind,
+// otherwise they are deployed with the default kind of their runtime.
+func CheckManifestRuntimes(manifest *YAML, op runtimes.OpenWhiskInfo) []RuntimeIssue {
+	issues := make([]RuntimeIssue, 0)
+	manifestPackages := manifest.Packages
+	if len(manifestPackages) == 0 {
+		manifestPackages = manifest.GetProject().Packages
+	}
+	for packageName, pkg := range manifestPackages {
+		defaults := manifest.PackageDefaults(pkg)
+		for actionName, action := range pkg.Actions {
+			action = action.withDefaults(defaults)
+			// the kind a file extension is mapped to is checked too
+			if len(action.Runtime) == 0 && len(action.Docker) == 0 && !action.Native {
+				action.Runtime = runtimes.FileExtensionDefaultKindMap[strings.TrimPrefix(path.Ext(action.Function), ".")]
+			}
+			if len(action.Runtime) == 0 || len(action.Docker) != 0 || action.Native || action.Runtime == runtimes.BLACKBOX {
+				continue
+			}
+			status := runtimes.KindStatus(op, action.Runtime)
+			if status == runtimes.KIND_STATUS_AVAILABLE {
+				continue
+			}
+			issues = append(issues, RuntimeIssue{
+				Package:    packageName,
+				Action:     actionName,
+				Runtime:    action.Runtime,
+				Status:     status,
+				Suggestion: runtimes.SuggestKind(op, action.Runtime),
+			})
+		}
+	}
+	sort.Slice(issues, func(i, j int) bool {
+		if issues[i].Package != issues[j].Package {
+			return issues[i].Package < issues[j].Package
+		}
+		return issues[i].Action < issues[j].Action
+	})
+	return issues
+}
+
+// ApplyRuntimeMappings maps the file extensions listed by the runtime mappings of the project
+// to their runtime, in addition to the mappings of the catalog and of the user config file
+func ApplyRuntimeMappings(manifest *YAML) error {
+	mappings := manifest.GetProject().RuntimeMappings
+	if err := mappings.Validate(); err != nil {
+		return wskderrors.NewYAMLFileFormatError(manifest.Filepath, err.Error())
+	}
+	runtimes.ApplyRuntimeMappings(mappings)
+	return nil
+}
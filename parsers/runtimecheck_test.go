@@ -0,0 +1,59 @@
+// +build unit
+
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parsers
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/sciabarracom/openwhisk-wskdeploy/runtimes"
+	"github.com/stretchr/testify/assert"
+)
+
+const TEST_RUNTIMES_CHECK_INFO = `{"runtimes": {
+	"nodejs": [
+		{"kind": "nodejs:6", "deprecated": true},
+		{"kind": "nodejs:14", "default": true}
+	],
+	"python": [
+		{"kind": "python:3", "default": true}
+	]
+}}`
+
+func TestCheckManifestRuntimes(t *testing.T) {
+	file := "../tests/dat/manifest_data_runtimes_check.yaml"
+	var op runtimes.OpenWhiskInfo
+	assert.Nil(t, json.Unmarshal([]byte(TEST_RUNTIMES_CHECK_INFO), &op))
+
+	_, m, _ := testLoadParseManifest(t, file)
+	issues := CheckManifestRuntimes(m, op)
+	expected := []RuntimeIssue{
+		// the default runtime of the project applies to the actions without one
+		{Package: "check", Action: "defaulted", Runtime: "python:2", Status: runtimes.KIND_STATUS_UNAVAILABLE, Suggestion: "python:3"},
+		{Package: "check", Action: "deprecated", Runtime: "nodejs:6", Status: runtimes.KIND_STATUS_DEPRECATED, Suggestion: "nodejs:14"},
+		// the conductor action of a composition
+		{Package: "check", Action: "flow", Runtime: "nodejs:6", Status: runtimes.KIND_STATUS_DEPRECATED, Suggestion: "nodejs:14"},
+		// the action of a feed with a location
+		{Package: "check", Action: "ticker", Runtime: "nodejs:4", Status: runtimes.KIND_STATUS_UNAVAILABLE, Suggestion: "nodejs:14"},
+		{Package: "check", Action: "unavailable", Runtime: "nodejs:4", Status: runtimes.KIND_STATUS_UNAVAILABLE, Suggestion: "nodejs:14"},
+		{Package: "check", Action: "unknown", Runtime: "cobol:1", Status: runtimes.KIND_STATUS_UNAVAILABLE},
+	}
+	assert.Equal(t, expected, issues)
+}
@@ -0,0 +1,70 @@
+// +build unit
+
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parsers
+
+import (
+	"testing"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/sciabarracom/openwhisk-wskdeploy/runtimes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComposeActionsWithRuntimeMappings(t *testing.T) {
+	file := "../tests/dat/manifest_data_runtime_mappings.yaml"
+	// the mappings of the manifest are applied to copies of the mappings of the catalog
+	extensions, kinds, exports := runtimes.FileExtensionRuntimeKindMap, runtimes.FileExtensionDefaultKindMap, runtimes.FileRuntimeExtensionsMap
+	runtimes.FileExtensionRuntimeKindMap = make(map[string]string)
+	for ext, runtime := range extensions {
+		runtimes.FileExtensionRuntimeKindMap[ext] = runtime
+	}
+	runtimes.FileExtensionDefaultKindMap = make(map[string]string)
+	runtimes.FileRuntimeExtensionsMap = make(map[string]string)
+	defer func() {
+		runtimes.FileExtensionRuntimeKindMap, runtimes.FileExtensionDefaultKindMap, runtimes.FileRuntimeExtensionsMap = extensions, kinds, exports
+	}()
+
+	p, m, _ := testLoadParseManifest(t, file)
+	assert.Nil(t, ApplyRuntimeMappings(m))
+	actions, err := p.ComposeActionsFromAllPackages(m, m.Filepath, whisk.KeyValue{}, map[string]PackageInputs{})
+	assert.Nil(t, err)
+
+	expected := map[string]string{
+		"typescript": "nodejs:12",
+		"module":     "nodejs:12",
+		"explicit":   "nodejs:10",
+	}
+	assert.Equal(t, len(expected), len(actions))
+	for _, action := range actions {
+		assert.Equal(t, expected[action.Action.Name], action.Action.Exec.Kind, "action [%s]", action.Action.Name)
+	}
+}
+
+func TestApplyRuntimeMappings_Invalid(t *testing.T) {
+	m := &YAML{Filepath: "manifest.yaml"}
+	m.Project.RuntimeMappings = runtimes.RuntimeMappings{
+		"nodejs": {Extensions: []string{"ts"}},
+		"deno":   {Extensions: []string{".ts"}},
+	}
+	assert.NotNil(t, ApplyRuntimeMappings(m), "an extension mapped to two runtimes must be an error")
+
+	m.Project.RuntimeMappings = runtimes.RuntimeMappings{"nodejs": {}}
+	assert.NotNil(t, ApplyRuntimeMappings(m), "a runtime without extensions must be an error")
+}
@@ -0,0 +1,283 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package parsers
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/sciabarracom/openwhisk-wskdeploy/wskderrors"
+	"github.com/sciabarracom/openwhisk-wskdeploy/wski18n"
+	"github.com/sciabarracom/openwhisk-wskdeploy/wskprint"
+)
+
+// scalar-unit types as defined in the manifest specification
+const (
+	SCALAR_UNIT_SIZE string = "scalar-unit.size"
+	SCALAR_UNIT_TIME string = "scalar-unit.time"
+)
+
+type scalarUnit struct {
+	name       string
+	multiplier float64
+}
+
+type scalarUnits struct {
+	// units are listed from the largest to the smallest
+	units      []scalarUnit
+	ignoreCase bool
+}
+
+// sizes are normalized to bytes; as per specification kB, MB, GB and TB are
+// powers of 1000, KiB, MiB, GiB and TiB are powers of 1024
+var scalarUnitSizes = scalarUnits{
+	units: []scalarUnit{
+		{"TiB", 1 << 40}, {"TB", 1e12},
+		{"GiB", 1 << 30}, {"GB", 1e9},
+		{"MiB", 1 << 20}, {"MB", 1e6},
+		{"KiB", 1 << 10}, {"kB", 1e3},
+		{"B", 1},
+	},
+	ignoreCase: true,
+}
+
+// times are normalized to seconds
+var scalarUnitTimes = scalarUnits{
+	units: []scalarUnit{
+		{"d", 86400}, {"h", 3600}, {"m", 60}, {"s", 1}, {"ms", 1e-3}, {"us", 1e-6},
+	},
+}
+
+// memorySize and logSize are expressed in MiB as in OpenWhisk, so limits only
+// accept the units which are powers of 1024
+var limitUnitSizes = scalarUnits{
+	units: []scalarUnit{
+		{"TiB", 1 << 20}, {"GiB", 1 << 10}, {"MiB", 1}, {"KiB", 1.0 / (1 << 10)}, {"B", 1.0 / (1 << 20)},
+	},
+	ignoreCase: true,
+}
+
+// the units of scalar-unit.size which are powers of 1000 and their counterpart in limits
+var limitDecimalUnits = map[string]string{"kb": "KiB", "mb": "MiB", "gb": "GiB", "tb": "TiB"}
+
+// timeout is expressed in ms
+var limitUnitTimes = scalarUnits{
+	units: []scalarUnit{
+		{"d", 86400000}, {"h", 3600000}, {"m", 60000}, {"s", 1000}, {"ms", 1},
+	},
+}
+
+var scalarUnitTypes = map[string]scalarUnits{
+	SCALAR_UNIT_SIZE: scalarUnitSizes,
+	SCALAR_UNIT_TIME: scalarUnitTimes,
+}
+
+// <scalar> <unit>, any number of spaces is allowed in between
+var scalarUnitRegex = regexp.MustCompile(`^\s*([0-9]*\.?[0-9]+)\s*([A-Za-z]+)\s*$`)
+
+func isScalarUnitType(typeName string) bool {
+	_, ok := scalarUnitTypes[typeName]
+	return ok
+}
+
+func (su scalarUnits) names() []string {
+	names := make([]string, 0, len(su.units))
+	for _, u := range su.units {
+		names = append(names, u.name)
+	}
+	return names
+}
+
+func (su scalarUnits) lookup(name string) (scalarUnit, bool) {
+	for _, u := range su.units {
+		if u.name == name || (su.ignoreCase && strings.EqualFold(u.name, name)) {
+			return u, true
+		}
+	}
+	return scalarUnit{}, false
+}
+
+// parse converts "<scalar> <unit>" into the base unit of su
+func (su scalarUnits) parse(value string) (float64, bool) {
+	matches := scalarUnitRegex.FindStringSubmatch(value)
+	if matches == nil {
+		return 0, false
+	}
+	unit, ok := su.lookup(matches[2])
+	if !ok {
+		return 0, false
+	}
+	scalar, err := strconv.ParseFloat(matches[1], 64)
+	if err != nil {
+		return 0, false
+	}
+	return scalar * unit.multiplier, true
+}
+
+// format renders a value in the base unit of su with the largest unit it is a whole multiple of
+func (su scalarUnits) format(value int) string {
+	for _, u := range su.units {
+		if u.multiplier < 1 {
+			continue
+		}
+		if m := int(u.multiplier); value != 0 && value%m == 0 {
+			return fmt.Sprintf("%d %s", value/m, u.name)
+		}
+	}
+	return fmt.Sprintf("%d %s", value, su.units[len(su.units)-1].name)
+}
+
+func isWholeNumber(value float64) bool {
+	return math.Abs(value-math.Round(value)) < 1e-9
+}
+
+// a whole value is returned as an int, e.g. 1 GB is 1000000000, while 1500 ms is 1.5
+func normalizedNumber(value float64) interface{} {
+	if isWholeNumber(value) {
+		return int(math.Round(value))
+	}
+	return value
+}
+
+/*
+   ResolveScalarUnit converts a scalar-unit.size or scalar-unit.time value into
+   its base unit, i.e. bytes for sizes and seconds for times.
+
+   Inputs:
+   - filePath: the path, including name, of the YAML file which contained the parameter for error reporting
+   - paramName: name of the parameter for error reporting
+   - typeName: scalar-unit.size or scalar-unit.time
+   - value: the value with its unit, e.g. "256 MB" or "30 s"
+
+   Returns:
+   - (interface{}) the value in the base unit, as int when it is a whole number, float64 otherwise
+*/
+func ResolveScalarUnit(filePath string, paramName string, typeName string, value interface{}) (interface{}, error) {
+	units, ok := scalarUnitTypes[typeName]
+	if !ok {
+		return value, wskderrors.NewInvalidParameterTypeError(filePath, paramName, typeName)
+	}
+	// zero is the default value of scalar-unit types and needs no unit
+	if value == nil || value == getTypeDefaultValue(typeName) {
+		return getTypeDefaultValue(typeName), nil
+	}
+	if str, ok := value.(string); ok {
+		if v, ok := units.parse(str); ok {
+			return normalizedNumber(v), nil
+		}
+	}
+	return value, wskderrors.NewInvalidScalarUnitError(filePath, paramName, fmt.Sprintf("%v", value), typeName, units.names())
+}
+
+// limitValue converts a limit which is either an integer in the limit unit or a
+// scalar-unit string, non whole values are rounded up to the next integer,
+// the path of the manifest is set on the error by ParseManifest
+func limitValue(name string, value interface{}, typeName string, units scalarUnits) (*int, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case int:
+		return &v, nil
+	case string:
+		if converted, ok := units.parse(v); ok {
+			limit := int(math.Ceil(converted - 1e-9))
+			if !isWholeNumber(converted) {
+				warningString := wski18n.T(wski18n.ID_WARN_LIMIT_ROUNDED_X_limit_X_value_X,
+					map[string]interface{}{
+						wski18n.KEY_LIMIT: name,
+						wski18n.KEY_VALUE: units.format(limit)})
+				wskprint.PrintlnOpenWhiskWarning(warningString)
+			}
+			return &limit, nil
+		}
+	}
+	err := wskderrors.NewInvalidScalarUnitError("", name, fmt.Sprintf("%v", value), typeName, units.names())
+	// 1 MB is 10^6 bytes in parameters, it is not silently taken as 1 MiB in limits
+	if str, ok := value.(string); ok && typeName == SCALAR_UNIT_SIZE {
+		if matches := scalarUnitRegex.FindStringSubmatch(str); matches != nil {
+			if unit, ok := limitDecimalUnits[strings.ToLower(matches[2])]; ok {
+				err.AppendDetail(wski18n.T(wski18n.ID_ERR_LIMIT_DECIMAL_UNIT_X_limit_X_value_X_unit_X,
+					map[string]interface{}{
+						wski18n.KEY_LIMIT: name,
+						wski18n.KEY_VALUE: matches[2],
+						wski18n.KEY_UNIT:  unit}))
+			}
+		}
+	}
+	return nil, err
+}
+
+// limitsYAML mirrors Limits with values that are either integers or scalar-unit strings
+type limitsYAML struct {
+	Timeout               interface{} `yaml:"timeout,omitempty"`
+	Memory                interface{} `yaml:"memorySize,omitempty"`
+	Logsize               interface{} `yaml:"logSize,omitempty"`
+	Concurrency           *int        `yaml:"concurrency,omitempty"`
+	ConcurrentActivations *int        `yaml:"concurrentActivations,omitempty"`
+	UserInvocationRate    *int        `yaml:"userInvocationRate,omitempty"`
+	CodeSize              *int        `yaml:"codeSize,omitempty"`
+	ParameterSize         *int        `yaml:"parameterSize,omitempty"`
+}
+
+// Limits accept scalar-unit values such as "5 m" for timeout or "1 GiB" for memorySize
+func (l *Limits) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var aux limitsYAML
+	var err error
+
+	if err = unmarshal(&aux); err != nil {
+		return err
+	}
+	if l.Timeout, err = limitValue(LIMIT_VALUE_TIMEOUT, aux.Timeout, SCALAR_UNIT_TIME, limitUnitTimes); err != nil {
+		return err
+	}
+	if l.Memory, err = limitValue(LIMIT_VALUE_MEMORY_SIZE, aux.Memory, SCALAR_UNIT_SIZE, limitUnitSizes); err != nil {
+		return err
+	}
+	if l.Logsize, err = limitValue(LIMIT_VALUE_LOG_SIZE, aux.Logsize, SCALAR_UNIT_SIZE, limitUnitSizes); err != nil {
+		return err
+	}
+	l.Concurrency = aux.Concurrency
+	l.ConcurrentActivations = aux.ConcurrentActivations
+	l.UserInvocationRate = aux.UserInvocationRate
+	l.CodeSize = aux.CodeSize
+	l.ParameterSize = aux.ParameterSize
+	return nil
+}
+
+// Limits are rendered with friendly units, e.g. timeout 300000 as "5 m"
+func (l Limits) MarshalYAML() (interface{}, error) {
+	aux := limitsYAML{
+		Concurrency:           l.Concurrency,
+		ConcurrentActivations: l.ConcurrentActivations,
+		UserInvocationRate:    l.UserInvocationRate,
+		CodeSize:              l.CodeSize,
+		ParameterSize:         l.ParameterSize,
+	}
+	if l.Timeout != nil {
+		aux.Timeout = limitUnitTimes.format(*l.Timeout)
+	}
+	if l.Memory != nil {
+		aux.Memory = limitUnitSizes.format(*l.Memory)
+	}
+	if l.Logsize != nil {
+		aux.Logsize = limitUnitSizes.format(*l.Logsize)
+	}
+	return aux, nil
+}
@@ -0,0 +1,110 @@
+// +build unit
+
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parsers
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/sciabarracom/openwhisk-wskdeploy/wskderrors"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+func TestResolveScalarUnit(t *testing.T) {
+	v, err := ResolveScalarUnit("", "size", SCALAR_UNIT_SIZE, "256 MB")
+	assert.Nil(t, err)
+	assert.Equal(t, 256000000, v)
+
+	v, err = ResolveScalarUnit("", "size", SCALAR_UNIT_SIZE, "1 kib")
+	assert.Nil(t, err)
+	assert.Equal(t, 1024, v)
+
+	v, err = ResolveScalarUnit("", "time", SCALAR_UNIT_TIME, "30 s")
+	assert.Nil(t, err)
+	assert.Equal(t, 30, v)
+
+	v, err = ResolveScalarUnit("", "time", SCALAR_UNIT_TIME, "1500 ms")
+	assert.Nil(t, err)
+	assert.Equal(t, 1.5, v)
+
+	v, err = ResolveScalarUnit("", "time", SCALAR_UNIT_TIME, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, v)
+
+	_, err = ResolveScalarUnit("", "time", SCALAR_UNIT_TIME, "5 weeks")
+	assert.IsType(t, &wskderrors.InvalidScalarUnitError{}, err)
+
+	_, err = ResolveScalarUnit("", "size", SCALAR_UNIT_SIZE, 512)
+	assert.IsType(t, &wskderrors.InvalidScalarUnitError{}, err)
+}
+
+func TestResolveParameterForScalarUnit(t *testing.T) {
+	param := Parameter{Type: SCALAR_UNIT_SIZE, Value: "2 GB", multiline: true}
+	v, err := ResolveParameter("size", &param, "")
+	assert.Nil(t, err)
+	assert.Equal(t, 2000000000, v)
+
+	param = Parameter{Type: SCALAR_UNIT_TIME, Value: "bad", multiline: true}
+	_, err = ResolveParameter("time", &param, "")
+	assert.IsType(t, &wskderrors.InvalidScalarUnitError{}, err)
+}
+
+func TestLimitsScalarUnit(t *testing.T) {
+	var limits Limits
+	data := "timeout: 5 m\nmemorySize: 1 GiB\nlogSize: 512 KiB\ncodeSize: 10\n"
+	err := yaml.UnmarshalStrict([]byte(data), &limits)
+	assert.Nil(t, err)
+	assert.Equal(t, 300000, *limits.Timeout)
+	assert.Equal(t, 1024, *limits.Memory)
+	// half a megabyte is rounded up
+	assert.Equal(t, 1, *limits.Logsize)
+	assert.Equal(t, 10, *limits.CodeSize)
+
+	// plain integers are in the limit unit
+	err = yaml.UnmarshalStrict([]byte("timeout: 180\nmemorySize: 128\n"), &limits)
+	assert.Nil(t, err)
+	assert.Equal(t, 180, *limits.Timeout)
+	assert.Equal(t, 128, *limits.Memory)
+
+	err = yaml.UnmarshalStrict([]byte("memorySize: 1 parsec\n"), &limits)
+	assert.IsType(t, &wskderrors.InvalidScalarUnitError{}, err)
+
+	// MB is a power of 1000 as in parameters, limits name the unit to use instead
+	err = yaml.UnmarshalStrict([]byte("memorySize: 256 MB\n"), &limits)
+	assert.IsType(t, &wskderrors.InvalidScalarUnitError{}, err)
+	assert.Contains(t, err.Error(), "[MiB] instead of [MB]")
+
+	timeout, memory, logsize, concurrency := 300000, 1024, 1, 10
+	out, err := yaml.Marshal(Limits{Timeout: &timeout, Memory: &memory, Logsize: &logsize, Concurrency: &concurrency})
+	assert.Nil(t, err)
+	assert.Equal(t, "timeout: 5 m\nmemorySize: 1 GiB\nlogSize: 1 MiB\nconcurrency: 10\n", string(out))
+}
+
+func TestLimitsScalarUnit_ManifestPath(t *testing.T) {
+	manifestPath := filepath.Join(t.TempDir(), "manifest.yaml")
+	manifest := "packages:\n  demo:\n    actions:\n      hello:\n        function: hello.js\n        limits:\n          timeout: 5 weeks\n"
+	assert.Nil(t, ioutil.WriteFile(manifestPath, []byte(manifest), 0644))
+
+	_, err := NewYAMLParser().ParseManifest(manifestPath)
+	assert.IsType(t, &wskderrors.InvalidScalarUnitError{}, err)
+	assert.Equal(t, manifestPath, err.(*wskderrors.InvalidScalarUnitError).ErrorFilePath)
+}
@@ -0,0 +1,185 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parsers
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/sciabarracom/openwhisk-wskdeploy/utils"
+	"github.com/sciabarracom/openwhisk-wskdeploy/wskderrors"
+	"github.com/sciabarracom/openwhisk-wskdeploy/wskenv"
+	"github.com/sciabarracom/openwhisk-wskdeploy/wski18n"
+	"github.com/sciabarracom/openwhisk-wskdeploy/wskprint"
+)
+
+// parameters of the alarm feed of the alarms package
+const (
+	SCHEDULE_PARAM_CRON         = "cron"
+	SCHEDULE_PARAM_TIMEZONE     = "timezone"
+	SCHEDULE_PARAM_PAYLOAD      = "trigger_payload"
+	SCHEDULE_PARAM_START_DATE   = "startDate"
+	SCHEDULE_PARAM_STOP_DATE    = "stopDate"
+	SCHEDULE_PARAM_MAX_TRIGGERS = "maxTriggers"
+)
+
+const (
+	SCHEDULE_ALARMS_PACKAGE = "/whisk.system/alarms"
+	SCHEDULE_ALARM_FEED     = "alarm"
+	SCHEDULE_KEY_START      = "start"
+	SCHEDULE_KEY_STOP       = "stop"
+)
+
+// layouts accepted for the start and stop dates, the ones without an offset are in the schedule timezone
+var SCHEDULE_DATE_LAYOUTS = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// Schedule fires a trigger on a cron expression through the alarm feed of the alarms package
+type Schedule struct {
+	Cron        string                 `yaml:"cron"`
+	Timezone    string                 `yaml:"timezone,omitempty"`
+	Payload     map[string]interface{} `yaml:"payload,omitempty"`
+	Start       string                 `yaml:"start,omitempty"`
+	Stop        string                 `yaml:"stop,omitempty"`
+	MaxTriggers int                    `yaml:"max-triggers,omitempty"`
+}
+
+// ScheduleFeed returns the alarm feed of the alarms package set with --alarms-package
+func ScheduleFeed() string {
+	alarms := utils.Flags.AlarmsPackage
+	if len(alarms) == 0 {
+		alarms = SCHEDULE_ALARMS_PACKAGE
+	}
+	return strings.TrimSuffix(alarms, PATH_SEPARATOR) + PATH_SEPARATOR + SCHEDULE_ALARM_FEED
+}
+
+// ParseScheduleDate parses a start or stop date in the timezone location unless it has an offset
+func ParseScheduleDate(value string, location *time.Location) (time.Time, bool) {
+	for _, layout := range SCHEDULE_DATE_LAYOUTS {
+		if t, err := time.ParseInLocation(layout, value, location); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// composeSchedule validates the schedule of a trigger as the alarms package would
+// and returns the inputs of its alarm feed
+func (dm *YAMLParser) composeSchedule(triggerName string, schedule Schedule, ctx *wskenv.Context, filePath string, now time.Time) (whisk.KeyValueArr, error) {
+	var err error
+	fields := []*string{&schedule.Cron, &schedule.Timezone, &schedule.Start, &schedule.Stop}
+	for _, field := range fields {
+		if *field, err = wskenv.InterpolateString(*field, ctx); err != nil {
+			return nil, err
+		}
+	}
+	scheduleError := func(id string, keys map[string]interface{}) error {
+		keys[wski18n.KEY_TRIGGER] = triggerName
+		return wskderrors.NewYAMLFileFormatError(filePath, wski18n.T(id, keys))
+	}
+
+	location := time.UTC
+	if len(schedule.Timezone) != 0 {
+		if location, err = time.LoadLocation(schedule.Timezone); err != nil {
+			return nil, scheduleError(wski18n.ID_ERR_SCHEDULE_TIMEZONE_X_trigger_X_value_X,
+				map[string]interface{}{wski18n.KEY_VALUE: schedule.Timezone})
+		}
+	}
+	cron, err := utils.ParseCron(schedule.Cron, location)
+	if err != nil {
+		return nil, scheduleError(wski18n.ID_ERR_SCHEDULE_CRON_X_trigger_X_err_X,
+			map[string]interface{}{wski18n.KEY_ERR: err.Error()})
+	}
+
+	inputs := make(whisk.KeyValueArr, 0)
+	inputs = append(inputs, whisk.KeyValue{Key: SCHEDULE_PARAM_CRON, Value: strings.Join(strings.Fields(schedule.Cron), " ")})
+	if len(schedule.Timezone) != 0 {
+		inputs = append(inputs, whisk.KeyValue{Key: SCHEDULE_PARAM_TIMEZONE, Value: schedule.Timezone})
+	}
+	if len(schedule.Payload) != 0 {
+		payload := make(map[string]interface{}, len(schedule.Payload))
+		for key, value := range schedule.Payload {
+			payload[key] = utils.ConvertInterfaceValue(value)
+		}
+		inputs = append(inputs, whisk.KeyValue{Key: SCHEDULE_PARAM_PAYLOAD, Value: payload})
+	}
+
+	from := now
+	dates := []struct {
+		key   string
+		value string
+		param string
+	}{
+		{SCHEDULE_KEY_START, schedule.Start, SCHEDULE_PARAM_START_DATE},
+		{SCHEDULE_KEY_STOP, schedule.Stop, SCHEDULE_PARAM_STOP_DATE},
+	}
+	for _, date := range dates {
+		if len(date.value) == 0 {
+			continue
+		}
+		t, ok := ParseScheduleDate(date.value, location)
+		if !ok {
+			return nil, scheduleError(wski18n.ID_ERR_SCHEDULE_DATE_X_trigger_X_key_X_value_X,
+				map[string]interface{}{wski18n.KEY_KEY: date.key, wski18n.KEY_VALUE: date.value})
+		}
+		// a start date which has passed, e.g. when the trigger is deployed again, is not passed to the alarm feed
+		if !t.After(now) && date.key == SCHEDULE_KEY_START {
+			warningString := wski18n.T(wski18n.ID_WARN_SCHEDULE_START_PAST_X_trigger_X_value_X,
+				map[string]interface{}{
+					wski18n.KEY_TRIGGER: triggerName,
+					wski18n.KEY_VALUE:   date.value})
+			wskprint.PrintlnOpenWhiskWarning(warningString)
+			continue
+		}
+		if !t.After(now) {
+			return nil, scheduleError(wski18n.ID_ERR_SCHEDULE_DATE_PAST_X_trigger_X_key_X_value_X,
+				map[string]interface{}{wski18n.KEY_KEY: date.key, wski18n.KEY_VALUE: date.value})
+		}
+		if date.key == SCHEDULE_KEY_STOP && !t.After(from) {
+			return nil, scheduleError(wski18n.ID_ERR_SCHEDULE_STOP_BEFORE_START_X_trigger_X,
+				map[string]interface{}{})
+		}
+		if date.key == SCHEDULE_KEY_START {
+			from = t
+		}
+		inputs = append(inputs, whisk.KeyValue{Key: date.param, Value: t.UTC().Format(time.RFC3339)})
+	}
+
+	next, ok := cron.Next(from)
+	if stop, _ := ParseScheduleDate(schedule.Stop, location); !ok || (len(schedule.Stop) != 0 && next.After(stop)) {
+		return nil, scheduleError(wski18n.ID_ERR_SCHEDULE_NEVER_FIRES_X_trigger_X,
+			map[string]interface{}{})
+	}
+
+	if schedule.MaxTriggers < 0 {
+		return nil, scheduleError(wski18n.ID_ERR_SCHEDULE_MAX_TRIGGERS_X_trigger_X_value_X,
+			map[string]interface{}{wski18n.KEY_VALUE: strconv.Itoa(schedule.MaxTriggers)})
+	}
+	if schedule.MaxTriggers > 0 {
+		inputs = append(inputs, whisk.KeyValue{Key: SCHEDULE_PARAM_MAX_TRIGGERS, Value: schedule.MaxTriggers})
+	}
+	return inputs, nil
+}
@@ -0,0 +1,71 @@
+// +build unit
+
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parsers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComposeScheduleErrors(t *testing.T) {
+	now := time.Date(2021, time.March, 10, 10, 0, 0, 0, time.UTC)
+	dm := NewYAMLParser()
+
+	_, err := dm.composeSchedule("ticker", Schedule{Cron: "*/5 * * * *", Start: "2021-03-11", Stop: "2021-03-12"}, nil, "manifest.yaml", now)
+	assert.Nil(t, err)
+
+	for name, schedule := range map[string]Schedule{
+		"cron":          {Cron: "* * * *"},
+		"timezone":      {Cron: "* * * * *", Timezone: "Mars/Olympus"},
+		"date":          {Cron: "* * * * *", Start: "tomorrow"},
+		"past":          {Cron: "* * * * *", Stop: "2021-03-09"},
+		"stop":          {Cron: "* * * * *", Start: "2021-03-12", Stop: "2021-03-11"},
+		"never":         {Cron: "0 0 1 1 *", Stop: "2021-06-01"},
+		"max triggers":  {Cron: "* * * * *", MaxTriggers: -1},
+		"leap february": {Cron: "0 0 30 2 *"},
+	} {
+		_, err := dm.composeSchedule("ticker", schedule, nil, "manifest.yaml", now)
+		assert.NotNil(t, err, "Expected an error for an invalid "+name)
+		if err != nil {
+			assert.Contains(t, err.Error(), "[ticker]")
+		}
+	}
+}
+
+func TestComposeSchedulePastStart(t *testing.T) {
+	now := time.Date(2021, time.March, 10, 10, 0, 0, 0, time.UTC)
+
+	// a trigger deployed again after its start date keeps firing from now on
+	inputs, err := NewYAMLParser().composeSchedule("ticker", Schedule{Cron: "*/5 * * * *", Start: "2021-03-09", Stop: "2021-03-12"}, nil, "manifest.yaml", now)
+	assert.Nil(t, err)
+	assert.Nil(t, inputs.GetValue(SCHEDULE_PARAM_START_DATE))
+	assert.Equal(t, "2021-03-12T00:00:00Z", inputs.GetValue(SCHEDULE_PARAM_STOP_DATE))
+}
+
+func TestComposeScheduleWithFeed(t *testing.T) {
+	pkg := Package{Triggers: map[string]Trigger{
+		"ticker": {Feed: "/whisk.system/alarms/interval", Schedule: &Schedule{Cron: "* * * * *"}},
+	}}
+	_, err := NewYAMLParser().ComposeTriggers("manifest.yaml", pkg, whisk.KeyValue{}, PackageInputs{})
+	assert.NotNil(t, err, "Expected an error for a trigger with both a feed and a schedule")
+}
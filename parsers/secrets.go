@@ -0,0 +1,227 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parsers
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/sciabarracom/openwhisk-wskdeploy/utils"
+	"github.com/sciabarracom/openwhisk-wskdeploy/wskderrors"
+	"github.com/sciabarracom/openwhisk-wskdeploy/wskenv"
+	"github.com/sciabarracom/openwhisk-wskdeploy/wski18n"
+	"github.com/sciabarracom/openwhisk-wskdeploy/wskprint"
+)
+
+// built-in secret providers
+const (
+	SECRET_FROM_FILE    = "file"
+	SECRET_FROM_ENV     = "env"
+	SECRET_FROM_COMMAND = "command"
+)
+
+const PARAM_SOURCE_SECRET = "secret"
+
+/*
+   Secret marks an input as secret, with secret: true, and optionally reads its value from a provider:
+
+   secret: {from: file, path: ~/.secrets/db}
+   secret: {from: env, name: DB_PASSWORD}
+   secret: {from: command, command: pass show db}
+*/
+type Secret struct {
+	Enabled bool   `yaml:"-"`
+	From    string `yaml:"from,omitempty"`
+	Path    string `yaml:"path,omitempty"`
+	Name    string `yaml:"name,omitempty"`
+	Command string `yaml:"command,omitempty"`
+}
+
+func (secret *Secret) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var enabled bool
+	if err := unmarshal(&enabled); err == nil {
+		*secret = Secret{Enabled: enabled}
+		return nil
+	}
+	type secretSource Secret
+	var s secretSource
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	*secret = Secret(s)
+	secret.Enabled = true
+	return nil
+}
+
+// a secret without a provider is exported as secret: true
+func (secret Secret) MarshalYAML() (interface{}, error) {
+	if len(secret.From) == 0 {
+		return secret.Enabled, nil
+	}
+	type secretSource Secret
+	return secretSource(secret), nil
+}
+
+// SecretProvider returns the value of a secret, manifestPath is the manifest declaring it
+type SecretProvider interface {
+	Resolve(secret Secret, manifestPath string) (string, error)
+}
+
+// SecretProviderFunc adapts a function to a SecretProvider
+type SecretProviderFunc func(secret Secret, manifestPath string) (string, error)
+
+func (f SecretProviderFunc) Resolve(secret Secret, manifestPath string) (string, error) {
+	return f(secret, manifestPath)
+}
+
+var secretProviders = map[string]SecretProvider{
+	SECRET_FROM_FILE:    SecretProviderFunc(fileSecret),
+	SECRET_FROM_ENV:     SecretProviderFunc(envSecret),
+	SECRET_FROM_COMMAND: SecretProviderFunc(commandSecret),
+}
+
+// RegisterSecretProvider makes the provider available as secret: {from: <name>}
+func RegisterSecretProvider(name string, provider SecretProvider) {
+	secretProviders[name] = provider
+}
+
+func secretMissingKey(provider string, key string) error {
+	return errors.New(wski18n.T(wski18n.ID_ERR_SECRET_MISSING_KEY_X_provider_X_key_X,
+		map[string]interface{}{
+			wski18n.KEY_PROVIDER: provider,
+			wski18n.KEY_KEY:      key}))
+}
+
+// fileSecret reads the secret from a file, relative to the manifest unless absolute or under ~
+func fileSecret(secret Secret, manifestPath string) (string, error) {
+	if len(secret.Path) == 0 {
+		return "", secretMissingKey(SECRET_FROM_FILE, "path")
+	}
+	path := secret.Path
+	if strings.HasPrefix(path, "~/") {
+		path = filepath.Join(utils.GetHomeDirectory(), path[2:])
+	} else if !filepath.IsAbs(path) {
+		path = filepath.Join(filepath.Dir(manifestPath), path)
+	}
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(content), "\r\n"), nil
+}
+
+// envSecret reads the secret from an environment variable, which must be set
+func envSecret(secret Secret, manifestPath string) (string, error) {
+	if len(secret.Name) == 0 {
+		return "", secretMissingKey(SECRET_FROM_ENV, "name")
+	}
+	value, ok := wskenv.LookupEnv(secret.Name)
+	if !ok {
+		return "", errors.New(wski18n.T(wski18n.ID_ERR_SECRET_ENV_NOT_SET_X_name_X,
+			map[string]interface{}{wski18n.KEY_NAME: secret.Name}))
+	}
+	return value, nil
+}
+
+// commandSecret reads the secret from the output of a shell command, run in the directory of the manifest
+func commandSecret(secret Secret, manifestPath string) (string, error) {
+	if len(secret.Command) == 0 {
+		return "", secretMissingKey(SECRET_FROM_COMMAND, "command")
+	}
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd", "/C", secret.Command)
+	} else {
+		cmd = exec.Command("sh", "-c", secret.Command)
+	}
+	cmd.Dir = filepath.Dir(manifestPath)
+	cmd.Stderr = os.Stderr
+	output, err := cmd.Output()
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(output), "\r\n"), nil
+}
+
+// resolveSecret returns the value of the secret input from its provider
+func resolveSecret(paramName string, param *Parameter, filePath string) (interface{}, error) {
+	secretError := func(err error) error {
+		errMessage := wski18n.T(wski18n.ID_ERR_SECRET_X_name_X_err_X,
+			map[string]interface{}{
+				wski18n.KEY_NAME: paramName,
+				wski18n.KEY_ERR:  err.Error()})
+		return wskderrors.NewYAMLFileFormatError(filePath, errMessage)
+	}
+
+	provider, ok := secretProviders[param.Secret.From]
+	if !ok {
+		var names []string
+		for name := range secretProviders {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return nil, secretError(errors.New(wski18n.T(wski18n.ID_ERR_SECRET_PROVIDER_X_provider_X_providers_X,
+			map[string]interface{}{
+				wski18n.KEY_PROVIDER:  param.Secret.From,
+				wski18n.KEY_PROVIDERS: strings.Join(names, ", ")})))
+	}
+	value, err := provider.Resolve(param.Secret, filePath)
+	if err != nil {
+		return nil, secretError(err)
+	}
+	wskprint.RegisterSecret(value)
+	if len(param.Type) == 0 {
+		param.Type = STRING
+	}
+	return CoerceParameterValue(paramName, param, value, filePath, ParameterSource(PARAM_SOURCE_SECRET, param.Secret.From))
+}
+
+// RegisterSecretValue redacts the value of a secret input from all output
+func RegisterSecretValue(param Parameter, value interface{}) {
+	if !param.Secret.Enabled {
+		return
+	}
+	if s, ok := value.(string); ok {
+		wskprint.RegisterSecret(s)
+	}
+}
+
+// secretInputs returns the names of the secret inputs
+func secretInputs(inputs map[string]Parameter) []string {
+	var names []string
+	for name, param := range inputs {
+		if param.Secret.Enabled {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+var secretPlaceholderRegex = regexp.MustCompile(`[^A-Z0-9_]+`)
+
+// secretPlaceholder returns the ${VAR} exported in place of the value of a secret input
+func secretPlaceholder(name string) string {
+	return "${" + secretPlaceholderRegex.ReplaceAllString(strings.ToUpper(name), "_") + "}"
+}
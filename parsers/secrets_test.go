@@ -0,0 +1,113 @@
+// +build unit
+
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parsers
+
+import (
+	"os"
+	"sort"
+	"testing"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/sciabarracom/openwhisk-wskdeploy/utils"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+func TestSecretUnmarshal(t *testing.T) {
+	var inputs map[string]Parameter
+	src := `
+plain: value
+marked:
+  value: $PASSWORD
+  secret: true
+unmarked:
+  value: visible
+  secret: false
+file:
+  secret:
+    from: file
+    path: ~/.secrets/db
+`
+	assert.Nil(t, yaml.Unmarshal([]byte(src), &inputs))
+	assert.False(t, inputs["plain"].Secret.Enabled)
+	assert.True(t, inputs["marked"].Secret.Enabled)
+	assert.False(t, inputs["unmarked"].Secret.Enabled)
+	assert.Equal(t, Secret{Enabled: true, From: SECRET_FROM_FILE, Path: "~/.secrets/db"}, inputs["file"].Secret)
+	names := secretInputs(inputs)
+	sort.Strings(names)
+	assert.Equal(t, []string{"file", "marked"}, names)
+}
+
+func TestSecretProviders(t *testing.T) {
+	os.Setenv("WSKDEPLOY_TEST_SECRET", "from-env")
+	defer os.Unsetenv("WSKDEPLOY_TEST_SECRET")
+
+	for expected, secret := range map[string]Secret{
+		"s3cr3t-pass": {From: SECRET_FROM_FILE, Path: "secret_password.txt"},
+		"from-env":    {From: SECRET_FROM_ENV, Name: "WSKDEPLOY_TEST_SECRET"},
+		"from-cmd":    {From: SECRET_FROM_COMMAND, Command: "echo from-cmd"},
+	} {
+		value, err := secretProviders[secret.From].Resolve(secret, "../tests/dat/manifest.yaml")
+		assert.Nil(t, err)
+		assert.Equal(t, expected, value)
+	}
+
+	// custom providers are available by name
+	RegisterSecretProvider("vault", SecretProviderFunc(func(secret Secret, manifestPath string) (string, error) {
+		return "vault:" + secret.Path, nil
+	}))
+	defer delete(secretProviders, "vault")
+	param := Parameter{Secret: Secret{Enabled: true, From: "vault", Path: "db"}}
+	value, err := resolveParameter("db", &param, "manifest.yaml", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "vault:db", value)
+}
+
+func TestSecretErrors(t *testing.T) {
+	os.Unsetenv("WSKDEPLOY_TEST_SECRET")
+	for expected, secret := range map[string]Secret{
+		"[unknown]":               {From: "unknown"},
+		"[path]":                  {From: SECRET_FROM_FILE},
+		"[WSKDEPLOY_TEST_SECRET]": {From: SECRET_FROM_ENV, Name: "WSKDEPLOY_TEST_SECRET"},
+		"[command]":               {From: SECRET_FROM_COMMAND},
+	} {
+		param := Parameter{Secret: secret}
+		_, err := resolveSecret("db", &param, "manifest.yaml")
+		assert.NotNil(t, err, "Expected an error for "+expected)
+		assert.Contains(t, err.Error(), expected)
+		assert.Contains(t, err.Error(), "[db]")
+	}
+}
+
+func TestExportSecretInputs(t *testing.T) {
+	parameters := whisk.KeyValueArr{{Key: "user", Value: "admin"}, {Key: "db-password", Value: "hunter22"}}
+	annotations := whisk.KeyValueArr{utils.SecretInputsAnnotation([]string{"db-password"})}
+
+	var manifest YAML
+	action := manifest.ComposeParsersAction(whisk.Action{Parameters: parameters, Annotations: annotations, Exec: &whisk.Exec{Kind: "nodejs:default"}})
+	assert.Equal(t, "admin", action.Inputs["user"].Value)
+	assert.Equal(t, "${DB_PASSWORD}", action.Inputs["db-password"].Value)
+	assert.NotContains(t, action.Annotations, utils.SECRET_INPUTS)
+
+	exported, err := yaml.Marshal(action.Inputs)
+	assert.Nil(t, err)
+	assert.NotContains(t, string(exported), "hunter22")
+	assert.Contains(t, string(exported), "secret: true")
+}
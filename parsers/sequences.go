@@ -0,0 +1,315 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parsers
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/sciabarracom/openwhisk-wskdeploy/dependencies"
+	"github.com/sciabarracom/openwhisk-wskdeploy/wskderrors"
+	"github.com/sciabarracom/openwhisk-wskdeploy/wski18n"
+)
+
+const SEQUENCE_CYCLE_SEPARATOR = " -> "
+
+// names of the actions and package bindings generated for the steps of a sequence binding inputs
+const (
+	SEQUENCE_STEP_ACTION_FORMAT  = "%s-%d-%s" // <sequence>-<step>-<action>
+	SEQUENCE_STEP_BINDING_FORMAT = "%s-%d"    // <package>-<sequence>-<step>
+)
+
+// SequenceStep is a step of a sequence, an action with optional inputs bound for this step only
+type SequenceStep struct {
+	Action string               `yaml:"action"`
+	Inputs map[string]Parameter `yaml:"inputs,omitempty"`
+}
+
+// a step is either the name of its action or a map with its action and inputs
+func (step *SequenceStep) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var action string
+	if err := unmarshal(&action); err == nil {
+		*step = SequenceStep{Action: action}
+		return nil
+	}
+	type sequenceStep SequenceStep
+	var s sequenceStep
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	*step = SequenceStep(s)
+	return nil
+}
+
+// Components returns the names of the actions of the sequence, in order
+func (sequence Sequence) Components() []string {
+	var steps []string
+	for _, step := range strings.Split(sequence.Actions, ",") {
+		steps = append(steps, strings.TrimSpace(step))
+	}
+	return steps
+}
+
+// entityName returns the name of an action or sequence of the package relative to the namespace
+func entityName(packageName string, name string) string {
+	if strings.ToLower(packageName) == DEFAULT_PACKAGE {
+		return name
+	}
+	return packageName + PATH_SEPARATOR + name
+}
+
+func (pkg Package) hasActionOrSequence(name string) bool {
+	if _, ok := pkg.Actions[name]; ok {
+		return true
+	}
+	_, ok := pkg.Sequences[name]
+	return ok
+}
+
+func findDependency(label string, manifestPackages map[string]Package) (Dependency, bool) {
+	for _, pkg := range manifestPackages {
+		if dependency, ok := pkg.Dependencies[label]; ok {
+			return dependency, true
+		}
+	}
+	return Dependency{}, false
+}
+
+func isDependencyLabel(label string, manifestPackages map[string]Package) bool {
+	_, ok := findDependency(label, manifestPackages)
+	return ok
+}
+
+/*
+   resolveSequenceStep returns the name, relative to the namespace, of the action a step of a sequence
+   of the package refers to, false if it is neither in the manifest nor in a dependency binding.
+
+   Absolute names, starting with the namespace, are returned unchanged and are checked against
+   the live namespace when deployed; so are the actions of dependency bindings, named dep/action.
+*/
+func resolveSequenceStep(step string, packageName string, manifestPackages map[string]Package) (string, bool) {
+	if strings.HasPrefix(step, PATH_SEPARATOR) {
+		return step, true
+	}
+	parts := strings.Split(step, PATH_SEPARATOR)
+	switch len(parts) {
+	case 1:
+		if manifestPackages[packageName].hasActionOrSequence(step) {
+			return entityName(packageName, step), true
+		}
+	case 2:
+		if pkg, ok := manifestPackages[parts[0]]; ok {
+			if pkg.hasActionOrSequence(parts[1]) {
+				return entityName(parts[0], parts[1]), true
+			}
+		} else if isDependencyLabel(parts[0], manifestPackages) {
+			return step, true
+		}
+	}
+	return "", false
+}
+
+/*
+   checkSequenceCycles returns an error for the first sequence found including itself,
+   directly or through other sequences of the manifest.
+*/
+func checkSequenceCycles(filePath string, manifestPackages map[string]Package) error {
+	graph := make(map[string][]string)
+	for packageName, pkg := range manifestPackages {
+		for name, sequence := range pkg.Sequences {
+			node := entityName(packageName, name)
+			graph[node] = make([]string, 0)
+			for _, step := range sequence.Components() {
+				if resolved, ok := resolveSequenceStep(step, packageName, manifestPackages); ok {
+					graph[node] = append(graph[node], resolved)
+				}
+			}
+		}
+	}
+
+	nodes := make([]string, 0, len(graph))
+	for node := range graph {
+		nodes = append(nodes, node)
+	}
+	sort.Strings(nodes)
+
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := make(map[string]int)
+	var stack []string
+	var visit func(node string) []string
+	visit = func(node string) []string {
+		state[node] = visiting
+		stack = append(stack, node)
+		for _, next := range graph[node] {
+			if _, isSequence := graph[next]; !isSequence {
+				continue
+			}
+			switch state[next] {
+			case visiting:
+				for i, n := range stack {
+					if n == next {
+						return append(append([]string{}, stack[i:]...), next)
+					}
+				}
+			case 0:
+				if cycle := visit(next); cycle != nil {
+					return cycle
+				}
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[node] = visited
+		return nil
+	}
+
+	for _, node := range nodes {
+		if state[node] != 0 {
+			continue
+		}
+		if cycle := visit(node); cycle != nil {
+			errMessage := wski18n.T(wski18n.ID_ERR_SEQUENCE_CYCLE_X_sequence_X_path_X,
+				map[string]interface{}{
+					wski18n.KEY_SEQUENCE: cycle[0],
+					wski18n.KEY_PATH:     strings.Join(cycle, SEQUENCE_CYCLE_SEPARATOR)})
+			return wskderrors.NewYAMLFileFormatError(filePath, errMessage)
+		}
+	}
+	return nil
+}
+
+// expandSequenceSteps turns the steps of the sequences of the manifest into their actions
+func expandSequenceSteps(manifest *YAML, manifestPath string) error {
+	var err error
+	for name, pkg := range manifest.Packages {
+		if manifest.Packages[name], err = pkg.expandSequenceSteps(name, manifestPath, manifest.Packages); err != nil {
+			return err
+		}
+	}
+	for name, pkg := range manifest.Project.Packages {
+		if manifest.Project.Packages[name], err = pkg.expandSequenceSteps(name, manifestPath, manifest.Project.Packages); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/*
+   expandSequenceSteps replaces the steps of each sequence of the package by their actions.
+
+   A step binding inputs to an action of the manifest uses a copy of that action, added to the package,
+   with the inputs merged into its own; a step binding inputs to an action of another package,
+   a dependency or an absolute package name, uses a new package binding added to the dependencies.
+   Either is deployed, synced and undeployed with the project.
+*/
+func (pkg Package) expandSequenceSteps(packageName string, manifestPath string, manifestPackages map[string]Package) (Package, error) {
+	for name, sequence := range pkg.Sequences {
+		if len(sequence.Steps) == 0 {
+			continue
+		}
+		if len(strings.TrimSpace(sequence.Actions)) != 0 {
+			errMessage := wski18n.T(wski18n.ID_ERR_SEQUENCE_ACTIONS_AND_STEPS_X_sequence_X,
+				map[string]interface{}{wski18n.KEY_SEQUENCE: name})
+			return pkg, wskderrors.NewYAMLFileFormatError(manifestPath, errMessage)
+		}
+
+		steps := make([]string, 0, len(sequence.Steps))
+		for i, step := range sequence.Steps {
+			action := strings.TrimSpace(step.Action)
+			if len(step.Inputs) != 0 {
+				var err error
+				if action, err = pkg.bindSequenceStep(packageName, name, i+1, action, step.Inputs, manifestPath, manifestPackages); err != nil {
+					return pkg, err
+				}
+			}
+			steps = append(steps, action)
+		}
+		sequence.Actions = strings.Join(steps, ", ")
+		sequence.Steps = nil
+		pkg.Sequences[name] = sequence
+	}
+	return pkg, nil
+}
+
+// bindSequenceStep adds to the package the action or the package binding of a step with inputs and returns the step action
+func (pkg *Package) bindSequenceStep(packageName string, sequenceName string, index int, step string, inputs map[string]Parameter, manifestPath string, manifestPackages map[string]Package) (string, error) {
+	stepError := func(id string) error {
+		errMessage := wski18n.T(id,
+			map[string]interface{}{
+				wski18n.KEY_SEQUENCE: sequenceName,
+				wski18n.KEY_ACTION:   step})
+		return wskderrors.NewYAMLFileFormatError(manifestPath, errMessage)
+	}
+
+	parts := strings.Split(strings.TrimPrefix(step, PATH_SEPARATOR), PATH_SEPARATOR)
+	actionName := parts[len(parts)-1]
+
+	// an action of the manifest is copied with the inputs of the step
+	if !strings.HasPrefix(step, PATH_SEPARATOR) && len(parts) <= 2 {
+		stepPackage := packageName
+		if len(parts) == 2 {
+			stepPackage = parts[0]
+		}
+		if action, ok := manifestPackages[stepPackage].Actions[actionName]; ok {
+			name := fmt.Sprintf(SEQUENCE_STEP_ACTION_FORMAT, sequenceName, index, actionName)
+			if pkg.hasActionOrSequence(name) {
+				return "", stepError(wski18n.ID_ERR_SEQUENCE_STEP_NAME_COLLISION_X_sequence_X_action_X)
+			}
+			actions := make(map[string]Action, len(pkg.Actions)+1)
+			for n, a := range pkg.Actions {
+				actions[n] = a
+			}
+			actions[name] = mergeAction(action, Action{Inputs: inputs})
+			pkg.Actions = actions
+			return name, nil
+		}
+	}
+
+	// an action of a package is reached through a binding of that package with the inputs of the step
+	var dependency Dependency
+	if strings.HasPrefix(step, PATH_SEPARATOR) && len(parts) == 3 {
+		dependency.Location = PATH_SEPARATOR + parts[0] + PATH_SEPARATOR + parts[1]
+	} else if d, ok := findDependency(parts[0], manifestPackages); ok && len(parts) == 2 && dependencies.LocationIsBinding(d.Location) {
+		dependency = d
+	} else {
+		return "", stepError(wski18n.ID_ERR_SEQUENCE_STEP_INPUTS_X_sequence_X_action_X)
+	}
+
+	label := fmt.Sprintf(SEQUENCE_STEP_BINDING_FORMAT, entityName(packageName, sequenceName), index)
+	label = strings.Replace(label, PATH_SEPARATOR, "-", -1)
+	if _, ok := findDependency(label, manifestPackages); ok {
+		return "", stepError(wski18n.ID_ERR_SEQUENCE_STEP_NAME_COLLISION_X_sequence_X_action_X)
+	}
+	bound := make(map[string]Parameter, len(dependency.Inputs)+len(inputs))
+	for _, m := range []map[string]Parameter{dependency.Inputs, inputs} {
+		for k, v := range m {
+			bound[k] = v
+		}
+	}
+	dependency.Inputs = bound
+	deps := make(map[string]Dependency, len(pkg.Dependencies)+1)
+	for n, d := range pkg.Dependencies {
+		deps[n] = d
+	}
+	deps[label] = dependency
+	pkg.Dependencies = deps
+	return label + PATH_SEPARATOR + actionName, nil
+}
@@ -0,0 +1,162 @@
+// +build unit
+
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parsers
+
+import (
+	"testing"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveSequenceStep(t *testing.T) {
+	packages := map[string]Package{
+		"pipeline": {
+			Actions:      map[string]Action{"greet": {}},
+			Sequences:    map[string]Sequence{"all": {Actions: "greet"}},
+			Dependencies: map[string]Dependency{"utils": {Location: "/whisk.system/utils"}},
+		},
+		DEFAULT_PACKAGE: {Actions: map[string]Action{"hello": {}}},
+	}
+
+	for step, expected := range map[string]string{
+		"greet":                    "pipeline/greet",
+		"all":                      "pipeline/all",
+		"pipeline/greet":           "pipeline/greet",
+		"default/hello":            "hello",
+		"utils/echo":               "utils/echo",
+		"/whisk.system/utils/echo": "/whisk.system/utils/echo",
+	} {
+		resolved, ok := resolveSequenceStep(step, "pipeline", packages)
+		assert.True(t, ok, "Failed to resolve "+step)
+		assert.Equal(t, expected, resolved)
+	}
+
+	resolved, ok := resolveSequenceStep("hello", DEFAULT_PACKAGE, packages)
+	assert.True(t, ok)
+	assert.Equal(t, "hello", resolved)
+
+	for _, step := range []string{"missing", "pipeline/missing", "unknown/echo", "a/b/c"} {
+		_, ok := resolveSequenceStep(step, "pipeline", packages)
+		assert.False(t, ok, "Expected "+step+" to be unresolved")
+	}
+}
+
+func TestCheckSequenceCycles(t *testing.T) {
+	packages := map[string]Package{
+		"pipeline": {
+			Actions: map[string]Action{"greet": {}},
+			Sequences: map[string]Sequence{
+				"first":  {Actions: "greet, second"},
+				"second": {Actions: "greet, other/third"},
+			},
+		},
+		"other": {
+			Sequences: map[string]Sequence{"third": {Actions: "pipeline/greet"}},
+		},
+	}
+	assert.Nil(t, checkSequenceCycles("manifest.yaml", packages))
+
+	packages["other"] = Package{Sequences: map[string]Sequence{"third": {Actions: "pipeline/first"}}}
+	err := checkSequenceCycles("manifest.yaml", packages)
+	assert.NotNil(t, err, "Expected an error for a sequence including itself")
+	assert.Contains(t, err.Error(), "[other/third -> pipeline/first -> pipeline/second -> other/third]")
+
+	packages = map[string]Package{
+		"pipeline": {Sequences: map[string]Sequence{"loop": {Actions: "loop"}}},
+	}
+	err = checkSequenceCycles("manifest.yaml", packages)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "[pipeline/loop -> pipeline/loop]")
+}
+
+func TestComposeSequencesUnresolved(t *testing.T) {
+	packages := map[string]Package{
+		"pipeline": {Actions: map[string]Action{"greet": {}}},
+	}
+	sequences := map[string]Sequence{"broken": {Actions: "greet, missing"}}
+	_, err := NewYAMLParser().ComposeSequences("ns", sequences, "pipeline", "manifest.yaml", whisk.KeyValue{}, PackageInputs{}, packages, nil)
+	assert.NotNil(t, err, "Expected an error for an unresolved component")
+	assert.Contains(t, err.Error(), "[missing]")
+}
+
+func TestExpandSequenceSteps(t *testing.T) {
+	manifest := YAML{Packages: map[string]Package{
+		"pipeline": {
+			Actions: map[string]Action{
+				"greet": {Function: "greet.js", Inputs: map[string]Parameter{"name": {Value: "Amy"}, "place": {Value: "Paris"}}},
+			},
+			Dependencies: map[string]Dependency{"utils": {Location: "/whisk.system/utils", Inputs: map[string]Parameter{"sep": {Value: ","}}}},
+			Sequences: map[string]Sequence{
+				"steps": {Steps: []SequenceStep{
+					{Action: "greet"},
+					{Action: "greet", Inputs: map[string]Parameter{"name": {Value: "Bob"}}},
+					{Action: "utils/split", Inputs: map[string]Parameter{"sep": {Value: ";"}}},
+					{Action: "/whisk.system/utils/echo", Inputs: map[string]Parameter{"extra": {Value: true}}},
+				}},
+			},
+		},
+	}}
+	assert.Nil(t, expandSequenceSteps(&manifest, "manifest.yaml"))
+
+	pkg := manifest.Packages["pipeline"]
+	sequence := pkg.Sequences["steps"]
+	assert.Nil(t, sequence.Steps)
+	assert.Equal(t, "greet, steps-2-greet, pipeline-steps-3/split, pipeline-steps-4/echo", sequence.Actions)
+
+	// the action is copied with the inputs of the step merged into its own
+	wrapper := pkg.Actions["steps-2-greet"]
+	assert.Equal(t, "greet.js", wrapper.Function)
+	assert.Equal(t, "Bob", wrapper.Inputs["name"].Value)
+	assert.Equal(t, "Paris", wrapper.Inputs["place"].Value)
+	assert.Equal(t, "Amy", pkg.Actions["greet"].Inputs["name"].Value)
+
+	// the packages are bound with the inputs of the step
+	binding := pkg.Dependencies["pipeline-steps-3"]
+	assert.Equal(t, "/whisk.system/utils", binding.Location)
+	assert.Equal(t, ";", binding.Inputs["sep"].Value)
+	assert.Equal(t, ",", pkg.Dependencies["utils"].Inputs["sep"].Value)
+	binding = pkg.Dependencies["pipeline-steps-4"]
+	assert.Equal(t, "/whisk.system/utils", binding.Location)
+	assert.Equal(t, true, binding.Inputs["extra"].Value)
+}
+
+func TestExpandSequenceStepsErrors(t *testing.T) {
+	inputs := map[string]Parameter{"name": {Value: "Bob"}}
+	for expected, sequence := range map[string]Sequence{
+		"[both]":      {Actions: "greet", Steps: []SequenceStep{{Action: "greet"}}},
+		"[all]":       {Steps: []SequenceStep{{Action: "all", Inputs: inputs}}},
+		"[missing]":   {Steps: []SequenceStep{{Action: "missing", Inputs: inputs}}},
+		"[git/hello]": {Steps: []SequenceStep{{Action: "git/hello", Inputs: inputs}}},
+		"[/ns/hello]": {Steps: []SequenceStep{{Action: "/ns/hello", Inputs: inputs}}},
+		"[greet]":     {Steps: []SequenceStep{{Action: "greet", Inputs: inputs}}},
+	} {
+		manifest := YAML{Packages: map[string]Package{
+			"pipeline": {
+				Actions:      map[string]Action{"greet": {}, "both-1-greet": {}},
+				Dependencies: map[string]Dependency{"git": {Location: "github.com/apache/openwhisk-test/packages/helloworlds"}},
+				Sequences:    map[string]Sequence{"all": {Actions: "greet"}, "both": sequence},
+			},
+		}}
+		err := expandSequenceSteps(&manifest, "manifest.yaml")
+		assert.NotNil(t, err, "Expected an error for "+expected)
+		assert.Contains(t, err.Error(), expected)
+	}
+}
@@ -21,6 +21,9 @@ import (
 	"strings"
 
 	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/sciabarracom/openwhisk-wskdeploy/builder"
+	"github.com/sciabarracom/openwhisk-wskdeploy/conductor"
+	"github.com/sciabarracom/openwhisk-wskdeploy/runtimes"
 	"github.com/sciabarracom/openwhisk-wskdeploy/utils"
 	"github.com/sciabarracom/openwhisk-wskdeploy/wskenv"
 )
@@ -31,6 +34,7 @@ const (
 	YAML_KEY_ACTION     = "action"
 	YAML_KEY_ANNOTATION = "annotation"
 	YAML_KEY_API        = "api"
+	YAML_KEY_DEPENDENCY = "dependency"
 	YAML_KEY_FEED       = "feed"
 	YAML_KEY_MANIFEST   = "manifest"
 	YAML_KEY_NAMESPACE  = "namespace"
@@ -42,6 +46,7 @@ const (
 	YAML_KEY_TRIGGER    = "trigger"
 	YAML_KEY_SOURCE     = "source"
 	YAML_KEY_BLACKBOX   = "blackbox"
+	YAML_KEY_LIMITS     = "limits"
 )
 
 // YAML schema key values
@@ -61,6 +66,7 @@ const (
 	LIMIT_VALUE_TIMEOUT     = "timeout"
 	LIMIT_VALUE_MEMORY_SIZE = "memorySize"
 	LIMIT_VALUE_LOG_SIZE    = "logSize"
+	LIMIT_VALUE_CONCURRENCY = "concurrency"
 	// unsupported
 	LIMIT_VALUE_CONCURRENT_ACTIVATIONS = "concurrentActivations"
 	LIMIT_VALUE_USER_INVOCATION_RATE   = "userInvocationRate"
@@ -72,6 +78,7 @@ var LIMITS_SUPPORTED = [](string){
 	LIMIT_VALUE_TIMEOUT,
 	LIMIT_VALUE_MEMORY_SIZE,
 	LIMIT_VALUE_LOG_SIZE,
+	LIMIT_VALUE_CONCURRENCY,
 }
 
 var LIMITS_UNSUPPORTED = [](string){
@@ -87,8 +94,11 @@ func NewYAMLParser() *YAMLParser {
 }
 
 type YAMLParser struct {
-	manifests []*YAML
-	lastID    uint32
+	manifests   []*YAML
+	lastID      uint32
+	projectName string
+	// the manifest is read to undeploy its entities, its actions are not built
+	IsUndeploy bool
 }
 
 // Action is mapped to wsk.Action.*
@@ -117,12 +127,25 @@ type Action struct {
 	Annotations map[string]interface{} `yaml:"annotations,omitempty"`
 	Include     [][]string             `yaml:"include,omitempty"`
 	Exclude     []string               `yaml:"exclude,omitempty"`
+	Build       *builder.Build         `yaml:"build,omitempty"`
+}
+
+// Composition is compiled into a conductor action running its flow
+type Composition struct {
+	Flow        conductor.Flow         `yaml:"flow"`
+	Runtime     string                 `yaml:"runtime,omitempty"`
+	Web         string                 `yaml:"web"`
+	Limits      *Limits                `yaml:"limits"`
+	Inputs      map[string]Parameter   `yaml:"inputs"`
+	Description string                 `yaml:"description,omitempty"`
+	Annotations map[string]interface{} `yaml:"annotations,omitempty"`
 }
 
 type Limits struct {
 	Timeout               *int `yaml:"timeout,omitempty"`               //in ms, [100 ms,300000ms]
 	Memory                *int `yaml:"memorySize,omitempty"`            //in MB, [128 MB,512 MB]
 	Logsize               *int `yaml:"logSize,omitempty"`               //in MB, [0MB,10MB]
+	Concurrency           *int `yaml:"concurrency,omitempty"`           //activations per container, [1,500]
 	ConcurrentActivations *int `yaml:"concurrentActivations,omitempty"` //not changeable via APIs
 	UserInvocationRate    *int `yaml:"userInvocationRate,omitempty"`    //not changeable via APIs
 	CodeSize              *int `yaml:"codeSize,omitempty"`              //not changeable via APIs
@@ -131,6 +154,7 @@ type Limits struct {
 
 type Sequence struct {
 	Actions     string                 `yaml:"actions"`
+	Steps       []SequenceStep         `yaml:"steps,omitempty"`
 	Web         string                 `yaml:"web"`
 	Annotations map[string]interface{} `yaml:"annotations,omitempty"`
 }
@@ -143,19 +167,24 @@ type Dependency struct {
 }
 
 type Parameter struct {
-	Type        string      `yaml:"type,omitempty"`
-	Description string      `yaml:"description,omitempty"`
-	Value       interface{} `yaml:"value,omitempty"`
-	Required    bool        `yaml:"required,omitempty"`
-	Default     interface{} `yaml:"default,omitempty"`
-	Status      string      `yaml:"status,omitempty"`
-	Schema      interface{} `yaml:"schema,omitempty"`
+	Type        string        `yaml:"type,omitempty"`
+	Description string        `yaml:"description,omitempty"`
+	Value       interface{}   `yaml:"value,omitempty"`
+	Required    bool          `yaml:"required,omitempty"`
+	Default     interface{}   `yaml:"default,omitempty"`
+	Status      string        `yaml:"status,omitempty"`
+	Schema      interface{}   `yaml:"schema,omitempty"`
+	Enum        []interface{} `yaml:"enum,omitempty"`
+	Min         *float64      `yaml:"min,omitempty"`
+	Max         *float64      `yaml:"max,omitempty"`
+	Secret      Secret        `yaml:"secret,omitempty"`
 	multiline   bool
 }
 
 // Trigger is mapped wsk.Trigger.*
 type Trigger struct {
 	Feed        string               `yaml:"feed"`
+	Schedule    *Schedule            `yaml:"schedule,omitempty"`
 	Namespace   string               `yaml:"namespace"`
 	Credential  string               `yaml:"credential"`
 	Inputs      map[string]Parameter `yaml:"inputs"`
@@ -168,15 +197,18 @@ type Trigger struct {
 	Source string `yaml:"source"`
 }
 
+// Feed is deployed as a feed action, either created from Location or set on the package Action,
+// Operations are keyed by the lifecycle events the feed action is invoked with
 type Feed struct {
-	Namespace  string            `yaml:"namespace"`
-	Credential string            `yaml:"credential"`
-	Inputs     map[string]string `yaml:"inputs"`
-	Location   string            `yaml:"location"`
-	Action     string            `yaml:"action"`
-	// TODO(): need to define operation structure
-	Operations map[string]interface{} `yaml:"operations"`
-	Name       string
+	Namespace   string                   `yaml:"namespace"`
+	Credential  string                   `yaml:"credential"`
+	Inputs      map[string]Parameter     `yaml:"inputs"`
+	Location    string                   `yaml:"location"`
+	Action      string                   `yaml:"action"`
+	Runtime     string                   `yaml:"runtime,omitempty"`
+	Annotations map[string]interface{}   `yaml:"annotations,omitempty"`
+	Operations  map[string]FeedOperation `yaml:"operations"`
+	Name        string
 }
 
 type Rule struct {
@@ -214,11 +246,14 @@ type Package struct {
 	ApiHost          string                                                        `yaml:"apiHost"`
 	ApigwAccessToken string                                                        `yaml:"apigwAccessToken"`
 	Actions          map[string]Action                                             `yaml:"actions"`
+	ActionsFrom      ActionsFromList                                               `yaml:"actions-from,omitempty"`
 	Triggers         map[string]Trigger                                            `yaml:"triggers"`
 	Feeds            map[string]Feed                                               `yaml:"feeds"`
 	Rules            map[string]Rule                                               `yaml:"rules"`
 	Inputs           map[string]Parameter                                          `yaml:"inputs"`
+	Defaults         *Defaults                                                     `yaml:"defaults,omitempty"`
 	Sequences        map[string]Sequence                                           `yaml:"sequences"`
+	Compositions     map[string]Composition                                        `yaml:"compositions,omitempty"`
 	Description      string                                                        `yaml:"description,omitempty"`
 	Annotations      map[string]interface{}                                        `yaml:"annotations,omitempty"`
 	Apis             map[string]map[string]map[string]map[string]APIMethodResponse `yaml:"apis"`
@@ -234,6 +269,10 @@ type Project struct {
 	Packages         map[string]Package   `yaml:"packages"`
 	Inputs           map[string]Parameter `yaml: parameters`
 	Config           string               `yaml:"config"`
+	Defaults         *Defaults            `yaml:"defaults,omitempty"`
+
+	// file extensions mapped to runtimes, extending the catalog of runtimes
+	RuntimeMappings runtimes.RuntimeMappings `yaml:"runtimeMappings,omitempty"`
 }
 
 type YAML struct {
@@ -395,7 +434,7 @@ func (pkg *Package) GetApis() []*whisk.Api {
 func filterAnnotations(annotations whisk.KeyValueArr) map[string]interface{} {
 	res := make(map[string]interface{})
 	for _, a := range annotations {
-		if a.Key != utils.MANAGED {
+		if a.Key != utils.MANAGED && a.Key != utils.SECRET_INPUTS {
 			res[a.Key] = a.Value
 		}
 	}
@@ -403,18 +442,29 @@ func filterAnnotations(annotations whisk.KeyValueArr) map[string]interface{} {
 	return res
 }
 
+// composeParsersInputs returns the inputs of an exported entity, secret inputs are exported
+// as secret with a ${VAR} placeholder instead of their value
+func composeParsersInputs(parameters whisk.KeyValueArr, annotations whisk.KeyValueArr) map[string]Parameter {
+	secrets := utils.SecretInputs(annotations)
+	inputs := make(map[string]Parameter)
+	for _, keyval := range parameters {
+		param := Parameter{Value: keyval.Value}
+		if secrets[keyval.Key] {
+			param.Value = secretPlaceholder(keyval.Key)
+			param.Secret = Secret{Enabled: true}
+		}
+		inputs[keyval.Key] = param
+	}
+	return inputs
+}
+
 func (yaml *YAML) ComposeParsersPackage(wskpag whisk.Package) *Package {
 	pkg := new(Package)
 	pkg.Packagename = wskpag.Name
 	pkg.Namespace = wskpag.Namespace
 	pkg.Version = wskpag.Version
 
-	for _, keyval := range wskpag.Parameters {
-		param := new(Parameter)
-		param.Value = keyval.Value
-		pkg.Inputs[keyval.Key] = *param
-	}
-
+	pkg.Inputs = composeParsersInputs(wskpag.Parameters, wskpag.Annotations)
 	pkg.Annotations = filterAnnotations(wskpag.Annotations)
 	return pkg
 }
@@ -426,15 +476,26 @@ func (yaml *YAML) ComposeParsersAction(wskact whisk.Action) *Action {
 	action.Version = wskact.Version
 	action.Main = wskact.Exec.Main
 
-	action.Inputs = make(map[string]Parameter)
-	for _, keyval := range wskact.Parameters {
-		param := new(Parameter)
-		param.Value = keyval.Value
-		action.Inputs[keyval.Key] = *param
-	}
+	action.Inputs = composeParsersInputs(wskact.Parameters, wskact.Annotations)
 
 	action.Annotations = filterAnnotations(wskact.Annotations)
 
+	// the outputs annotation is exported back as the outputs of the action
+	if outputs, ok := action.Annotations[ANNOTATION_OUTPUTS]; ok {
+		action.Outputs = parseOutputsAnnotation(outputs)
+		delete(action.Annotations, ANNOTATION_OUTPUTS)
+	}
+
+	// limits are rendered with friendly units, see Limits.MarshalYAML
+	if wskact.Limits != nil {
+		action.Limits = &Limits{
+			Timeout:     wskact.Limits.Timeout,
+			Memory:      wskact.Limits.Memory,
+			Logsize:     wskact.Limits.Logsize,
+			Concurrency: wskact.Limits.Concurrency,
+		}
+	}
+
 	runtime := strings.Split(wskact.Exec.Kind, ":")[0]
 	if strings.ToLower(runtime) == YAML_KEY_BLACKBOX {
 		// storing blackbox image reference without saving the code as its impossible
@@ -450,12 +511,7 @@ func (yaml *YAML) ComposeParsersTrigger(wsktrg whisk.Trigger) *Trigger {
 	trigger := new(Trigger)
 	trigger.Name = wsktrg.Name
 	trigger.Namespace = wsktrg.Namespace
-	trigger.Inputs = make(map[string]Parameter)
-	for _, keyval := range wsktrg.Parameters {
-		param := new(Parameter)
-		param.Value = keyval.Value
-		trigger.Inputs[keyval.Key] = *param
-	}
+	trigger.Inputs = composeParsersInputs(wsktrg.Parameters, wsktrg.Annotations)
 
 	if feedname, isFeed := utils.IsFeedAction(&wsktrg); isFeed {
 		trigger.Source = feedname
@@ -468,12 +524,7 @@ func (yaml *YAML) ComposeParsersDependency(binding whisk.Binding, bPkg whisk.Pac
 	dependency := new(Dependency)
 	dependency.Location = "/" + binding.Namespace + "/" + binding.Name
 
-	dependency.Inputs = make(map[string]Parameter)
-	for _, keyval := range bPkg.Parameters {
-		param := new(Parameter)
-		param.Value = keyval.Value
-		dependency.Inputs[keyval.Key] = *param
-	}
+	dependency.Inputs = composeParsersInputs(bPkg.Parameters, bPkg.Annotations)
 	return dependency
 }
 
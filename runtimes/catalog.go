@@ -0,0 +1,267 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtimes
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"github.com/sciabarracom/openwhisk-wskdeploy/utils"
+	"github.com/sciabarracom/openwhisk-wskdeploy/wski18n"
+)
+
+const (
+	DEFAULT_CATALOG_TTL = 24 * time.Hour
+	CATALOG_CACHE_DIR   = "wskdeploy/runtimes"
+	CATALOG_CACHE_EXT   = ".json"
+	ENV_RUNTIMES_JSON   = "OPS_RUNTIMES_JSON"
+	API_INFO_PATH       = "/api/info"
+)
+
+// sources of the catalog of runtimes
+const (
+	CATALOG_SOURCE_APIHOST  = "apihost"
+	CATALOG_SOURCE_CACHE    = "cache"
+	CATALOG_SOURCE_ENV      = ENV_RUNTIMES_JSON
+	CATALOG_SOURCE_SNAPSHOT = "snapshot"
+)
+
+// TLSOptions are the client certificate, key and CA certificate used to fetch
+// the catalog of runtimes from the apihost
+type TLSOptions struct {
+	Cert     string
+	Key      string
+	CACert   string
+	Insecure bool // skip the verification of the certificate of the apihost
+}
+
+// ClientTLS is set from the configuration of the client before the catalog is fetched
+var ClientTLS TLSOptions
+
+// CatalogCacheDir overrides the directory of the cache, by default in the user config directory
+var CatalogCacheDir string
+
+// Catalog is the /api/info of an apihost, as cached on disk
+type Catalog struct {
+	ApiHost string          `json:"apihost"`
+	Fetched time.Time       `json:"fetched"`
+	Info    json.RawMessage `json:"info"`
+	Source  string          `json:"-"`
+	Path    string          `json:"-"`
+}
+
+// Fresh is true when the catalog is younger than the TTL
+func (c *Catalog) Fresh(ttl time.Duration, now time.Time) bool {
+	return ttl > 0 && now.Sub(c.Fetched) < ttl
+}
+
+// OpenWhiskInfo decodes the runtimes and limits of the catalog
+func (c *Catalog) OpenWhiskInfo() (op OpenWhiskInfo, err error) {
+	if err = json.Unmarshal(c.Info, &op); err != nil {
+		return op, runtimeParserError(err)
+	}
+	if len(op.Runtimes) == 0 {
+		return op, runtimeParserError(errors.New(wski18n.T(wski18n.ID_ERR_RUNTIMES_EMPTY_X_source_X,
+			map[string]interface{}{wski18n.KEY_SOURCE: c.Source})))
+	}
+	return op, nil
+}
+
+var cacheNameRegex = regexp.MustCompile(`[^A-Za-z0-9.-]+`)
+
+// CatalogCachePath returns the file where the catalog of an apihost is cached
+func CatalogCachePath(apiHost string) (string, error) {
+	dir := CatalogCacheDir
+	if len(dir) == 0 {
+		configDir, err := os.UserConfigDir()
+		if err != nil {
+			return "", err
+		}
+		dir = filepath.Join(configDir, filepath.FromSlash(CATALOG_CACHE_DIR))
+	}
+	name := apiHost
+	if u, err := url.Parse(apiHostURL(apiHost)); err == nil && len(u.Host) != 0 {
+		name = u.Host + u.Path
+	}
+	return filepath.Join(dir, cacheNameRegex.ReplaceAllString(name, "_")+CATALOG_CACHE_EXT), nil
+}
+
+// ReadCatalogCache returns the cached catalog of an apihost, if any
+func ReadCatalogCache(apiHost string) (*Catalog, error) {
+	path, err := CatalogCachePath(apiHost)
+	if err != nil {
+		return nil, err
+	}
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var catalog Catalog
+	if err := json.Unmarshal(content, &catalog); err != nil {
+		return nil, err
+	}
+	catalog.Source = CATALOG_SOURCE_CACHE
+	catalog.Path = path
+	return &catalog, nil
+}
+
+// WriteCatalogCache caches the catalog of an apihost
+func WriteCatalogCache(catalog *Catalog) error {
+	path, err := CatalogCachePath(catalog.ApiHost)
+	if err != nil {
+		return err
+	}
+	content, err := json.MarshalIndent(catalog, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	// write a temporary file of this command and rename it, concurrent commands
+	// neither read a partial file nor write to the same temporary file
+	tmp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	_, err = tmp.Write(content)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tmp.Name(), 0644)
+	}
+	if err != nil {
+		return err
+	}
+	catalog.Path = path
+	return os.Rename(tmp.Name(), path)
+}
+
+// FetchCatalog downloads the catalog from the apihost and caches it
+func FetchCatalog(apiHost string) (*Catalog, error) {
+	tlsConfig, err := ClientTLS.Config()
+	if err != nil {
+		return nil, err
+	}
+	opURL := apiHostURL(apiHost)
+	info, err := GetRuntimesByUrl(opURL+API_INFO_PATH, tlsConfig)
+	if err != nil {
+		info, err = GetRuntimesByUrl(opURL, tlsConfig)
+	}
+	if err != nil {
+		return nil, err
+	}
+	catalog := &Catalog{ApiHost: apiHost, Fetched: time.Now().UTC(), Info: info, Source: CATALOG_SOURCE_APIHOST}
+	if _, err := catalog.OpenWhiskInfo(); err != nil {
+		return nil, err
+	}
+	if err := WriteCatalogCache(catalog); err != nil {
+		warnCatalog(wski18n.T(wski18n.ID_WARN_RUNTIMES_CACHE_WRITE_X_path_X_err_X,
+			map[string]interface{}{wski18n.KEY_PATH: catalog.Path, wski18n.KEY_ERR: err.Error()}))
+	}
+	return catalog, nil
+}
+
+/*
+   LoadCatalog returns the catalog of runtimes of the apihost, looking in order for:
+
+   - the cached catalog, when it is younger than the TTL
+   - the catalog at the apihost, which is cached (skipped with --offline)
+   - the cached catalog, whatever its age
+   - the catalog in the OPS_RUNTIMES_JSON env. variable
+   - the snapshot bundled with wskdeploy
+
+   With --strict, a catalog which cannot be fetched from the apihost nor found in the cache is an error.
+*/
+func LoadCatalog(apiHost string) (*Catalog, error) {
+	cached, _ := ReadCatalogCache(apiHost)
+	if cached != nil && cached.Fresh(utils.Flags.RuntimesTTL, time.Now()) {
+		return cached, nil
+	}
+
+	var fetchErr error
+	if !utils.Flags.Offline {
+		catalog, err := FetchCatalog(apiHost)
+		if err == nil {
+			return catalog, nil
+		}
+		fetchErr = err
+		warnCatalog(wski18n.T(wski18n.ID_ERR_RUNTIMES_GET_X_err_X,
+			map[string]interface{}{wski18n.KEY_ERR: err.Error()}))
+	}
+	if cached != nil {
+		return cached, nil
+	}
+	if utils.Flags.Strict && fetchErr != nil {
+		return nil, runtimeParserError(fetchErr)
+	}
+	if env := os.Getenv(ENV_RUNTIMES_JSON); len(env) != 0 {
+		return &Catalog{ApiHost: apiHost, Info: json.RawMessage(env), Source: CATALOG_SOURCE_ENV}, nil
+	}
+	return &Catalog{ApiHost: apiHost, Info: json.RawMessage(RUNTIME_DETAILS), Source: CATALOG_SOURCE_SNAPSHOT}, nil
+}
+
+// Config returns the TLS configuration verifying the apihost with the system
+// or the configured CA certificates, and presenting the client certificate if any
+func (o TLSOptions) Config() (*tls.Config, error) {
+	config := &tls.Config{InsecureSkipVerify: o.Insecure}
+	if len(o.Cert) != 0 && len(o.Key) != 0 {
+		cert, err := tls.LoadX509KeyPair(o.Cert, o.Key)
+		if err != nil {
+			return nil, tlsError(o.Cert, err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	if len(o.CACert) != 0 {
+		pem, err := ioutil.ReadFile(o.CACert)
+		if err != nil {
+			return nil, tlsError(o.CACert, err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, tlsError(o.CACert, errors.New(wski18n.T(wski18n.ID_ERR_RUNTIMES_CACERT_INVALID)))
+		}
+		config.RootCAs = pool
+	}
+	return config, nil
+}
+
+func apiHostURL(apiHost string) string {
+	if _, err := url.ParseRequestURI(apiHost); err != nil {
+		return HTTPS + apiHost
+	}
+	return apiHost
+}
+
+func tlsError(path string, err error) error {
+	return errors.New(wski18n.T(wski18n.ID_ERR_RUNTIMES_TLS_X_path_X_err_X,
+		map[string]interface{}{wski18n.KEY_PATH: path, wski18n.KEY_ERR: err.Error()}))
+}
@@ -0,0 +1,203 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtimes
+
+import (
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sciabarracom/openwhisk-wskdeploy/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+const TEST_CATALOG_INFO = `{"runtimes":{"nodejs":[{"kind":"nodejs:14","default":true}]}}`
+
+// setupCatalog caches the catalogs in a temporary directory and restores the flags on cleanup
+func setupCatalog(t *testing.T) {
+	flags := utils.Flags
+	tls := ClientTLS
+	CatalogCacheDir = t.TempDir()
+	utils.Flags.RuntimesTTL = DEFAULT_CATALOG_TTL
+	t.Cleanup(func() {
+		utils.Flags = flags
+		ClientTLS = tls
+		CatalogCacheDir = ""
+	})
+}
+
+// newCatalogServer serves the catalog over TLS and trusts its certificate as the CA
+func newCatalogServer(t *testing.T, info string) (*httptest.Server, *int) {
+	requests := new(int)
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		w.Write([]byte(info))
+	}))
+	t.Cleanup(server.Close)
+	caCert := filepath.Join(t.TempDir(), "ca.pem")
+	content := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := ioutil.WriteFile(caCert, content, 0644); err != nil {
+		t.Fatal(err)
+	}
+	ClientTLS = TLSOptions{CACert: caCert}
+	return server, requests
+}
+
+func TestCatalogCachePath(t *testing.T) {
+	setupCatalog(t)
+	path, err := CatalogCachePath("https://openwhisk.example.com:443")
+	assert.Nil(t, err)
+	assert.Equal(t, filepath.Join(CatalogCacheDir, "openwhisk.example.com_443.json"), path)
+	hostOnly, err := CatalogCachePath("openwhisk.example.com:443")
+	assert.Nil(t, err)
+	assert.Equal(t, path, hostOnly, "the scheme of the apihost must not change the cache")
+}
+
+func TestCatalogFresh(t *testing.T) {
+	now := time.Now()
+	catalog := Catalog{Fetched: now.Add(-time.Hour)}
+	assert.True(t, catalog.Fresh(2*time.Hour, now))
+	assert.False(t, catalog.Fresh(30*time.Minute, now))
+	assert.False(t, catalog.Fresh(0, now), "a TTL of 0 must always refresh the catalog")
+}
+
+func TestLoadCatalog_FetchAndCache(t *testing.T) {
+	setupCatalog(t)
+	server, requests := newCatalogServer(t, TEST_CATALOG_INFO)
+
+	catalog, err := LoadCatalog(server.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, CATALOG_SOURCE_APIHOST, catalog.Source)
+	assert.FileExists(t, catalog.Path)
+
+	// the fresh cache is used without accessing the apihost
+	catalog, err = LoadCatalog(server.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, CATALOG_SOURCE_CACHE, catalog.Source)
+	assert.Equal(t, 1, *requests)
+	op, err := catalog.OpenWhiskInfo()
+	assert.Nil(t, err)
+	assert.Equal(t, "nodejs:14", op.Runtimes["nodejs"][0].Kind)
+
+	// an expired cache is refreshed
+	utils.Flags.RuntimesTTL = 0
+	catalog, err = LoadCatalog(server.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, CATALOG_SOURCE_APIHOST, catalog.Source)
+	assert.Equal(t, 2, *requests)
+}
+
+func TestLoadCatalog_Offline(t *testing.T) {
+	setupCatalog(t)
+	server, requests := newCatalogServer(t, TEST_CATALOG_INFO)
+	utils.Flags.Offline = true
+
+	// without a cache, the env. variable or the snapshot are used
+	catalog, err := LoadCatalog(server.URL)
+	assert.Nil(t, err)
+	if len(os.Getenv(ENV_RUNTIMES_JSON)) != 0 {
+		assert.Equal(t, CATALOG_SOURCE_ENV, catalog.Source)
+	} else {
+		assert.Equal(t, CATALOG_SOURCE_SNAPSHOT, catalog.Source)
+	}
+
+	// an expired cache is used rather than the apihost
+	stale := &Catalog{ApiHost: server.URL, Fetched: time.Now().Add(-48 * time.Hour), Info: json.RawMessage(TEST_CATALOG_INFO)}
+	assert.Nil(t, WriteCatalogCache(stale))
+	catalog, err = LoadCatalog(server.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, CATALOG_SOURCE_CACHE, catalog.Source)
+	assert.Equal(t, 0, *requests)
+}
+
+func TestWriteCatalogCache_Concurrent(t *testing.T) {
+	setupCatalog(t)
+	done := make(chan error)
+	for i := 0; i < 10; i++ {
+		go func() {
+			done <- WriteCatalogCache(&Catalog{ApiHost: "https://example.com", Fetched: time.Now(), Info: json.RawMessage(TEST_CATALOG_INFO)})
+		}()
+	}
+	for i := 0; i < 10; i++ {
+		assert.Nil(t, <-done)
+	}
+
+	path, err := CatalogCachePath("https://example.com")
+	assert.Nil(t, err)
+	catalog, err := ReadCatalogCache("https://example.com")
+	assert.Nil(t, err)
+	assert.Equal(t, path, catalog.Path)
+	files, err := ioutil.ReadDir(filepath.Dir(path))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(files), "temporary files are removed")
+}
+
+func TestLoadCatalog_UntrustedApiHost(t *testing.T) {
+	setupCatalog(t)
+	server, requests := newCatalogServer(t, TEST_CATALOG_INFO)
+	ClientTLS = TLSOptions{}
+
+	// the certificate of the apihost is verified
+	catalog, err := LoadCatalog(server.URL)
+	assert.Nil(t, err)
+	assert.NotEqual(t, CATALOG_SOURCE_APIHOST, catalog.Source)
+	assert.Equal(t, 0, *requests)
+
+	utils.Flags.Strict = true
+	_, err = LoadCatalog(server.URL)
+	assert.NotNil(t, err, "with --strict an untrusted apihost must be an error")
+
+	ClientTLS = TLSOptions{Insecure: true}
+	catalog, err = LoadCatalog(server.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, CATALOG_SOURCE_APIHOST, catalog.Source)
+}
+
+func TestLoadCatalog_EmptyRuntimes(t *testing.T) {
+	setupCatalog(t)
+	server, _ := newCatalogServer(t, `{"runtimes":{}}`)
+	utils.Flags.Strict = true
+	_, err := LoadCatalog(server.URL)
+	assert.NotNil(t, err, "a catalog without runtimes must not be cached")
+	_, err = ReadCatalogCache(server.URL)
+	assert.NotNil(t, err)
+}
+
+func TestTLSOptionsConfig(t *testing.T) {
+	dir := t.TempDir()
+	_, err := TLSOptions{CACert: filepath.Join(dir, "missing.pem")}.Config()
+	assert.NotNil(t, err)
+
+	invalid := filepath.Join(dir, "invalid.pem")
+	assert.Nil(t, ioutil.WriteFile(invalid, []byte("not a certificate"), 0644))
+	_, err = TLSOptions{CACert: invalid}.Config()
+	assert.NotNil(t, err)
+
+	_, err = TLSOptions{Cert: invalid, Key: invalid}.Config()
+	assert.NotNil(t, err)
+
+	config, err := TLSOptions{}.Config()
+	assert.Nil(t, err)
+	assert.False(t, config.InsecureSkipVerify, "the apihost must be verified by default")
+}
@@ -0,0 +1,96 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtimes
+
+import (
+	"sort"
+	"strings"
+)
+
+// status of a kind used by an action, as reported by `wskdeploy runtimes --check`
+const (
+	KIND_STATUS_AVAILABLE   = "available"
+	KIND_STATUS_DEPRECATED  = "deprecated"
+	KIND_STATUS_UNAVAILABLE = "unavailable"
+	KIND_DEFAULT_SUFFIX     = ":default"
+)
+
+// RuntimeKind is a kind of the catalog of runtimes
+type RuntimeKind struct {
+	Language   string `json:"language"`
+	Kind       string `json:"kind"`
+	Default    bool   `json:"default"`
+	Deprecated bool   `json:"deprecated"`
+}
+
+// ListOfKinds returns all the kinds of the catalog, deprecated ones included, sorted by language and kind
+func ListOfKinds(op OpenWhiskInfo) []RuntimeKind {
+	kinds := make([]RuntimeKind, 0)
+	for language, runtimes := range op.Runtimes {
+		for _, runtime := range runtimes {
+			kinds = append(kinds, RuntimeKind{
+				Language:   language,
+				Kind:       runtime.Kind,
+				Default:    runtime.Default,
+				Deprecated: runtime.Deprecated,
+			})
+		}
+	}
+	sort.Slice(kinds, func(i, j int) bool {
+		if kinds[i].Language != kinds[j].Language {
+			return kinds[i].Language < kinds[j].Language
+		}
+		return kinds[i].Kind < kinds[j].Kind
+	})
+	return kinds
+}
+
+// KindStatus tells whether a runtime of an action, i.e. a kind, a language or
+// "<language>:default", is available, deprecated or unavailable in the catalog
+func KindStatus(op OpenWhiskInfo, runtime string) string {
+	language := strings.TrimSuffix(runtime, KIND_DEFAULT_SUFFIX)
+	if _, ok := DefaultRuntimes(op)[language]; ok {
+		return KIND_STATUS_AVAILABLE
+	}
+	for _, kind := range ListOfKinds(op) {
+		if kind.Kind == runtime {
+			if kind.Deprecated {
+				return KIND_STATUS_DEPRECATED
+			}
+			return KIND_STATUS_AVAILABLE
+		}
+	}
+	return KIND_STATUS_UNAVAILABLE
+}
+
+// SuggestKind returns the kind replacing a deprecated or unavailable one, that is
+// the default kind of the same language, or an empty string if the language is unknown
+func SuggestKind(op OpenWhiskInfo, runtime string) string {
+	defaults := DefaultRuntimes(op)
+	language := strings.Split(runtime, ":")[0]
+	if kind, ok := defaults[language]; ok {
+		return kind
+	}
+	// languages whose kinds are not prefixed by the language name
+	for _, kind := range ListOfKinds(op) {
+		if strings.Split(kind.Kind, ":")[0] == language {
+			return defaults[kind.Language]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,72 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtimes
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const TEST_KINDS_INFO = `{"runtimes": {
+	"nodejs": [
+		{"kind": "nodejs:14", "default": true},
+		{"kind": "nodejs:6", "deprecated": true}
+	],
+	"dotnet": [
+		{"kind": "dotnet:2.2", "default": true}
+	],
+	"java": [
+		{"kind": "java:8", "default": true}
+	]
+}}`
+
+func testKindsInfo(t *testing.T) OpenWhiskInfo {
+	var op OpenWhiskInfo
+	assert.Nil(t, json.Unmarshal([]byte(TEST_KINDS_INFO), &op))
+	return op
+}
+
+func TestListOfKinds(t *testing.T) {
+	kinds := ListOfKinds(testKindsInfo(t))
+	assert.Equal(t, []RuntimeKind{
+		{Language: "dotnet", Kind: "dotnet:2.2", Default: true},
+		{Language: "java", Kind: "java:8", Default: true},
+		{Language: "nodejs", Kind: "nodejs:14", Default: true},
+		{Language: "nodejs", Kind: "nodejs:6", Deprecated: true},
+	}, kinds)
+}
+
+func TestKindStatus(t *testing.T) {
+	op := testKindsInfo(t)
+	assert.Equal(t, KIND_STATUS_AVAILABLE, KindStatus(op, "nodejs:14"))
+	assert.Equal(t, KIND_STATUS_AVAILABLE, KindStatus(op, "nodejs:default"))
+	assert.Equal(t, KIND_STATUS_AVAILABLE, KindStatus(op, "nodejs"))
+	assert.Equal(t, KIND_STATUS_DEPRECATED, KindStatus(op, "nodejs:6"))
+	assert.Equal(t, KIND_STATUS_UNAVAILABLE, KindStatus(op, "nodejs:4"))
+	assert.Equal(t, KIND_STATUS_UNAVAILABLE, KindStatus(op, "python:3"))
+}
+
+func TestSuggestKind(t *testing.T) {
+	op := testKindsInfo(t)
+	assert.Equal(t, "nodejs:14", SuggestKind(op, "nodejs:6"))
+	assert.Equal(t, "nodejs:14", SuggestKind(op, "nodejs:4"))
+	assert.Equal(t, "dotnet:2.2", SuggestKind(op, "dotnet:2.1"))
+	assert.Equal(t, "", SuggestKind(op, "python:3"))
+}
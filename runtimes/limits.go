@@ -0,0 +1,125 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtimes
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/sciabarracom/openwhisk-wskdeploy/utils"
+)
+
+// units used by /api/info for durations, e.g. "5 minutes", converted to milliseconds
+var limitDurationUnits = map[string]float64{
+	"nanosecond":  1.0 / 1000000,
+	"microsecond": 1.0 / 1000,
+	"millisecond": 1,
+	"ms":          1,
+	"second":      1000,
+	"s":           1000,
+	"minute":      60 * 1000,
+	"min":         60 * 1000,
+	"hour":        60 * 60 * 1000,
+	"h":           60 * 60 * 1000,
+	"day":         24 * 60 * 60 * 1000,
+	"d":           24 * 60 * 60 * 1000,
+}
+
+// units used by /api/info for sizes, e.g. "512 MB", converted to megabytes
+var limitSizeUnits = map[string]float64{
+	"b":  1.0 / (1024 * 1024),
+	"kb": 1.0 / 1024,
+	"mb": 1,
+	"gb": 1024,
+}
+
+// units used by /api/info for sizes, converted to bytes
+var limitByteUnits = map[string]float64{
+	"b":  1,
+	"kb": 1024,
+	"mb": 1024 * 1024,
+	"gb": 1024 * 1024 * 1024,
+}
+
+// parseLimit converts a limit such as "100 milliseconds" or "10 MB" to the unit of the action limit,
+// rounding up a minimum and down a maximum so that the range is never larger than the provider's
+func parseLimit(value string, units map[string]float64, roundUp bool) (int, bool) {
+	fields := strings.Fields(strings.ToLower(value))
+	if len(fields) != 2 {
+		return 0, false
+	}
+	number, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return 0, false
+	}
+	factor, ok := units[fields[1]]
+	if !ok {
+		factor, ok = units[strings.TrimSuffix(fields[1], "s")]
+	}
+	if !ok {
+		return 0, false
+	}
+	if roundUp {
+		return int(math.Ceil(number * factor)), true
+	}
+	return int(math.Floor(number * factor)), true
+}
+
+// limitRange overrides the bounds of a default range with the ones reported by the provider
+func limitRange(defaults utils.LimitRange, min string, max string, units map[string]float64) utils.LimitRange {
+	if value, ok := parseLimit(min, units, true); ok {
+		defaults.Min = value
+	} else if len(min) != 0 {
+		whisk.Debug(whisk.DbgWarn, "Ignoring invalid action limit ["+min+"]\n")
+	}
+	if value, ok := parseLimit(max, units, false); ok {
+		defaults.Max = value
+	} else if len(max) != 0 {
+		whisk.Debug(whisk.DbgWarn, "Ignoring invalid action limit ["+max+"]\n")
+	}
+	return defaults
+}
+
+// ActionLimitRanges returns the action limit ranges reported by the provider at /api/info,
+// keeping the OpenWhisk defaults for limits the provider does not report
+func ActionLimitRanges(op OpenWhiskInfo) utils.ActionLimits {
+	limits := utils.DefaultActionLimitRanges
+	limits.Timeout = limitRange(limits.Timeout, op.Limits.MinDuration, op.Limits.MaxDuration, limitDurationUnits)
+	limits.Memory = limitRange(limits.Memory, op.Limits.MinMemory, op.Limits.MaxMemory, limitSizeUnits)
+	limits.Logsize = limitRange(limits.Logsize, op.Limits.MinLogs, op.Limits.MaxLogs, limitSizeUnits)
+	if op.Limits.MinConcurrency != 0 {
+		limits.Concurrency.Min = int(op.Limits.MinConcurrency)
+	}
+	if op.Limits.MaxConcurrency != 0 {
+		limits.Concurrency.Max = int(op.Limits.MaxConcurrency)
+	}
+	return limits
+}
+
+// CodeSizeLimit returns the maximum size in bytes of the code of an action reported by the provider at /api/info,
+// or the OpenWhisk default if the provider does not report it
+func CodeSizeLimit(op OpenWhiskInfo) int {
+	if value, ok := parseLimit(op.Limits.MaxCodeSize, limitByteUnits, false); ok {
+		return value
+	} else if len(op.Limits.MaxCodeSize) != 0 {
+		whisk.Debug(whisk.DbgWarn, "Ignoring invalid action limit ["+op.Limits.MaxCodeSize+"]\n")
+	}
+	return utils.DEFAULT_CODE_SIZE_LIMIT
+}
@@ -0,0 +1,70 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtimes
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/sciabarracom/openwhisk-wskdeploy/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActionLimitRanges(t *testing.T) {
+	var op OpenWhiskInfo
+	info := `{"limits": {
+		"actions_per_minute": 60,
+		"concurrent_actions": 30,
+		"max_action_concurrency": 500,
+		"max_action_duration": "5 minutes",
+		"max_action_logs": "10 MB",
+		"max_action_memory": "512 MB",
+		"min_action_concurrency": 1,
+		"min_action_duration": "100 milliseconds",
+		"min_action_logs": "0 B",
+		"min_action_memory": "128 MB",
+		"sequence_length": 50,
+		"triggers_per_minute": 60}}`
+	assert.Nil(t, json.Unmarshal([]byte(info), &op))
+
+	limits := ActionLimitRanges(op)
+	assert.Equal(t, utils.LimitRange{Min: 100, Max: 300000}, limits.Timeout)
+	assert.Equal(t, utils.LimitRange{Min: 128, Max: 512}, limits.Memory)
+	assert.Equal(t, utils.LimitRange{Min: 0, Max: 10}, limits.Logsize)
+	assert.Equal(t, utils.LimitRange{Min: 1, Max: 500}, limits.Concurrency)
+	assert.Equal(t, uint(50), op.Limits.SequenceLength)
+
+	// limits not reported by the provider keep their defaults
+	assert.Equal(t, utils.DefaultActionLimitRanges, ActionLimitRanges(OpenWhiskInfo{}))
+
+	// sizes are rounded so that the range is never larger than the provider's
+	op.Limits.MinMemory = "1536 KB"
+	op.Limits.MaxMemory = "1.5 GB"
+	assert.Equal(t, utils.LimitRange{Min: 2, Max: 1536}, ActionLimitRanges(op).Memory)
+}
+
+func TestCodeSizeLimit(t *testing.T) {
+	assert.Equal(t, utils.DEFAULT_CODE_SIZE_LIMIT, CodeSizeLimit(OpenWhiskInfo{}))
+
+	var op OpenWhiskInfo
+	assert.Nil(t, json.Unmarshal([]byte(`{"limits": {"max_action_code_size": "10 MB"}}`), &op))
+	assert.Equal(t, 10*1024*1024, CodeSizeLimit(op))
+
+	op.Limits.MaxCodeSize = "ten megabytes"
+	assert.Equal(t, utils.DEFAULT_CODE_SIZE_LIMIT, CodeSizeLimit(op))
+}
@@ -0,0 +1,182 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtimes
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/sciabarracom/openwhisk-wskdeploy/wskderrors"
+	"github.com/sciabarracom/openwhisk-wskdeploy/wski18n"
+	"gopkg.in/yaml.v2"
+)
+
+// RUNTIME_MAPPINGS_FILE is the user config file extending the file extensions mapped to each runtime
+const RUNTIME_MAPPINGS_FILE = "wskdeploy/runtimes.yaml"
+
+// RuntimeMappingsFile overrides the user config file with the runtime mappings
+var RuntimeMappingsFile string
+
+// FileExtensionDefaultKindMap is the kind of the actions whose file extension is mapped to a kind,
+// rather than to the default kind of its runtime
+var FileExtensionDefaultKindMap = make(map[string]string)
+
+// RuntimeMapping lists the file extensions of a runtime, e.g.
+//
+//	nodejs:
+//	  extensions: [ts, mjs]
+//	  kind: nodejs:18
+//
+// the optional kind replaces the default kind of the runtime for these extensions
+type RuntimeMapping struct {
+	Extensions []string `yaml:"extensions" json:"extensions"`
+	Kind       string   `yaml:"kind,omitempty" json:"kind,omitempty"`
+}
+
+// RuntimeMappings are keyed by runtime, i.e. a language of the catalog of runtimes
+type RuntimeMappings map[string]RuntimeMapping
+
+type runtimeMappingsConfig struct {
+	RuntimeMappings RuntimeMappings `yaml:"runtimeMappings"`
+}
+
+// Validate checks that every runtime lists extensions and that no extension is mapped to two runtimes
+func (mappings RuntimeMappings) Validate() error {
+	runtimes := make(map[string]string)
+	for _, runtime := range mappings.sortedRuntimes() {
+		mapping := mappings[runtime]
+		noExtensions := errors.New(wski18n.T(wski18n.ID_ERR_RUNTIME_MAPPING_NO_EXTENSIONS_X_runtime_X,
+			map[string]interface{}{wski18n.KEY_RUNTIME: runtime}))
+		if len(mapping.Extensions) == 0 {
+			return noExtensions
+		}
+		for _, ext := range mapping.Extensions {
+			ext = normalizeExtension(ext)
+			if len(ext) == 0 {
+				return noExtensions
+			}
+			if other, ok := runtimes[ext]; ok {
+				return errors.New(wski18n.T(wski18n.ID_ERR_RUNTIME_MAPPING_EXTENSION_X_ext_X_runtime_X_other_X,
+					map[string]interface{}{
+						wski18n.KEY_EXTENSION: ext,
+						wski18n.KEY_RUNTIME:   runtime,
+						wski18n.KEY_OTHER:     other}))
+			}
+			runtimes[ext] = runtime
+		}
+	}
+	return nil
+}
+
+// ApplyRuntimeMappings maps the file extensions to their runtime and kind,
+// replacing the mappings of the catalog of runtimes for the same extensions
+func ApplyRuntimeMappings(mappings RuntimeMappings) {
+	if FileExtensionRuntimeKindMap == nil {
+		FileExtensionRuntimeKindMap = make(map[string]string)
+	}
+	if FileRuntimeExtensionsMap == nil {
+		FileRuntimeExtensionsMap = make(map[string]string)
+	}
+	for _, runtime := range mappings.sortedRuntimes() {
+		mapping := mappings[runtime]
+		for _, ext := range mapping.Extensions {
+			ext = normalizeExtension(ext)
+			FileExtensionRuntimeKindMap[ext] = runtime
+			if len(mapping.Kind) != 0 {
+				FileExtensionDefaultKindMap[ext] = mapping.Kind
+			} else {
+				delete(FileExtensionDefaultKindMap, ext)
+			}
+		}
+		// kinds of new runtimes are exported with the first of their extensions
+		kinds := append([]string{mapping.Kind}, SupportedRunTimes[runtime]...)
+		for _, kind := range kinds {
+			if _, ok := FileRuntimeExtensionsMap[kind]; !ok && len(kind) != 0 {
+				FileRuntimeExtensionsMap[kind] = normalizeExtension(mapping.Extensions[0])
+			}
+		}
+	}
+}
+
+// ExtensionKind returns the kind of the actions whose function has the file extension
+func ExtensionKind(ext string) string {
+	if kind, ok := FileExtensionDefaultKindMap[ext]; ok {
+		return kind
+	}
+	return DefaultRunTimes[FileExtensionRuntimeKindMap[ext]]
+}
+
+// FileExtensionKinds returns the kind of the actions for each file extension in effect
+func FileExtensionKinds() map[string]string {
+	kinds := make(map[string]string, len(FileExtensionRuntimeKindMap))
+	for ext := range FileExtensionRuntimeKindMap {
+		kinds[ext] = ExtensionKind(ext)
+	}
+	return kinds
+}
+
+// RuntimeMappingsPath returns the user config file with the runtime mappings
+func RuntimeMappingsPath() (string, error) {
+	if len(RuntimeMappingsFile) != 0 {
+		return RuntimeMappingsFile, nil
+	}
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(configDir, filepath.FromSlash(RUNTIME_MAPPINGS_FILE)), nil
+}
+
+// ReadRuntimeMappings returns the runtime mappings of the user config file, a missing file is not an error
+func ReadRuntimeMappings() (RuntimeMappings, error) {
+	path, err := RuntimeMappingsPath()
+	if err != nil {
+		return nil, nil
+	}
+	content, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, wskderrors.NewFileReadError(path, err.Error())
+	}
+	var config runtimeMappingsConfig
+	if err := yaml.UnmarshalStrict(content, &config); err != nil {
+		return nil, wskderrors.NewYAMLParserErr(path, err)
+	}
+	if err := config.RuntimeMappings.Validate(); err != nil {
+		return nil, wskderrors.NewYAMLFileFormatError(path, err.Error())
+	}
+	return config.RuntimeMappings, nil
+}
+
+func (mappings RuntimeMappings) sortedRuntimes() []string {
+	runtimes := make([]string, 0, len(mappings))
+	for runtime := range mappings {
+		runtimes = append(runtimes, runtime)
+	}
+	sort.Strings(runtimes)
+	return runtimes
+}
+
+func normalizeExtension(ext string) string {
+	return strings.TrimPrefix(strings.TrimSpace(ext), ".")
+}
@@ -0,0 +1,95 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtimes
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// setupMappings maps the extensions of the catalog of runtimes and restores the mappings on cleanup
+func setupMappings(t *testing.T) {
+	extensions, kinds, exports := FileExtensionRuntimeKindMap, FileExtensionDefaultKindMap, FileRuntimeExtensionsMap
+	supported, defaults, file := SupportedRunTimes, DefaultRunTimes, RuntimeMappingsFile
+	t.Cleanup(func() {
+		FileExtensionRuntimeKindMap, FileExtensionDefaultKindMap, FileRuntimeExtensionsMap = extensions, kinds, exports
+		SupportedRunTimes, DefaultRunTimes, RuntimeMappingsFile = supported, defaults, file
+	})
+	op := testKindsInfo(t)
+	SupportedRunTimes = ConvertToMap(op)
+	DefaultRunTimes = DefaultRuntimes(op)
+	FileExtensionRuntimeKindMap = FileExtensionRuntimes(op)
+	FileRuntimeExtensionsMap = FileRuntimeExtensions(op)
+	FileExtensionDefaultKindMap = make(map[string]string)
+}
+
+func TestApplyRuntimeMappings(t *testing.T) {
+	setupMappings(t)
+	DefaultRunTimes["kotlin"] = "kotlin:1.5"
+	ApplyRuntimeMappings(RuntimeMappings{
+		"nodejs": {Extensions: []string{"ts", ".mjs"}},
+		"java":   {Extensions: []string{"scala"}, Kind: "java:8"},
+		"kotlin": {Extensions: []string{"kt", "kts"}},
+	})
+
+	assert.Equal(t, "nodejs:14", ExtensionKind("ts"))
+	assert.Equal(t, "nodejs:14", ExtensionKind("mjs"))
+	assert.Equal(t, "nodejs:14", ExtensionKind(NODEJS_FILE_EXTENSION))
+	assert.Equal(t, "java:8", ExtensionKind("scala"))
+	assert.Equal(t, "kotlin:1.5", ExtensionKind("kts"))
+	assert.Equal(t, "", ExtensionKind("dart"))
+	assert.Equal(t, "java:8", FileExtensionKinds()["scala"])
+
+	assert.True(t, CheckRuntimeConsistencyWithFileExtension("ts", "nodejs:14"))
+	assert.False(t, CheckRuntimeConsistencyWithFileExtension("ts", "java:8"))
+
+	// an extension of the catalog can be mapped to another runtime
+	ApplyRuntimeMappings(RuntimeMappings{"java": {Extensions: []string{"js"}}})
+	assert.Equal(t, "java:8", ExtensionKind(NODEJS_FILE_EXTENSION))
+}
+
+func TestRuntimeMappingsValidate(t *testing.T) {
+	assert.Nil(t, RuntimeMappings{"nodejs": {Extensions: []string{"ts"}}, "java": {Extensions: []string{"kt"}}}.Validate())
+	assert.NotNil(t, RuntimeMappings{"nodejs": {}}.Validate())
+	assert.NotNil(t, RuntimeMappings{"nodejs": {Extensions: []string{"."}}}.Validate())
+	assert.NotNil(t, RuntimeMappings{"nodejs": {Extensions: []string{"ts"}}, "deno": {Extensions: []string{".ts"}}}.Validate())
+}
+
+func TestReadRuntimeMappings(t *testing.T) {
+	setupMappings(t)
+	dir := t.TempDir()
+
+	RuntimeMappingsFile = filepath.Join(dir, "missing.yaml")
+	mappings, err := ReadRuntimeMappings()
+	assert.Nil(t, err, "a missing user config file must not be an error")
+	assert.Nil(t, mappings)
+
+	RuntimeMappingsFile = filepath.Join(dir, "runtimes.yaml")
+	content := "runtimeMappings:\n  nodejs:\n    extensions: [ts, mts]\n    kind: nodejs:14\n"
+	assert.Nil(t, ioutil.WriteFile(RuntimeMappingsFile, []byte(content), 0644))
+	mappings, err = ReadRuntimeMappings()
+	assert.Nil(t, err)
+	assert.Equal(t, RuntimeMappings{"nodejs": {Extensions: []string{"ts", "mts"}, Kind: "nodejs:14"}}, mappings)
+
+	assert.Nil(t, ioutil.WriteFile(RuntimeMappingsFile, []byte("runtimeMappings:\n  nodejs:\n    extension: ts\n"), 0644))
+	_, err = ReadRuntimeMappings()
+	assert.NotNil(t, err, "unknown keys must be an error")
+}
@@ -18,19 +18,7 @@
 package runtimes
 
 import (
-	"crypto/tls"
-	"encoding/json"
-	"io/ioutil"
-	"net/http"
-	"net/url"
 	"strings"
-	"time"
-
-	"github.com/apache/openwhisk-client-go/whisk"
-	"github.com/sciabarracom/openwhisk-wskdeploy/utils"
-	"github.com/sciabarracom/openwhisk-wskdeploy/wskderrors"
-	"github.com/sciabarracom/openwhisk-wskdeploy/wski18n"
-	"github.com/sciabarracom/openwhisk-wskdeploy/wskprint"
 )
 
 const (
@@ -63,9 +51,19 @@ const (
 
 // Structs used to denote the OpenWhisk Runtime information
 type Limit struct {
-	Apm       uint `json:"actions_per_minute"`
-	Tpm       uint `json:"triggers_per_minute"`
-	ConAction uint `json:"concurrent_actions"`
+	Apm            uint   `json:"actions_per_minute"`
+	Tpm            uint   `json:"triggers_per_minute"`
+	ConAction      uint   `json:"concurrent_actions"`
+	SequenceLength uint   `json:"sequence_length"`
+	MinDuration    string `json:"min_action_duration"`
+	MaxDuration    string `json:"max_action_duration"`
+	MinMemory      string `json:"min_action_memory"`
+	MaxMemory      string `json:"max_action_memory"`
+	MinLogs        string `json:"min_action_logs"`
+	MaxLogs        string `json:"max_action_logs"`
+	MinConcurrency uint   `json:"min_action_concurrency"`
+	MaxConcurrency uint   `json:"max_action_concurrency"`
+	MaxCodeSize    string `json:"max_action_code_size,omitempty"`
 }
 
 type Runtime struct {
@@ -92,75 +90,6 @@ var SupportedRunTimes map[string][]string
 var DefaultRunTimes map[string]string
 var FileRuntimeExtensionsMap map[string]string
 
-// We could get the openwhisk info from bluemix through running the command
-// `curl -k https://openwhisk.ng.bluemix.net`
-// hard coding it here in case of network unavailable or failure.
-func _ParseOpenWhisk(apiHost string) (op OpenWhiskInfo, err error) {
-	opURL := apiHost
-	_, err = url.ParseRequestURI(opURL)
-	if err != nil {
-		opURL = HTTPS + opURL
-	}
-	req, _ := http.NewRequest("GET", opURL, nil)
-	req.Header.Set(HTTP_CONTENT_TYPE_KEY, HTTP_CONTENT_TYPE_VALUE)
-	tlsConfig := &tls.Config{
-		InsecureSkipVerify: true,
-	}
-
-	var netTransport = &http.Transport{
-		TLSClientConfig: tlsConfig,
-	}
-
-	var netClient = &http.Client{
-		Timeout:   time.Second * utils.DEFAULT_HTTP_TIMEOUT,
-		Transport: netTransport,
-	}
-
-	res, err := netClient.Do(req)
-	if err != nil {
-		// TODO() create an error
-		errString := wski18n.T(wski18n.ID_ERR_RUNTIMES_GET_X_err_X,
-			map[string]interface{}{"err": err.Error()})
-		whisk.Debug(whisk.DbgWarn, errString)
-		if utils.Flags.Strict {
-			errMessage := wski18n.T(wski18n.ID_ERR_RUNTIME_PARSER_ERROR,
-				map[string]interface{}{wski18n.KEY_ERR: err.Error()})
-			err = wskderrors.NewRuntimeParserError(errMessage)
-			return
-		}
-	}
-
-	if res != nil {
-		defer res.Body.Close()
-	}
-
-	// Local openwhisk deployment sometimes only returns "application/json" as the content type
-	if err != nil || !strings.Contains(HTTP_CONTENT_TYPE_VALUE, res.Header.Get(HTTP_CONTENT_TYPE_KEY)) {
-		stdout := wski18n.T(wski18n.ID_MSG_UNMARSHAL_LOCAL)
-		wskprint.PrintOpenWhiskVerbose(utils.Flags.Verbose, stdout)
-		err = json.Unmarshal(RUNTIME_DETAILS, &op)
-		if err != nil {
-			errMessage := wski18n.T(wski18n.ID_ERR_RUNTIME_PARSER_ERROR,
-				map[string]interface{}{wski18n.KEY_ERR: err.Error()})
-			err = wskderrors.NewRuntimeParserError(errMessage)
-		}
-	} else {
-		b, _ := ioutil.ReadAll(res.Body)
-		if b != nil && len(b) > 0 {
-			stdout := wski18n.T(wski18n.ID_MSG_UNMARSHAL_NETWORK_X_url_X,
-				map[string]interface{}{"url": opURL})
-			wskprint.PrintOpenWhiskVerbose(utils.Flags.Verbose, stdout)
-			err = json.Unmarshal(b, &op)
-			if err != nil {
-				errMessage := wski18n.T(wski18n.ID_ERR_RUNTIME_PARSER_ERROR,
-					map[string]interface{}{wski18n.KEY_ERR: err.Error()})
-				err = wskderrors.NewRuntimeParserError(errMessage)
-			}
-		}
-	}
-	return
-}
-
 func ConvertToMap(op OpenWhiskInfo) (rt map[string][]string) {
 	rt = make(map[string][]string)
 	for k, v := range op.Runtimes {
@@ -255,6 +184,9 @@ func FileRuntimeExtensions(op OpenWhiskInfo) (rte map[string]string) {
 }
 
 func CheckRuntimeConsistencyWithFileExtension(ext string, runtime string) bool {
+	if runtime == ExtensionKind(ext) {
+		return true
+	}
 	rt := FileExtensionRuntimeKindMap[ext]
 	for _, v := range SupportedRunTimes[rt] {
 		if runtime == v {
@@ -19,12 +19,9 @@ package runtimes
 
 import (
 	"crypto/tls"
-	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
-	"net/url"
-	"os"
 	"time"
 
 	"github.com/apache/openwhisk-client-go/whisk"
@@ -36,12 +33,10 @@ import (
 
 var Version = "openserverless"
 
-func GetRuntimesByUrl(opURL string, pop *OpenWhiskInfo) error {
+// GetRuntimesByUrl downloads the runtimes and limits described at the URL
+func GetRuntimesByUrl(opURL string, tlsConfig *tls.Config) ([]byte, error) {
 
 	// configure transport
-	tlsConfig := &tls.Config{
-		InsecureSkipVerify: true,
-	}
 	var netTransport = &http.Transport{
 		TLSClientConfig: tlsConfig,
 	}
@@ -56,55 +51,50 @@ func GetRuntimesByUrl(opURL string, pop *OpenWhiskInfo) error {
 	whisk.Debug(whisk.DbgInfo, "trying "+req.URL.String())
 	res, err := netClient.Do(req)
 	if err != nil {
-		// TODO() create an error
-		errString := wski18n.T(wski18n.ID_ERR_RUNTIMES_GET_X_err_X,
-			map[string]interface{}{"err": err.Error()})
-		whisk.Debug(whisk.DbgWarn, errString)
-		if utils.Flags.Strict {
-			errMessage := wski18n.T(wski18n.ID_ERR_RUNTIME_PARSER_ERROR,
-				map[string]interface{}{wski18n.KEY_ERR: err.Error()})
-			err = wskderrors.NewRuntimeParserError(errMessage)
-		}
-		return err
-	} else {
-		if res != nil {
-			defer res.Body.Close()
-		}
-		b, _ := ioutil.ReadAll(res.Body)
-		if b != nil && len(b) > 0 {
-			stdout := wski18n.T(wski18n.ID_MSG_UNMARSHAL_NETWORK_X_url_X,
-				map[string]interface{}{"url": opURL})
-			wskprint.PrintOpenWhiskVerbose(utils.Flags.Verbose, stdout)
-			return json.Unmarshal(b, pop)
-		}
-		return fmt.Errorf("cannot get runtimes")
+		return nil, err
 	}
-}
-
-// We could get the openwhisk info from bluemix through running the command
-// `curl -k https://openwhisk.ng.bluemix.net`
-// hard coding it here in case of network unavailable or failure.
-func ParseOpenWhisk(apiHost string) (op OpenWhiskInfo, err error) {
-	opURL := apiHost
-	_, err = url.ParseRequestURI(opURL)
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %s", opURL, res.Status)
+	}
+	b, err := ioutil.ReadAll(res.Body)
 	if err != nil {
-		opURL = HTTPS + opURL
+		return nil, err
+	}
+	if len(b) == 0 {
+		return nil, fmt.Errorf("%s: cannot get runtimes", opURL)
 	}
+	stdout := wski18n.T(wski18n.ID_MSG_UNMARSHAL_NETWORK_X_url_X,
+		map[string]interface{}{"url": opURL})
+	wskprint.PrintOpenWhiskVerbose(utils.Flags.Verbose, stdout)
+	return b, nil
+}
 
-	// trying to download info
-	err = GetRuntimesByUrl(opURL+"/api/info", &op)
+// ParseOpenWhisk returns the runtimes and limits of the apihost from the catalog,
+// see LoadCatalog for where the catalog is looked for
+func ParseOpenWhisk(apiHost string) (op OpenWhiskInfo, err error) {
+	catalog, err := LoadCatalog(apiHost)
 	if err != nil {
-		err = GetRuntimesByUrl(opURL, &op)
+		return op, err
 	}
-	if err != nil {
-		stdout := wski18n.T(wski18n.ID_MSG_UNMARSHAL_LOCAL)
-		wskprint.PrintOpenWhiskVerbose(utils.Flags.Verbose, stdout)
-		runtimes := []byte(os.Getenv("OPS_RUNTIMES_JSON"))
-		err = json.Unmarshal(runtimes, &op)
-		if err != nil {
-			fmt.Printf("cannot parse this json: ===\n%s\n===\n", runtimes)
-			return
-		}
+	switch catalog.Source {
+	case CATALOG_SOURCE_CACHE:
+		wskprint.PrintOpenWhiskVerbose(utils.Flags.Verbose, wski18n.T(wski18n.ID_MSG_RUNTIMES_CACHE_X_path_X_fetched_X,
+			map[string]interface{}{wski18n.KEY_PATH: catalog.Path, wski18n.KEY_FETCHED: catalog.Fetched.Local().Format(time.RFC3339)}))
+	case CATALOG_SOURCE_ENV, CATALOG_SOURCE_SNAPSHOT:
+		wskprint.PrintOpenWhiskVerbose(utils.Flags.Verbose, wski18n.T(wski18n.ID_MSG_UNMARSHAL_LOCAL))
 	}
-	return
+	return catalog.OpenWhiskInfo()
+}
+
+func runtimeParserError(err error) error {
+	errMessage := wski18n.T(wski18n.ID_ERR_RUNTIME_PARSER_ERROR,
+		map[string]interface{}{wski18n.KEY_ERR: err.Error()})
+	return wskderrors.NewRuntimeParserError(errMessage)
+}
+
+// a catalog which cannot be fetched is not an error as long as another one is found
+func warnCatalog(message string) {
+	whisk.Debug(whisk.DbgWarn, message)
+	wskprint.PrintOpenWhiskVerbose(utils.Flags.Verbose, message)
 }
@@ -30,6 +30,7 @@ import (
 // language versions.  Individual tests that require specific versions will have
 // better, more localized failures and error messages.
 func TestParseOpenWhisk(t *testing.T) {
+	setupCatalog(t)
 	openwhiskHost := "https://openwhisk.ng.bluemix.net"
 	openwhisk, err := ParseOpenWhisk(openwhiskHost)
 	assert.Equal(t, nil, err, "parse openwhisk info error happened.")
@@ -0,0 +1,3 @@
+function main(params: any) {
+    return { payload: "Hello" };
+}
@@ -0,0 +1,231 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+)
+
+const (
+	// the total size in bytes of the code of the actions held in memory at the same time, by default
+	DEFAULT_ARTIFACT_MEMORY_BUDGET = 256 * 1024 * 1024
+	// the copies of the code of an action held while it is deployed: the code and the JSON body of the request
+	ARTIFACT_MEMORY_COPIES = 2
+	// the prefix of the temporary files of the artifacts
+	ARTIFACT_TEMP_PREFIX = "wskdeploy-artifact"
+)
+
+/*
+   Artifact is the code of an action, kept in a file rather than in memory until the action is deployed.
+   The code is read, and base64 encoded on the fly for binary artifacts such as zip and jar archives, only
+   when it is sent to the apihost and within the memory budget shared by all the artifacts, see WithCode.
+*/
+type Artifact struct {
+	Path   string // the file with the code, a temporary file for archives and remote files
+	Binary bool   // the code is sent base64 encoded
+	Size   int64  // the size of the file
+	Digest string // the digest of the file, see Digest
+}
+
+// temporary files and directories of the artifacts, see RemoveTempArtifacts
+var tempArtifacts struct {
+	sync.Mutex
+	paths []string
+}
+
+// ArtifactMemory bounds the memory used by the code of the actions read from their artifacts
+var ArtifactMemory = NewMemoryBudget(DEFAULT_ARTIFACT_MEMORY_BUDGET)
+
+// NewArtifact returns the artifact of a local file, or of a remote file downloaded to a temporary file
+func NewArtifact(location string, binary bool) (*Artifact, error) {
+	if strings.HasPrefix(location, HTTP_FILE_EXTENSION) {
+		return NewTempArtifact(binary, func(w io.Writer) error {
+			return download(location, w)
+		})
+	}
+	file, err := os.Open(location)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	artifact := &Artifact{Path: location, Binary: binary}
+	return artifact, artifact.digest(file, ioutil.Discard)
+}
+
+// NewTempArtifact returns the artifact of a temporary file filled by the write function,
+// the file is removed by RemoveTempArtifacts
+func NewTempArtifact(binary bool, write func(w io.Writer) error) (*Artifact, error) {
+	file, err := ioutil.TempFile("", ARTIFACT_TEMP_PREFIX)
+	if err != nil {
+		return nil, err
+	}
+	AddTempArtifact(file.Name())
+	defer file.Close()
+
+	reader, writer := io.Pipe()
+	go func() {
+		writer.CloseWithError(write(writer))
+	}()
+	artifact := &Artifact{Path: file.Name(), Binary: binary}
+	if err := artifact.digest(reader, file); err != nil {
+		// stops the write function if the file could not be written
+		reader.CloseWithError(err)
+		return nil, err
+	}
+	return artifact, file.Close()
+}
+
+// digest copies the content of the artifact to w while computing its size and digest
+func (artifact *Artifact) digest(r io.Reader, w io.Writer) error {
+	hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(hash, w), r)
+	if err != nil {
+		return err
+	}
+	artifact.Size = size
+	artifact.Digest = DIGEST_ALGORITHM + ":" + hex.EncodeToString(hash.Sum(nil))
+	return nil
+}
+
+func download(url string, w io.Writer) error {
+	resp, err := http.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
+
+// AddTempArtifact records a temporary file, or directory, to be removed by RemoveTempArtifacts
+func AddTempArtifact(path string) {
+	tempArtifacts.Lock()
+	defer tempArtifacts.Unlock()
+	tempArtifacts.paths = append(tempArtifacts.paths, path)
+}
+
+// RemoveTempArtifacts removes the temporary files of the artifacts, once the actions are deployed
+func RemoveTempArtifacts() {
+	tempArtifacts.Lock()
+	defer tempArtifacts.Unlock()
+	for _, path := range tempArtifacts.paths {
+		os.RemoveAll(path)
+	}
+	tempArtifacts.paths = nil
+}
+
+// CodeSize is the size of the code of the artifact as sent to the apihost, i.e. after base64 encoding
+func (artifact *Artifact) CodeSize() int64 {
+	if artifact.Binary {
+		return int64(base64.StdEncoding.EncodedLen(int(artifact.Size)))
+	}
+	return artifact.Size
+}
+
+// Open returns a reader of the code of the artifact, base64 encoded on the fly for binary artifacts
+func (artifact *Artifact) Open() (io.ReadCloser, error) {
+	file, err := os.Open(artifact.Path)
+	if err != nil || !artifact.Binary {
+		return file, err
+	}
+	reader, writer := io.Pipe()
+	go func() {
+		encoder := base64.NewEncoder(base64.StdEncoding, writer)
+		_, err := io.Copy(encoder, file)
+		if err == nil {
+			err = encoder.Close()
+		}
+		file.Close()
+		writer.CloseWithError(err)
+	}()
+	return reader, nil
+}
+
+// ReadCode returns the code of the artifact, allocated once with its final size
+func (artifact *Artifact) ReadCode() (string, error) {
+	reader, err := artifact.Open()
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+	var code strings.Builder
+	code.Grow(int(artifact.CodeSize()))
+	_, err = io.Copy(&code, reader)
+	return code.String(), err
+}
+
+// WithCode calls fn with the code of the artifact once it fits in the memory budget, which is charged
+// for the code and the request it is marshalled into; the code must not be referenced after fn returns
+func (artifact *Artifact) WithCode(fn func(code string) error) error {
+	size := artifact.CodeSize() * ARTIFACT_MEMORY_COPIES
+	ArtifactMemory.Acquire(size)
+	defer ArtifactMemory.Release(size)
+	code, err := artifact.ReadCode()
+	if err != nil {
+		return err
+	}
+	return fn(code)
+}
+
+// MemoryBudget bounds the total size of the buffers held at the same time
+type MemoryBudget struct {
+	mutex sync.Mutex
+	cond  *sync.Cond
+	limit int64
+	used  int64
+}
+
+func NewMemoryBudget(limit int64) *MemoryBudget {
+	budget := &MemoryBudget{limit: limit}
+	budget.cond = sync.NewCond(&budget.mutex)
+	return budget
+}
+
+// Acquire waits until size bytes fit in the budget, a size over the limit waits until nothing else is held
+func (budget *MemoryBudget) Acquire(size int64) {
+	budget.mutex.Lock()
+	defer budget.mutex.Unlock()
+	for budget.used != 0 && budget.used+size > budget.limit {
+		budget.cond.Wait()
+	}
+	budget.used += size
+}
+
+// Release returns size bytes acquired to the budget
+func (budget *MemoryBudget) Release(size int64) {
+	budget.mutex.Lock()
+	defer budget.mutex.Unlock()
+	budget.used -= size
+	budget.cond.Broadcast()
+}
+
+// SetLimit changes the limit of the budget
+func (budget *MemoryBudget) SetLimit(limit int64) {
+	budget.mutex.Lock()
+	defer budget.mutex.Unlock()
+	budget.limit = limit
+	budget.cond.Broadcast()
+}
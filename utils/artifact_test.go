@@ -0,0 +1,113 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"encoding/base64"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewArtifact(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "hello.js")
+	assert.Nil(t, ioutil.WriteFile(path, []byte("function main() {}"), 0644))
+
+	artifact, err := NewArtifact(path, false)
+	assert.Nil(t, err)
+	assert.Equal(t, path, artifact.Path)
+	assert.Equal(t, int64(18), artifact.Size)
+	assert.Equal(t, int64(18), artifact.CodeSize())
+	assert.Equal(t, Digest([]byte("function main() {}")), artifact.Digest)
+	code, err := artifact.ReadCode()
+	assert.Nil(t, err)
+	assert.Equal(t, "function main() {}", code)
+
+	_, err = NewArtifact(filepath.Join(t.TempDir(), "missing.js"), false)
+	assert.NotNil(t, err)
+}
+
+func TestNewTempArtifact(t *testing.T) {
+	t.Cleanup(RemoveTempArtifacts)
+	content := []byte(strings.Repeat("binary\x00", 1000))
+	artifact, err := NewTempArtifact(true, func(w io.Writer) error {
+		_, err := w.Write(content)
+		return err
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(len(content)), artifact.Size)
+	assert.Equal(t, Digest(content), artifact.Digest)
+
+	// the code of a binary artifact is base64 encoded on the fly
+	encoded := base64.StdEncoding.EncodeToString(content)
+	assert.Equal(t, int64(len(encoded)), artifact.CodeSize())
+	code, err := artifact.ReadCode()
+	assert.Nil(t, err)
+	assert.Equal(t, encoded, code)
+
+	assert.Nil(t, artifact.WithCode(func(code string) error {
+		assert.Equal(t, encoded, code)
+		// the budget holds the code and the request it is marshalled into
+		assert.Equal(t, int64(len(encoded))*ARTIFACT_MEMORY_COPIES, ArtifactMemory.used)
+		return nil
+	}))
+	assert.Equal(t, int64(0), ArtifactMemory.used)
+
+	_, err = NewTempArtifact(true, func(w io.Writer) error {
+		return errors.New("no archive")
+	})
+	assert.EqualError(t, err, "no archive")
+
+	RemoveTempArtifacts()
+	_, err = os.Stat(artifact.Path)
+	assert.True(t, os.IsNotExist(err), "temporary artifacts are removed")
+}
+
+func TestMemoryBudget(t *testing.T) {
+	budget := NewMemoryBudget(100)
+	budget.Acquire(60)
+
+	acquired := make(chan bool)
+	go func() {
+		budget.Acquire(60)
+		acquired <- true
+	}()
+	select {
+	case <-acquired:
+		t.Fatal("the budget is exceeded")
+	case <-time.After(50 * time.Millisecond):
+	}
+	budget.Release(60)
+	<-acquired
+
+	// a size over the limit is acquired once nothing else is held
+	go func() {
+		budget.Acquire(200)
+		acquired <- true
+	}()
+	budget.Release(60)
+	<-acquired
+	budget.Release(200)
+}
@@ -0,0 +1,95 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCronErrors(t *testing.T) {
+	for _, expr := range []string{
+		"* * * *",
+		"* * * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"30-10 * * * *",
+		"* * * foo *",
+	} {
+		_, err := ParseCron(expr, nil)
+		assert.NotNil(t, err, "Expected an error for "+expr)
+	}
+}
+
+func TestCronNextTimes(t *testing.T) {
+	from := time.Date(2021, time.March, 10, 10, 7, 30, 0, time.UTC)
+
+	c, err := ParseCron("*/15 * * * *", nil)
+	assert.Nil(t, err)
+	times := c.NextTimes(from, 3)
+	assert.Equal(t, []time.Time{
+		time.Date(2021, time.March, 10, 10, 15, 0, 0, time.UTC),
+		time.Date(2021, time.March, 10, 10, 30, 0, 0, time.UTC),
+		time.Date(2021, time.March, 10, 10, 45, 0, 0, time.UTC),
+	}, times)
+
+	// names, ranges and sunday as 7
+	c, err = ParseCron("0 9 * JAN-mar sun,7", nil)
+	assert.Nil(t, err)
+	next, ok := c.Next(from)
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2021, time.March, 14, 9, 0, 0, 0, time.UTC), next)
+
+	// either the day of month or the day of week when both are restricted
+	c, err = ParseCron("0 0 1 * mon", nil)
+	assert.Nil(t, err)
+	next, _ = c.Next(from)
+	assert.Equal(t, time.Date(2021, time.March, 15, 0, 0, 0, 0, time.UTC), next)
+
+	// seconds
+	c, err = ParseCron("*/20 * * * * *", nil)
+	assert.Nil(t, err)
+	next, _ = c.Next(from)
+	assert.Equal(t, time.Date(2021, time.March, 10, 10, 7, 40, 0, time.UTC), next)
+
+	// leap days
+	c, err = ParseCron("0 0 29 2 *", nil)
+	assert.Nil(t, err)
+	next, _ = c.Next(from)
+	assert.Equal(t, time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC), next)
+
+	c, err = ParseCron("0 0 31 2 *", nil)
+	assert.Nil(t, err)
+	_, ok = c.Next(from)
+	assert.False(t, ok, "The 31st of February never comes")
+}
+
+func TestCronTimezone(t *testing.T) {
+	rome, err := time.LoadLocation("Europe/Rome")
+	assert.Nil(t, err)
+	c, err := ParseCron("0 8 * * *", rome)
+	assert.Nil(t, err)
+	next, _ := c.Next(time.Date(2021, time.March, 10, 10, 0, 0, 0, time.UTC))
+	assert.Equal(t, time.Date(2021, time.March, 11, 7, 0, 0, 0, time.UTC), next.UTC())
+}
@@ -20,11 +20,14 @@ package utils
 import (
 	"fmt"
 	"reflect"
+	"time"
+
+	"github.com/sciabarracom/openwhisk-wskdeploy/wskprint"
 )
 
 type WskDeployFlags struct {
 	ApiHost          string // OpenWhisk API host
-	Auth             string // OpenWhisk API key
+	Auth             string `secret:"true"` // OpenWhisk API key
 	Namespace        string
 	ApiVersion       string // OpenWhisk version
 	CfgFile          string
@@ -40,14 +43,29 @@ type WskDeployFlags struct {
 	Cert             string
 	Managed          bool   // OpenWhisk Managed Deployments
 	ProjectName      string // Project name
-	ApigwAccessToken string
+	ApigwAccessToken string `secret:"true"`
 	//ApigwTenantId    string // APIGW_TENANT_ID (IAM namespace resource identifier); not avail. as CLI flag yet
 	Verbose   bool
 	Trace     bool
 	Sync      bool
 	Report    bool
 	Param     []string
-	ParamFile string
+	ParamFile []string
+	// export factors values shared by this number of actions into package defaults
+	DefaultsMinActions int
+	AlarmsPackage      string // package of the alarm feed of scheduled triggers
+	ExplainParams      bool   // print where the value of each parameter comes from
+	ListZipContents    bool   // print the files in the archive of each action and why
+	CodeSizeLimit      int    // maximum size of the code of an action in MB, overriding the one of the apihost
+	MemoryBudget       int    // total size in MB of the code of the actions, and of their requests, held in memory at the same time
+	// catalog of runtimes
+	Offline     bool          // use the cached catalog of runtimes without accessing the apihost
+	CACert      string        // CA certificate verifying the apihost
+	Insecure    bool          // skip the verification of the certificate of the apihost
+	RuntimesTTL time.Duration // age after which the cached catalog of runtimes is refreshed
+	// runtimes command
+	JSON          bool // print the output in JSON
+	CheckRuntimes bool // list the actions of the manifest using a deprecated or unavailable runtime
 }
 
 // TODO turn this into a generic utility for formatting any struct
@@ -64,6 +82,10 @@ func (flags *WskDeployFlags) Format() string {
 	for i := 0; i < flagValues.NumField(); i++ {
 		name = flagNames.Field(i).Name
 		value = flagValues.Field(i)
+		// credentials are masked in verbose output
+		if _, ok := flagNames.Field(i).Tag.Lookup("secret"); ok && !flagValues.Field(i).IsZero() {
+			value = wskprint.SECRET_MASK
+		}
 		// NOTE: if you need to see the Type, add this line to output
 		//t = flagValues.Field(i).Type()
 		line := fmt.Sprintf("      > %s: [%v]\n", name, value)
@@ -74,3 +96,7 @@ func (flags *WskDeployFlags) Format() string {
 }
 
 var Flags WskDeployFlags
+
+// ParamSources maps the name of each parameter set on CLI to the --param or
+// --param-file argument which sets its value
+var ParamSources = make(map[string]string)
@@ -0,0 +1,108 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// PATH_ANY_DIRS matches any number of directories in a path, including none
+const PATH_ANY_DIRS = "**"
+
+// the characters of path.Match patterns
+const PATH_WILDCARDS = "*?["
+
+// HasWildcard is true when the path is a pattern
+func HasWildcard(pattern string) bool {
+	return strings.ContainsAny(pattern, PATH_WILDCARDS)
+}
+
+// MatchPath reports whether the slash separated name matches the pattern, which is a path.Match pattern
+// where a ** element matches any number of elements of the name, e.g. actions/**/*.js matches actions/index.js
+// and actions/lib/utils.js
+func MatchPath(pattern string, name string) (bool, error) {
+	return matchElements(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
+
+func matchElements(pattern []string, name []string) (bool, error) {
+	for len(pattern) != 0 {
+		if pattern[0] == PATH_ANY_DIRS {
+			for i := 0; i <= len(name); i++ {
+				if match, err := matchElements(pattern[1:], name[i:]); match || err != nil {
+					return match, err
+				}
+			}
+			return false, nil
+		}
+		if len(name) == 0 {
+			return false, nil
+		}
+		if match, err := path.Match(pattern[0], name[0]); !match || err != nil {
+			return false, err
+		}
+		pattern, name = pattern[1:], name[1:]
+	}
+	return len(name) == 0, nil
+}
+
+// WildcardPrefix returns the directory of a pattern before its first element with wildcards
+func WildcardPrefix(pattern string) string {
+	elements := strings.Split(filepath.ToSlash(pattern), "/")
+	for i, element := range elements {
+		if !HasWildcard(element) {
+			continue
+		}
+		if i == 0 {
+			return "."
+		}
+		if i == 1 && len(elements[0]) == 0 {
+			return string(filepath.Separator)
+		}
+		return filepath.FromSlash(path.Clean(strings.Join(elements[:i], "/")))
+	}
+	return filepath.Dir(pattern)
+}
+
+// GlobPath returns the sorted files and directories matching a pattern, see MatchPath
+func GlobPath(pattern string) ([]string, error) {
+	pattern = filepath.ToSlash(filepath.Clean(pattern))
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, err
+	}
+	root := WildcardPrefix(pattern)
+	if _, err := os.Stat(root); os.IsNotExist(err) {
+		return nil, nil
+	}
+	var matches []string
+	err := filepath.Walk(root, func(file string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		match, err := MatchPath(pattern, filepath.ToSlash(file))
+		if match {
+			matches = append(matches, file)
+		}
+		return err
+	})
+	sort.Strings(matches)
+	return matches, err
+}
@@ -0,0 +1,81 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchPath(t *testing.T) {
+	matches := []struct {
+		pattern string
+		name    string
+		match   bool
+	}{
+		{"actions/*.js", "actions/index.js", true},
+		{"actions/*.js", "actions/lib/utils.js", false},
+		{"actions/**/*.js", "actions/index.js", true},
+		{"actions/**/*.js", "actions/lib/common/utils.js", true},
+		{"actions/**", "actions", true},
+		{"actions/**", "actions/lib/utils.js", true},
+		{"**/node_modules", "actions/node_modules", true},
+		{"**/node_modules", "node_modules", true},
+		{"**/node_modules", "actions/node_modules/utils.js", false},
+		{"actions/lib", "actions/lib", true},
+		{"actions/lib", "actions/libs", false},
+	}
+	for _, m := range matches {
+		match, err := MatchPath(m.pattern, m.name)
+		assert.Nil(t, err)
+		assert.Equal(t, m.match, match, m.pattern+" "+m.name)
+	}
+	_, err := MatchPath("actions/[", "actions/lib")
+	assert.NotNil(t, err)
+}
+
+func TestWildcardPrefix(t *testing.T) {
+	assert.Equal(t, filepath.FromSlash("actions/libs"), WildcardPrefix("actions/libs/*"))
+	assert.Equal(t, filepath.FromSlash("actions"), WildcardPrefix("actions/**/*.js"))
+	assert.Equal(t, ".", WildcardPrefix("*.js"))
+	assert.Equal(t, filepath.FromSlash("actions"), WildcardPrefix("actions/index.js"))
+}
+
+func TestGlobPath(t *testing.T) {
+	root := t.TempDir()
+	for _, name := range []string{"index.js", "lib/utils.js", "lib/common/utils.js", "lib/common/README.md"} {
+		file := filepath.Join(root, filepath.FromSlash(name))
+		assert.Nil(t, os.MkdirAll(filepath.Dir(file), 0755))
+		assert.Nil(t, ioutil.WriteFile(file, []byte(name), 0644))
+	}
+	matches, err := GlobPath(filepath.Join(root, "**", "*.js"))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(root, "index.js"),
+		filepath.Join(root, "lib", "common", "utils.js"),
+		filepath.Join(root, "lib", "utils.js"),
+	}, matches)
+
+	matches, err = GlobPath(filepath.Join(root, "missing", "*"))
+	assert.Nil(t, err)
+	assert.Empty(t, matches)
+}
@@ -0,0 +1,141 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"bufio"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// IGNORE_FILE_NAME is the file listing the files left out of the archives of actions, with the syntax of .gitignore
+const IGNORE_FILE_NAME = ".wskignore"
+
+// IgnoreRule is a pattern of an ignore file
+type IgnoreRule struct {
+	Pattern  string // the pattern as written in the file
+	Source   string // the ignore file
+	Line     int
+	negate   bool
+	dirOnly  bool
+	elements string // slash separated pattern relative to the directory of the ignore file, see MatchPath
+}
+
+// Ignore is the list of rules of an ignore file, matching the paths under its directory
+type Ignore struct {
+	dir   string
+	rules []IgnoreRule
+}
+
+/*
+   ReadIgnoreFile reads the rules of an ignore file, nil without the file.
+   The rules follow .gitignore: blank lines and lines starting with # are skipped, a leading ! negates the pattern,
+   a trailing / only matches directories, a pattern with a / at the beginning or in the middle is relative to the
+   directory of the file while any other pattern matches at any level, and ** matches any number of directories.
+*/
+func ReadIgnoreFile(file string) (*Ignore, error) {
+	content, err := os.Open(file)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer content.Close()
+
+	ignore := &Ignore{dir: filepath.Dir(file)}
+	scanner := bufio.NewScanner(content)
+	for line := 1; scanner.Scan(); line++ {
+		rule, ok := parseIgnoreRule(scanner.Text())
+		if !ok {
+			continue
+		}
+		rule.Source = file
+		rule.Line = line
+		if _, err := path.Match(rule.elements, ""); err != nil {
+			return nil, err
+		}
+		ignore.rules = append(ignore.rules, rule)
+	}
+	return ignore, scanner.Err()
+}
+
+func parseIgnoreRule(line string) (IgnoreRule, bool) {
+	// trailing spaces are ignored unless escaped
+	pattern := strings.TrimRight(line, " \t\r")
+	if strings.HasSuffix(pattern, "\\") && len(pattern) < len(line) {
+		pattern += " "
+	}
+	rule := IgnoreRule{Pattern: pattern}
+	if len(pattern) == 0 || strings.HasPrefix(pattern, "#") {
+		return rule, false
+	}
+	if strings.HasPrefix(pattern, "!") {
+		rule.negate = true
+		pattern = pattern[1:]
+	} else if strings.HasPrefix(pattern, "\\!") || strings.HasPrefix(pattern, "\\#") {
+		pattern = pattern[1:]
+	}
+	if strings.HasSuffix(pattern, "/") {
+		rule.dirOnly = true
+		pattern = strings.TrimRight(pattern, "/")
+	}
+	if len(pattern) == 0 {
+		return rule, false
+	}
+	if !strings.Contains(pattern, "/") {
+		pattern = PATH_ANY_DIRS + "/" + pattern
+	}
+	pattern = strings.TrimPrefix(pattern, "/")
+	// a trailing ** matches what is inside a directory, not the directory
+	if strings.HasSuffix(pattern, "/"+PATH_ANY_DIRS) {
+		pattern += "/*"
+	}
+	rule.elements = strings.Replace(pattern, "[!", "[^", -1)
+	return rule, true
+}
+
+// Match returns the last rule matching a file under the directory of the ignore file, nil if none does.
+// The file is ignored when the rule is not negated.
+func (ignore *Ignore) Match(file string, isDir bool) *IgnoreRule {
+	if ignore == nil {
+		return nil
+	}
+	rel, err := filepath.Rel(ignore.dir, file)
+	if err != nil || rel == "." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) || rel == ".." {
+		return nil
+	}
+	name := filepath.ToSlash(rel)
+	for i := len(ignore.rules) - 1; i >= 0; i-- {
+		rule := &ignore.rules[i]
+		if rule.dirOnly && !isDir {
+			continue
+		}
+		if match, _ := MatchPath(rule.elements, name); match {
+			return rule
+		}
+	}
+	return nil
+}
+
+// Ignored is true when the rule leaves the file out
+func (rule *IgnoreRule) Ignored() bool {
+	return rule != nil && !rule.negate
+}
@@ -0,0 +1,92 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadIgnoreFile(t *testing.T) {
+	dir := t.TempDir()
+	ignore, err := ReadIgnoreFile(filepath.Join(dir, IGNORE_FILE_NAME))
+	assert.Nil(t, err)
+	assert.Nil(t, ignore)
+	assert.Nil(t, ignore.Match(filepath.Join(dir, "index.js"), false))
+
+	content := `# comment
+
+.DS_Store
+*.log
+!keep.log
+build/
+/config.json
+test/fixtures
+docs/**
+!docs/README.md
+\#notes
+trailing\ 
+[!a]*.tmp
+`
+	file := filepath.Join(dir, IGNORE_FILE_NAME)
+	assert.Nil(t, ioutil.WriteFile(file, []byte(content), 0644))
+	ignore, err = ReadIgnoreFile(file)
+	assert.Nil(t, err)
+
+	matches := []struct {
+		name    string
+		isDir   bool
+		ignored bool
+		line    int
+	}{
+		{".DS_Store", false, true, 3},
+		{"lib/.DS_Store", false, true, 3},
+		{"lib/debug.log", false, true, 4},
+		{"lib/keep.log", false, false, 5},
+		{"build", true, true, 6},
+		{"lib/build", true, true, 6},
+		{"build", false, false, 0},
+		{"config.json", false, true, 7},
+		{"lib/config.json", false, false, 0},
+		{"test/fixtures", true, true, 8},
+		{"lib/test/fixtures", true, false, 0},
+		{"docs", true, false, 0},
+		{"docs/api/index.md", false, true, 9},
+		{"docs/README.md", false, false, 10},
+		{"#notes", false, true, 11},
+		{"trailing ", false, true, 12},
+		{"b.tmp", false, true, 13},
+		{"a.tmp", false, false, 0},
+		{"index.js", false, false, 0},
+	}
+	for _, m := range matches {
+		rule := ignore.Match(filepath.Join(dir, filepath.FromSlash(m.name)), m.isDir)
+		assert.Equal(t, m.ignored, rule.Ignored(), m.name)
+		if m.line == 0 {
+			assert.True(t, rule == nil || !rule.Ignored(), m.name)
+		} else {
+			assert.Equal(t, m.line, rule.Line, m.name)
+			assert.Equal(t, file, rule.Source)
+		}
+	}
+	// files outside of the directory of the ignore file do not match
+	assert.Nil(t, ignore.Match(filepath.Join(filepath.Dir(dir), ".DS_Store"), false))
+}
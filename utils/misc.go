@@ -18,7 +18,10 @@
 package utils
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"os"
@@ -38,6 +41,8 @@ const (
 	ManifestFileNameYml    = "manifest.yml"
 	DeploymentFileNameYaml = "deployment.yaml"
 	DeploymentFileNameYml  = "deployment.yml"
+	// algorithm of the digests of the artifacts
+	DIGEST_ALGORITHM = "sha256"
 )
 
 // ActionRecord is a container to keep track of
@@ -47,6 +52,10 @@ type ActionRecord struct {
 	Action      *whisk.Action
 	Packagename string
 	Filepath    string
+	// Digest identifies the code of the action, i.e. its source file or archive, to detect its changes
+	Digest string
+	// Artifact holds the code of an action read from its function until it is deployed, see Artifact.WithCode
+	Artifact *Artifact
 }
 
 type TriggerRecord struct {
@@ -59,6 +68,14 @@ type RuleRecord struct {
 	Packagename string
 }
 
+// FeedRecord keeps the inputs a feed action is invoked with
+// for each lifecycle event declared in the operations of the feed
+type FeedRecord struct {
+	Name        string
+	Packagename string
+	Operations  map[string]whisk.KeyValueArr
+}
+
 func fallbackHome() string {
 	if home := os.Getenv("HOME"); home != "" {
 		return home
@@ -198,3 +215,24 @@ func GetActionFromActionRecords(records []ActionRecord, packageName string, acti
 	}
 	return nil
 }
+
+// Digest returns the digest of the content of an artifact, e.g. sha256:<hex>
+func Digest(content []byte) string {
+	sum := sha256.Sum256(content)
+	return DIGEST_ALGORITHM + ":" + hex.EncodeToString(sum[:])
+}
+
+// FormatSize returns a size in bytes with the largest binary unit below it, e.g. 1.5 MB
+func FormatSize(size int64) string {
+	units := []string{"KB", "MB", "GB"}
+	if size < 1024 {
+		return fmt.Sprintf("%d B", size)
+	}
+	value := float64(size) / 1024
+	unit := 0
+	for value >= 1024 && unit < len(units)-1 {
+		value /= 1024
+		unit++
+	}
+	return fmt.Sprintf("%.1f %s", value, units[unit])
+}
@@ -65,3 +65,12 @@ func TestNewZipWriter(t *testing.T) {
 	defer os.Remove(zipName)
 	assert.Equal(t, nil, err, "zip folder error happened.")
 }
+
+func TestFormatSize(t *testing.T) {
+	assert.Equal(t, "0 B", FormatSize(0))
+	assert.Equal(t, "1023 B", FormatSize(1023))
+	assert.Equal(t, "1.0 KB", FormatSize(1024))
+	assert.Equal(t, "1.5 MB", FormatSize(1536*1024))
+	assert.Equal(t, "48.0 MB", FormatSize(DEFAULT_CODE_SIZE_LIMIT))
+	assert.Equal(t, "2048.0 GB", FormatSize(2048*1024*1024*1024))
+}
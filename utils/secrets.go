@@ -0,0 +1,69 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"sort"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/sciabarracom/openwhisk-wskdeploy/wskprint"
+)
+
+// SECRET_INPUTS annotates an entity with the names of its secret inputs, which are masked in
+// preview and report and exported as placeholders, as the platform does not tell them apart
+const SECRET_INPUTS = "secret-inputs"
+
+// SecretInputsAnnotation returns the annotation listing the secret inputs
+func SecretInputsAnnotation(names []string) whisk.KeyValue {
+	sorted := append([]string{}, names...)
+	sort.Strings(sorted)
+	return whisk.KeyValue{Key: SECRET_INPUTS, Value: sorted}
+}
+
+// SecretInputs returns the names of the secret inputs listed by the annotations
+func SecretInputs(annotations whisk.KeyValueArr) map[string]bool {
+	names := make(map[string]bool)
+	switch value := annotations.GetValue(SECRET_INPUTS).(type) {
+	case []string:
+		for _, name := range value {
+			names[name] = true
+		}
+	// as read back from OpenWhisk
+	case []interface{}:
+		for _, name := range value {
+			if s, ok := name.(string); ok {
+				names[s] = true
+			}
+		}
+	}
+	return names
+}
+
+// MaskSecretParameters returns a copy of the parameters with the values of the secret inputs
+// listed by the annotations replaced by wskprint.SECRET_MASK
+func MaskSecretParameters(parameters whisk.KeyValueArr, annotations whisk.KeyValueArr) whisk.KeyValueArr {
+	secrets := SecretInputs(annotations)
+	masked := make(whisk.KeyValueArr, 0, len(parameters))
+	for _, p := range parameters {
+		if secrets[p.Key] {
+			p.Value = wskprint.SECRET_MASK
+		}
+		masked = append(masked, p)
+	}
+	return masked
+}
@@ -0,0 +1,52 @@
+// +build unit
+
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/sciabarracom/openwhisk-wskdeploy/wskprint"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaskSecretParameters(t *testing.T) {
+	parameters := whisk.KeyValueArr{{Key: "user", Value: "admin"}, {Key: "password", Value: "hunter22"}}
+	annotations := whisk.KeyValueArr{SecretInputsAnnotation([]string{"password"})}
+
+	masked := MaskSecretParameters(parameters, annotations)
+	assert.Equal(t, "admin", masked.GetValue("user"))
+	assert.Equal(t, wskprint.SECRET_MASK, masked.GetValue("password"))
+	assert.Equal(t, "hunter22", parameters.GetValue("password"), "the parameters must not be changed")
+
+	// as read back from OpenWhisk
+	annotations = whisk.KeyValueArr{{Key: SECRET_INPUTS, Value: []interface{}{"user"}}}
+	assert.Equal(t, map[string]bool{"user": true}, SecretInputs(annotations))
+	assert.Equal(t, map[string]bool{}, SecretInputs(nil))
+}
+
+func TestFlagsFormatMasksCredentials(t *testing.T) {
+	flags := WskDeployFlags{Auth: "user:password", ApiHost: "localhost"}
+	formatted := flags.Format()
+	assert.NotContains(t, formatted, "user:password")
+	assert.Contains(t, formatted, "Auth: ["+wskprint.SECRET_MASK+"]")
+	assert.Contains(t, formatted, "ApiHost: [localhost]")
+	assert.Contains(t, formatted, "ApigwAccessToken: []")
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/apache/openwhisk-client-go/whisk"
 	"github.com/sciabarracom/openwhisk-wskdeploy/wski18n"
 	"github.com/sciabarracom/openwhisk-wskdeploy/wskprint"
+	"regexp"
 	"strings"
 )
 
@@ -134,53 +135,63 @@ func LicenseRemoteValidation(license string) bool {
 	return false
 }
 
-//if valid or nil, true
-//or else, false
-func LimitsTimeoutValidation(timeout *int) bool {
-	if timeout == nil {
-		return true
-	}
-	if *timeout < 100 {
-		// Do not allow invalid limit to be added to API
-		wskprint.PrintlnOpenWhiskWarning(wski18n.T(wski18n.ID_WARN_LIMITS_TIMEOUT))
-		return false
-	} else if *timeout > 600000 {
-		// Emit a warning, but allow to pass through to provider
-		wskprint.PrintlnOpenWhiskWarning(wski18n.T(wski18n.ID_WARN_LIMITS_TIMEOUT))
-	}
-	return true
+// LimitRange is the range of values accepted by the provider for an action limit
+type LimitRange struct {
+	Min int
+	Max int
 }
 
-//if valid or nil, true
-//or else, false
-func LimitsMemoryValidation(memory *int) bool {
-	if memory == nil {
-		return true
-	}
-	if *memory < 128 {
-		// Do not allow invalid limit to be added to API
-		wskprint.PrintlnOpenWhiskWarning(wski18n.T(wski18n.ID_WARN_LIMITS_MEMORY_SIZE))
-		return false
-	} else if *memory > 2048 {
-		// Emit a warning, but allow to pass through to provider
-		wskprint.PrintlnOpenWhiskWarning(wski18n.T(wski18n.ID_WARN_LIMITS_MEMORY_SIZE))
-	}
-	return true
+// ActionLimits holds the ranges of the action limits that can be set through the API
+type ActionLimits struct {
+	Timeout     LimitRange // in ms
+	Memory      LimitRange // in MB
+	Logsize     LimitRange // in MB
+	Concurrency LimitRange // activations per container
+}
+
+// defaults of the OpenWhisk platform, used when the provider does not report its limits
+var DefaultActionLimitRanges = ActionLimits{
+	Timeout:     LimitRange{Min: 100, Max: 600000},
+	Memory:      LimitRange{Min: 128, Max: 2048},
+	Logsize:     LimitRange{Min: 0, Max: 10},
+	Concurrency: LimitRange{Min: 1, Max: 500},
 }
 
+// replaced by the limits reported by the provider's /api/info, see runtimes.ActionLimitRanges
+var ActionLimitRanges = DefaultActionLimitRanges
+
+const (
+	// the maximum size in bytes of the code of an action of the OpenWhisk platform, the code of a zip or
+	// jar archive being encoded in base64
+	DEFAULT_CODE_SIZE_LIMIT = 48 * 1024 * 1024
+	// the percentage of the code size limit above which the largest files of the archive of an action are printed
+	CODE_SIZE_WARNING_PERCENT = 90
+	// the number of the largest files, and directories, of an archive which are printed
+	CODE_SIZE_LARGEST_FILES = 10
+)
+
+// replaced by the limit reported by the provider's /api/info or set with --code-size-limit, see runtimes.CodeSizeLimit
+var CodeSizeLimit = DEFAULT_CODE_SIZE_LIMIT
+
 //if valid or nil, true
 //or else, false
-func LimitsLogsizeValidation(logsize *int) bool {
-	if logsize == nil {
+func LimitValidation(name string, value *int, limitRange LimitRange) bool {
+	if value == nil {
 		return true
 	}
-	if *logsize < 0 {
+	warningString := wski18n.T(wski18n.ID_WARN_LIMIT_RANGE_X_limit_X_value_X_min_X_max_X,
+		map[string]interface{}{
+			wski18n.KEY_LIMIT:     name,
+			wski18n.KEY_VALUE:     *value,
+			wski18n.KEY_VALUE_MIN: limitRange.Min,
+			wski18n.KEY_VALUE_MAX: limitRange.Max})
+	if *value < limitRange.Min {
 		// Do not allow invalid limit to be added to API
-		wskprint.PrintlnOpenWhiskWarning(wski18n.T(wski18n.ID_WARN_LIMITS_LOG_SIZE))
+		wskprint.PrintlnOpenWhiskWarning(warningString)
 		return false
-	} else if *logsize > 10 {
+	} else if *value > limitRange.Max {
 		// Emit a warning, but allow to pass through to provider
-		wskprint.PrintlnOpenWhiskWarning(wski18n.T(wski18n.ID_WARN_LIMITS_LOG_SIZE))
+		wskprint.PrintlnOpenWhiskWarning(warningString)
 	}
 	return true
 }
@@ -193,3 +204,25 @@ func NotSupportLimits(value *int, name string) {
 		wskprint.PrintlnOpenWhiskWarning(warningString)
 	}
 }
+
+// the grammar of the docker image references, i.e. [registry[:port]/]name[:tag][@digest],
+// the registry is told apart from the name by a dot, a port or being localhost
+const (
+	dockerDomainComponent = `(?:[a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9-]*[a-zA-Z0-9])`
+	dockerDomain          = `(?:localhost|` + dockerDomainComponent + `(?:\.` + dockerDomainComponent + `)+)(?::[0-9]+)?|` + dockerDomainComponent + `:[0-9]+`
+	dockerNameComponent   = `[a-z0-9]+(?:(?:[._]|__|[-]*)[a-z0-9]+)*`
+	dockerName            = `(?:(?:` + dockerDomain + `)/)?` + dockerNameComponent + `(?:/` + dockerNameComponent + `)*`
+	dockerTag             = `[\w][\w.-]{0,127}`
+	dockerDigest          = `[A-Za-z][A-Za-z0-9]*(?:[-_+.][A-Za-z][A-Za-z0-9]*)*:[0-9a-fA-F]{32,}`
+)
+
+// DOCKER_NAME_MAX_LENGTH is the maximum length of the name of an image, without tag and digest
+const DOCKER_NAME_MAX_LENGTH = 255
+
+var dockerImageRegexp = regexp.MustCompile(`^(` + dockerName + `)(?::` + dockerTag + `)?(?:@` + dockerDigest + `)?$`)
+
+// ValidDockerImage checks the syntax of a docker image reference, e.g. openwhisk/dockerskeleton:latest
+func ValidDockerImage(image string) bool {
+	match := dockerImageRegexp.FindStringSubmatch(image)
+	return match != nil && len(match[1]) <= DOCKER_NAME_MAX_LENGTH
+}
@@ -61,3 +61,28 @@ func TestCheckLicense(t *testing.T) {
 	assert.True(t, CheckLicense("Zimbra-1.3"))
 	assert.True(t, CheckLicense("xpp"))
 }
+
+func TestValidDockerImage(t *testing.T) {
+	for _, image := range []string{
+		"openwhisk/dockerskeleton",
+		"openwhisk/dockerskeleton:latest",
+		"ubuntu",
+		"registry.example.com:5000/team/my-action:1.0.2",
+		"localhost/my_image__v2",
+		"ghcr.io/org/image@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+	} {
+		assert.True(t, ValidDockerImage(image), image)
+	}
+	for _, image := range []string{
+		"",
+		"OpenWhisk/skeleton",
+		"openwhisk/skeleton:",
+		"openwhisk//skeleton",
+		"openwhisk/skeleton:-latest",
+		"openwhisk/skeleton@sha256:abc",
+		"open whisk/skeleton",
+		"-registry.io/image",
+	} {
+		assert.False(t, ValidDockerImage(image), image)
+	}
+}
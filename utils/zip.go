@@ -21,7 +21,9 @@ import (
 	"archive/zip"
 	"io"
 	"os"
+	"path"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/sciabarracom/openwhisk-wskdeploy/wski18n"
@@ -31,333 +33,428 @@ import (
 const PATH_WILDCARD = "*"
 const ONE_DIR_UP = "../"
 
+// the metadata of the entries of the zip files, normalized to build the same zip file from the same files
+// on any machine: the modification time is the MS-DOS epoch, i.e. 1980-01-01, and executables are the only
+// files with permissions other than ZIP_FILE_MODE
+const (
+	ZIP_MODIFIED_DATE = 1<<5 | 1
+	ZIP_FILE_MODE     = 0644
+	ZIP_EXEC_MODE     = 0755
+)
+
 func NewZipWritter(src string, des string, include [][]string, exclude []string, manifestFilePath string) *ZipWritter {
 	zw := &ZipWritter{
 		src:              src,
 		des:              des,
 		include:          include,
 		exclude:          exclude,
-		excludedFiles:    make(map[string]bool, 0),
 		manifestFilePath: manifestFilePath,
+		entries:          make(map[string]zipEntry),
+		left:             make(map[string]string),
 	}
 	return zw
 }
 
+/*
+   ZipWritter archives the directory of an action with the files it includes and without the ones it excludes.
+   The archive is built from a list of entries, mapping the path in the archive to the file it is read from,
+   the directory of the action is never written to.
+
+   Include and exclude paths are relative to the manifest, the destination of an include is relative to
+   the root of the archive. Paths can have wildcards, where ** matches any number of directories.
+   Excluded files, and the files of excluded directories, are left out of the archive, unless they are
+   included explicitly, i.e. matched by the path of an include rather than found in an included directory.
+   The files ignored by the .wskignore of the manifest directory, or of the directory of the action, are
+   excluded as well, the rules of the action taking precedence.
+*/
 type ZipWritter struct {
 	src              string
 	des              string
 	include          [][]string
 	exclude          []string
-	excludedFiles    map[string]bool
 	manifestFilePath string
-	zipWritter       *zip.Writer
+	ignores          []*Ignore
+	entries          map[string]zipEntry
+	left             map[string]string // the reason of each file left out of the archive
 }
 
-type Include struct {
-	source      string
-	destination string
+// zipEntry is a file added to the zip file, entries are written sorted by name once all of them are known
+type zipEntry struct {
+	path   string
+	mode   os.FileMode
+	reason string
 }
 
-func (zw *ZipWritter) zipFile(path string, f os.FileInfo, err error) error {
-	var file *os.File
-	var wr io.Writer
-	var verboseMsg string
+// ZipContent is a file of the directory of an action, or of its includes, with the reason it is in the archive
+// or left out of it, in which case it has no name
+type ZipContent struct {
+	Name   string
+	Path   string
+	Reason string
+}
 
-	if err != nil {
-		return err
+// ZipFileHeader returns the header of an entry of a zip file with normalized metadata and no extra fields
+func ZipFileHeader(name string, mode os.FileMode) *zip.FileHeader {
+	header := &zip.FileHeader{
+		Name:         filepath.ToSlash(name),
+		Method:       zip.Deflate,
+		ModifiedDate: ZIP_MODIFIED_DATE,
 	}
-
-	if zw.excludedFiles[filepath.Clean(path)] {
-		verboseMsg = wski18n.T(wski18n.ID_VERBOSE_ZIP_EXCLUDING_FILE_X_path_X,
-			map[string]interface{}{
-				wski18n.KEY_PATH: path,
-			})
-		wskprint.PrintlnOpenWhiskVerbose(Flags.Verbose, verboseMsg)
-		return nil
+	header.SetMode(ZIP_FILE_MODE)
+	if mode&0111 != 0 {
+		header.SetMode(ZIP_EXEC_MODE)
 	}
+	return header
+}
 
-	if !f.Mode().IsRegular() || f.Size() == 0 {
-		return nil
+// excluded is true when the file, or one of its directories, matches an exclude path or the file is ignored,
+// the reason is the exclude or the ignore rule deciding it, if any
+func (zw *ZipWritter) excluded(file string, isDir bool) (bool, string, error) {
+	if exclude, err := zw.excludedBy(file); len(exclude) != 0 || err != nil {
+		return true, wski18n.T(wski18n.ID_MSG_ZIP_EXCLUDED_X_exclude_X,
+			map[string]interface{}{wski18n.KEY_EXCLUDE: exclude}), err
 	}
-	if file, err = os.Open(path); err != nil {
-		return err
+	for i := len(zw.ignores) - 1; i >= 0; i-- {
+		if rule := zw.ignores[i].Match(file, isDir); rule != nil {
+			id := wski18n.ID_MSG_ZIP_KEPT_X_pattern_X_path_X_line_X
+			if rule.Ignored() {
+				id = wski18n.ID_MSG_ZIP_IGNORED_X_pattern_X_path_X_line_X
+			}
+			return rule.Ignored(), wski18n.T(id,
+				map[string]interface{}{
+					wski18n.KEY_PATTERN: rule.Pattern,
+					wski18n.KEY_PATH:    zw.relative(rule.Source),
+					wski18n.KEY_LINE:    rule.Line,
+				}), nil
+		}
 	}
-	defer file.Close()
+	return false, "", nil
+}
 
-	fileName := strings.TrimPrefix(path, zw.src+"/")
-	if wr, err = zw.zipWritter.Create(fileName); err != nil {
-		return err
+// excludedBy returns the exclude path matching the file or one of its directories
+func (zw *ZipWritter) excludedBy(file string) (string, error) {
+	name := filepath.ToSlash(zw.relative(file))
+	for {
+		for _, exclude := range zw.exclude {
+			if match, err := MatchPath(path.Clean(filepath.ToSlash(exclude)), name); match || err != nil {
+				return exclude, err
+			}
+		}
+		parent := path.Dir(name)
+		if parent == "." || parent == name || path.Base(parent) == ".." {
+			return "", nil
+		}
+		name = parent
 	}
+}
 
-	if _, err = io.Copy(wr, file); err != nil {
-		return err
+// relative returns the path of a file relative to the manifest
+func (zw *ZipWritter) relative(file string) string {
+	if rel, err := filepath.Rel(zw.manifestFilePath, file); err == nil {
+		return rel
 	}
-	verboseMsg = wski18n.T(wski18n.ID_VERBOSE_ZIP_ADDING_FILE_X_path_X,
+	return file
+}
+
+// leave records a file left out of the archive
+func (zw *ZipWritter) leave(file string, reason string) {
+	zw.left[file] = reason
+	wskprint.PrintlnOpenWhiskVerbose(Flags.Verbose, wski18n.T(wski18n.ID_VERBOSE_ZIP_EXCLUDING_FILE_X_path_X,
 		map[string]interface{}{
-			wski18n.KEY_PATH: path,
-		})
-	wskprint.PrintlnOpenWhiskVerbose(Flags.Verbose, verboseMsg)
-	return nil
+			wski18n.KEY_PATH: file,
+		}))
 }
 
-func (zw *ZipWritter) buildIncludeMetadata() ([]Include, error) {
-	var includeInfo []Include
-	var listOfSourceFiles []string
-	var err error
-	var verboseMsg string
-
-	// iterate over set of included files specified in manifest YAML e.g.
-	// include:
-	// - ["source"]
-	// - ["source", "destination"]
-	for _, includeData := range zw.include {
-		var i Include
-		// if "destination" is not specified, its considered same as "source"
-		// "source" is relative to where manifest.yaml file is located
-		// relative source path is converted to absolute path by appending manifest path
-		// since the relative source path might not be accessible from where wskdeploy is invoked
-		// "destination" is relative to the action directory, the one specified in function
-		// relative path is converted to absolute path by appending function directory
-		if len(includeData) == 1 {
-			i.source = filepath.Join(zw.manifestFilePath, includeData[0])
-			i.destination = filepath.Join(zw.src, includeData[0])
-			verboseMsg = wski18n.T(wski18n.ID_VERBOSE_ZIP_INCLUDE_SOURCE_PATH_X_path_X,
-				map[string]interface{}{
-					wski18n.KEY_PATH: includeData[0],
-				})
-			wskprint.PrintlnOpenWhiskVerbose(Flags.Verbose, verboseMsg)
-		} else if len(includeData) == 2 {
-			i.source = filepath.Join(zw.manifestFilePath, includeData[0])
-			i.destination = zw.src + "/" + includeData[1]
-			verboseMsg = wski18n.T(wski18n.ID_VERBOSE_ZIP_INCLUDE_SOURCE_PATH_X_path_X_DESTINATION_PATH_X_dest_X,
-				map[string]interface{}{
-					wski18n.KEY_PATH:        includeData[0],
-					wski18n.KEY_DESTINATION: includeData[1],
-				})
-		} else {
-			if len(includeData) == 0 {
-				verboseMsg = wski18n.T(wski18n.ID_VERBOSE_INVALID_INCLUDE_ENTRY,
-					map[string]interface{}{
-						wski18n.KEY_INCLUDE: "",
-					})
-				wskprint.PrintlnOpenWhiskVerbose(Flags.Verbose, verboseMsg)
-			} else {
-				for index, d := range includeData {
-					includeData[index] = "\"" + d + "\""
-				}
-				includeEntry := strings.Join(includeData, ", ")
-				verboseMsg = wski18n.T(wski18n.ID_VERBOSE_INVALID_INCLUDE_ENTRY,
-					map[string]interface{}{
-						wski18n.KEY_INCLUDE: includeEntry,
-					})
-				wskprint.PrintlnOpenWhiskVerbose(Flags.Verbose, verboseMsg)
+// addFile adds a regular, non empty, file to the archive, replacing the entry with the same name if any
+func (zw *ZipWritter) addFile(name string, file string, info os.FileInfo, reason string) {
+	if !info.Mode().IsRegular() || info.Size() == 0 {
+		zw.leave(file, wski18n.T(wski18n.ID_MSG_ZIP_SKIPPED_FILE))
+		return
+	}
+	// entries are relative to the root of the archive
+	name = path.Clean("/" + filepath.ToSlash(name))[1:]
+	zw.entries[name] = zipEntry{path: file, mode: info.Mode(), reason: reason}
+	delete(zw.left, file)
+	wskprint.PrintlnOpenWhiskVerbose(Flags.Verbose, wski18n.T(wski18n.ID_VERBOSE_ZIP_ADDING_FILE_X_path_X,
+		map[string]interface{}{
+			wski18n.KEY_PATH: file,
+		}))
+}
+
+// addDir adds the files of a directory which are not excluded under the given destination,
+// the ignore files themselves are never added
+func (zw *ZipWritter) addDir(dir string, destination string, reason string) error {
+	return filepath.Walk(dir, func(file string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		excluded, why, err := zw.excluded(file, info.IsDir())
+		if err != nil {
+			return err
+		}
+		if excluded {
+			zw.leave(file, why)
+			if info.IsDir() {
+				return filepath.SkipDir
 			}
-			continue
+			return nil
 		}
-
-		// set destDir to the destination location
-		// check if its a file than change it to the Dir of destination file
-		destDir := i.destination
-		if isFilePath(destDir) {
-			destDir = filepath.Dir(destDir)
+		if info.IsDir() {
+			return nil
 		}
-		// trim path wildcard "*" from the destination path as if it has any
-		destDirs := strings.Split(destDir, PATH_WILDCARD)
-		destDir = destDirs[0]
-
-		// retrieve the name of all files matching pattern or nil if there is no matching file
-		// listOfSourceFiles will hold a list of files matching patterns such as
-		// actions/* or actions/libs/* or actions/libs/*/utils.js or actions/*/*/utils.js
-		if listOfSourceFiles, err = filepath.Glob(i.source); err != nil {
-			return includeInfo, err
+		if info.Name() == IGNORE_FILE_NAME {
+			zw.leave(file, wski18n.T(wski18n.ID_MSG_ZIP_IGNORE_FILE))
+			return nil
 		}
+		rel, err := filepath.Rel(dir, file)
+		if err != nil {
+			return err
+		}
+		if len(why) == 0 {
+			why = reason
+		}
+		zw.addFile(path.Join(destination, filepath.ToSlash(rel)), file, info, why)
+		return nil
+	})
+}
 
-		// handle the scenarios where included path is something similar to actions/common/*.js
-		// or actions/libs/* or actions/libs/*/utils.js
-		// and destination is set to libs/ or libs/* or ./libs/* or libs/*/utils.js or libs/ or ./libs/
-		if strings.ContainsAny(i.source, PATH_WILDCARD) {
-			wskprint.PrintlnOpenWhiskVerbose(Flags.Verbose, wski18n.T(wski18n.ID_VERBOSE_LIST_OF_FILES_MATCHING_PATTERN))
-			for _, file := range listOfSourceFiles {
-				var relPath string
-				if relPath, err = filepath.Rel(i.source, file); err != nil {
-					return includeInfo, err
-				}
-				relPath = strings.TrimLeft(relPath, ONE_DIR_UP)
-				j := Include{
-					source:      file,
-					destination: filepath.Join(destDir, relPath),
-				}
-				includeInfo = append(includeInfo, j)
-				zw.excludedFiles[j.source] = false
-				verboseMsg = wski18n.T(wski18n.ID_VERBOSE_ZIP_INCLUDE_SOURCE_PATH_X_path_X_DESTINATION_PATH_X_dest_X,
-					map[string]interface{}{
-						wski18n.KEY_PATH:        j.source,
-						wski18n.KEY_DESTINATION: j.destination,
-					})
-				wskprint.PrintlnOpenWhiskVerbose(Flags.Verbose, verboseMsg)
-			}
-			// handle scenarios where included path is something similar to actions/common/utils.js
-			// and destination is set to ./common/ i.e. no file name specified in the destination
-		} else {
-			if f, err := isFile(i.source); err == nil && f {
-				if _, file := filepath.Split(i.destination); len(file) == 0 {
-					_, sFile := filepath.Split(i.source)
-					i.destination = i.destination + sFile
-				}
-			}
-			// append just parsed include info to the list for further processing
-			wskprint.PrintlnOpenWhiskVerbose(Flags.Verbose, wski18n.T(wski18n.ID_VERBOSE_LIST_OF_FILES_MATCHING_PATTERN))
-			verboseMsg = wski18n.T(wski18n.ID_VERBOSE_ZIP_INCLUDE_SOURCE_PATH_X_path_X_DESTINATION_PATH_X_dest_X,
-				map[string]interface{}{
-					wski18n.KEY_PATH:        i.source,
-					wski18n.KEY_DESTINATION: i.destination,
-				})
-			wskprint.PrintlnOpenWhiskVerbose(Flags.Verbose, verboseMsg)
-			includeInfo = append(includeInfo, i)
-			zw.excludedFiles[i.source] = false
+/*
+   addInclude adds the files of an include, i.e. ["source"] or ["source", "destination"]
+
+   Without a destination, the source has the same path in the archive as in the directory of the manifest.
+   The files matching a source with wildcards keep their path from the last directory before the wildcards,
+   under the destination or its directory if the destination names a file, e.g. ["actions/libs/*", "libs/"]
+   adds actions/libs/lib1/utils.js as libs/lib1/utils.js. A file is added with the name of the destination,
+   or under it if the destination ends with a "/", and the files of a directory are added under the destination.
+*/
+func (zw *ZipWritter) addInclude(include []string) error {
+	if len(include) == 0 || len(include) > 2 {
+		quoted := make([]string, len(include))
+		for index, d := range include {
+			quoted[index] = "\"" + d + "\""
 		}
+		wskprint.PrintlnOpenWhiskVerbose(Flags.Verbose, wski18n.T(wski18n.ID_VERBOSE_INVALID_INCLUDE_ENTRY,
+			map[string]interface{}{
+				wski18n.KEY_INCLUDE: strings.Join(quoted, ", "),
+			}))
+		return nil
+	}
+	source := filepath.Join(zw.manifestFilePath, include[0])
+	destination := filepath.ToSlash(include[len(include)-1])
+	reason := wski18n.T(wski18n.ID_MSG_ZIP_INCLUDED_X_include_X,
+		map[string]interface{}{
+			wski18n.KEY_INCLUDE: strings.Join(include, ", "),
+		})
+	if len(include) == 1 {
+		wskprint.PrintlnOpenWhiskVerbose(Flags.Verbose, wski18n.T(wski18n.ID_VERBOSE_ZIP_INCLUDE_SOURCE_PATH_X_path_X,
+			map[string]interface{}{
+				wski18n.KEY_PATH: include[0],
+			}))
+	} else {
+		wskprint.PrintlnOpenWhiskVerbose(Flags.Verbose, wski18n.T(wski18n.ID_VERBOSE_ZIP_INCLUDE_SOURCE_PATH_X_path_X_DESTINATION_PATH_X_dest_X,
+			map[string]interface{}{
+				wski18n.KEY_PATH:        include[0],
+				wski18n.KEY_DESTINATION: include[1],
+			}))
 	}
-	return includeInfo, nil
-}
 
-func (zw *ZipWritter) buildExcludeMetadata() error {
-	var err error
-	for _, exclude := range zw.exclude {
-		exclude = filepath.Join(zw.manifestFilePath, exclude)
-		if err = zw.findExcludedIncludedFiles(exclude, true); err != nil {
+	if !HasWildcard(include[0]) {
+		info, err := os.Stat(source)
+		if err != nil {
 			return err
 		}
+		if info.IsDir() {
+			return zw.addDir(source, destination, reason)
+		}
+		if !isFilePath(destination) {
+			destination = path.Join(destination, filepath.Base(source))
+		}
+		zw.addFile(destination, source, info, reason)
+		return nil
 	}
-	return err
-}
 
-func (zw *ZipWritter) findExcludedIncludedFiles(functionPath string, flag bool) error {
-	var err error
-	var files []string
-	var excludedFiles []string
-	var f bool
-
-	if !strings.HasSuffix(functionPath, PATH_WILDCARD) {
-		functionPath = filepath.Join(functionPath, PATH_WILDCARD)
+	destDir := destination
+	if isFilePath(destDir) {
+		destDir = path.Dir(destDir)
 	}
-	if excludedFiles, err = filepath.Glob(functionPath); err != nil {
+	destDir = strings.Split(destDir, PATH_WILDCARD)[0]
+	prefix := WildcardPrefix(source)
+	files, err := GlobPath(source)
+	if err != nil {
 		return err
 	}
-	for _, file := range excludedFiles {
-		err = filepath.Walk(file, func(path string, info os.FileInfo, err error) error {
-			files = append(files, path)
-			return nil
-		})
+	wskprint.PrintlnOpenWhiskVerbose(Flags.Verbose, wski18n.T(wski18n.ID_VERBOSE_LIST_OF_FILES_MATCHING_PATTERN))
+	for _, file := range files {
+		rel, err := filepath.Rel(prefix, file)
 		if err != nil {
 			return err
 		}
-	}
-	for _, file := range files {
-		if f, err = isFile(file); err != nil {
+		name := path.Join(destDir, filepath.ToSlash(rel))
+		wskprint.PrintlnOpenWhiskVerbose(Flags.Verbose, wski18n.T(wski18n.ID_VERBOSE_ZIP_INCLUDE_SOURCE_PATH_X_path_X_DESTINATION_PATH_X_dest_X,
+			map[string]interface{}{
+				wski18n.KEY_PATH:        file,
+				wski18n.KEY_DESTINATION: name,
+			}))
+		info, err := os.Stat(file)
+		if err != nil {
 			return err
-		} else if f {
-			zw.excludedFiles[file] = flag
+		}
+		if info.IsDir() {
+			err = zw.addDir(file, name, reason)
 		} else {
-			if err = zw.findExcludedIncludedFiles(file, flag); err != nil {
-				return err
-			}
+			zw.addFile(name, file, info, reason)
+		}
+		if err != nil {
+			return err
 		}
 	}
-	return err
+	return nil
 }
 
-func (zw *ZipWritter) Zip() error {
-
-	var zipFile *os.File
-	var err error
-	var fileInfo os.FileInfo
-	var verboseMsg string
+// writeEntries writes the entries sorted by name
+func (zw *ZipWritter) writeEntries(writer *zip.Writer) error {
+	names := make([]string, 0, len(zw.entries))
+	for name := range zw.entries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := writeEntry(writer, name, zw.entries[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-	// create zip file e.g. greeting.zip
-	if zipFile, err = os.Create(zw.des); err != nil {
+func writeEntry(writer *zip.Writer, name string, entry zipEntry) error {
+	file, err := os.Open(entry.path)
+	if err != nil {
 		return err
 	}
-	defer zipFile.Close()
+	defer file.Close()
+	wr, err := writer.CreateHeader(ZipFileHeader(name, entry.mode))
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(wr, file)
+	return err
+}
 
-	verboseMsg = wski18n.T(wski18n.ID_VERBOSE_CREATING_ZIP_FILE_X_path_X,
-		map[string]interface{}{
-			wski18n.KEY_PATH: zipFile.Name(),
-		})
-	wskprint.PrintlnOpenWhiskVerbose(Flags.Verbose, verboseMsg)
+// readIgnoreFiles reads the ignore files of the manifest directory and of the directory of the action
+func (zw *ZipWritter) readIgnoreFiles() error {
+	zw.ignores = nil
+	dirs := []string{zw.src}
+	if len(zw.manifestFilePath) != 0 && filepath.Clean(zw.manifestFilePath) != filepath.Clean(zw.src) {
+		dirs = []string{zw.manifestFilePath, zw.src}
+	}
+	for _, dir := range dirs {
+		ignore, err := ReadIgnoreFile(filepath.Join(dir, IGNORE_FILE_NAME))
+		if err != nil {
+			return err
+		}
+		if ignore != nil {
+			zw.ignores = append(zw.ignores, ignore)
+		}
+	}
+	return nil
+}
 
-	// creating a new zip writter for greeting.zip
-	zw.zipWritter = zip.NewWriter(zipFile)
+// Contents returns the files in the archive sorted by name, followed by the ones left out sorted by path
+func (zw *ZipWritter) Contents() []ZipContent {
+	contents := make([]ZipContent, 0, len(zw.entries)+len(zw.left))
+	for name, entry := range zw.entries {
+		contents = append(contents, ZipContent{Name: name, Path: entry.path, Reason: entry.reason})
+	}
+	sort.Slice(contents, func(i, j int) bool { return contents[i].Name < contents[j].Name })
+	packed := len(contents)
+	for file, reason := range zw.left {
+		contents = append(contents, ZipContent{Path: file, Reason: reason})
+	}
+	left := contents[packed:]
+	sort.Slice(left, func(i, j int) bool { return left[i].Path < left[j].Path })
+	return contents
+}
 
-	// build a map of file names and bool indicating whether the file is included or excluded
-	// iterate over the directory specified in "function", find the list of files and mark them as not excluded
-	if err = zw.findExcludedIncludedFiles(zw.src, false); err != nil {
+// ZipTo writes the archive of the action, reading its files from where they are
+func (zw *ZipWritter) ZipTo(w io.Writer) error {
+	// walk file system rooted at the directory specified in "function"
+	// e.g. function: actions/greeting adds actions/greeting/index.js as index.js,
+	// without the directory the archive only has the included files
+	if err := zw.readIgnoreFiles(); err != nil {
 		return err
 	}
-
-	if err = zw.buildExcludeMetadata(); err != nil {
+	reason := wski18n.T(wski18n.ID_MSG_ZIP_ACTION_DIRECTORY)
+	if err := zw.addDir(zw.src, "", reason); err != nil && !os.IsNotExist(err) {
 		return err
 	}
+	for _, include := range zw.include {
+		if err := zw.addInclude(include); err != nil {
+			return err
+		}
+	}
 
-	// walk file system rooted at the directory specified in "function"
-	// walk over each file and dir under root directory e.g. function: actions/greeting
-	// add actions/greeting/index.js and actions/greeting/package.json to zip file
-	if err = filepath.Walk(zw.src, zw.zipFile); err != nil {
-		return nil
+	writer := zip.NewWriter(w)
+	if err := zw.writeEntries(writer); err != nil {
+		return err
 	}
+	return writer.Close()
+}
 
-	// maintain a list of included files and/or directories with their destination
-	var includeInfo []Include
-	includeInfo, err = zw.buildIncludeMetadata()
+// Zip writes the archive of the action to its destination file
+func (zw *ZipWritter) Zip() error {
+	// create zip file e.g. greeting.zip
+	zipFile, err := os.Create(zw.des)
 	if err != nil {
 		return err
 	}
+	defer zipFile.Close()
 
-	for _, i := range includeInfo {
-		if i.source != i.destination {
-			// now determine whether the included item is file or dir
-			// it could list something like this as well, "actions/common/*.js"
-			if fileInfo, err = os.Stat(i.source); err != nil {
-				return err
-			}
+	wskprint.PrintlnOpenWhiskVerbose(Flags.Verbose, wski18n.T(wski18n.ID_VERBOSE_CREATING_ZIP_FILE_X_path_X,
+		map[string]interface{}{
+			wski18n.KEY_PATH: zipFile.Name(),
+		}))
+	return zw.ZipTo(zipFile)
+}
 
-			// if the included item is a directory, call a function to copy the
-			// entire directory recursively including its subdirectories and files
-			if fileInfo.Mode().IsDir() {
-				if err = copyDir(i.source, i.destination); err != nil {
-					return err
-				}
-				// if the included item is a file, call a function to copy the file
-				// along with its path by creating the parent directories
-			} else if fileInfo.Mode().IsRegular() {
-				if err = copyFile(i.source, i.destination); err != nil {
-					return err
-				}
-			}
+// ZipSize is the compressed size of a file of a zip file, or of the files of one of its directories
+type ZipSize struct {
+	Name string
+	Size int64
+}
+
+// ZipSizes returns the files and the directories of a zip file by decreasing compressed size
+func ZipSizes(archive string) ([]ZipSize, []ZipSize, error) {
+	reader, err := zip.OpenReader(archive)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer reader.Close()
+	var files []ZipSize
+	dirs := make(map[string]int64)
+	for _, file := range reader.File {
+		if file.FileInfo().IsDir() {
+			continue
 		}
-		// add included item into zip file greeting.zip
-		if err = filepath.Walk(i.destination, zw.zipFile); err != nil {
-			return nil
+		size := int64(file.CompressedSize64)
+		files = append(files, ZipSize{Name: file.Name, Size: size})
+		for dir := path.Dir(file.Name); dir != "." && dir != "/"; dir = path.Dir(dir) {
+			dirs[dir] += size
 		}
 	}
-
-	// now close the zip file greeting.zip as all the included items
-	// are added into the zip file along with the action root dir
-	if err = zw.zipWritter.Close(); err != nil {
-		return err
+	dirSizes := make([]ZipSize, 0, len(dirs))
+	for dir, size := range dirs {
+		dirSizes = append(dirSizes, ZipSize{Name: dir + "/", Size: size})
 	}
+	sortZipSizes(files)
+	sortZipSizes(dirSizes)
+	return files, dirSizes, nil
+}
 
-	// and its safe to delete the files/directories which we copied earlier
-	// to include them in the zip file greeting.zip
-	for _, i := range includeInfo {
-		if filepath.Clean(i.source) != filepath.Clean(i.destination) {
-			verboseMsg = wski18n.T(wski18n.ID_VERBOSE_DELETING_FILE_X_path_X,
-				map[string]interface{}{
-					wski18n.KEY_PATH: i.destination,
-				})
-			wskprint.PrintlnOpenWhiskVerbose(Flags.Verbose, verboseMsg)
-			os.RemoveAll(i.destination)
+func sortZipSizes(sizes []ZipSize) {
+	sort.Slice(sizes, func(i, j int) bool {
+		if sizes[i].Size != sizes[j].Size {
+			return sizes[i].Size > sizes[j].Size
 		}
-	}
-
-	return nil
+		return sizes[i].Name < sizes[j].Name
+	})
 }
@@ -0,0 +1,235 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"archive/zip"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func zipDir(t *testing.T, src string) []byte {
+	archive := filepath.Join(t.TempDir(), "action.zip")
+	assert.Nil(t, NewZipWritter(src, archive, nil, nil, "").Zip())
+	content, err := ioutil.ReadFile(archive)
+	assert.Nil(t, err)
+	return content
+}
+
+func TestZipWritter_Reproducible(t *testing.T) {
+	src := t.TempDir()
+	assert.Nil(t, os.MkdirAll(filepath.Join(src, "lib"), 0755))
+	files := map[string]os.FileMode{
+		"package.json": 0600,
+		"index.js":     0644,
+		"lib/utils.js": 0664,
+		"bin":          0700,
+	}
+	for name, mode := range files {
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(src, name), []byte(name), mode))
+		assert.Nil(t, os.Chmod(filepath.Join(src, name), mode))
+	}
+	first := zipDir(t, src)
+
+	later := time.Now().Add(time.Hour)
+	for name := range files {
+		assert.Nil(t, os.Chtimes(filepath.Join(src, name), later, later))
+	}
+	assert.Equal(t, first, zipDir(t, src), "the zip files of the same files must be identical")
+	assert.Equal(t, Digest(first), Digest(zipDir(t, src)))
+
+	reader, err := zip.NewReader(bytes.NewReader(first), int64(len(first)))
+	assert.Nil(t, err)
+	var names []string
+	for _, file := range reader.File {
+		names = append(names, file.Name)
+		assert.Equal(t, 0, len(file.Extra), "no extra fields")
+		assert.Equal(t, 1980, file.Modified.Year())
+		expected := os.FileMode(ZIP_FILE_MODE)
+		if file.Name == "bin" {
+			expected = ZIP_EXEC_MODE
+		}
+		assert.Equal(t, expected, file.Mode().Perm(), file.Name)
+	}
+	assert.Equal(t, []string{"bin", "index.js", "lib/utils.js", "package.json"}, names)
+}
+
+func TestDigest(t *testing.T) {
+	assert.Equal(t, "sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", Digest(nil))
+	assert.NotEqual(t, Digest([]byte("a")), Digest([]byte("b")))
+}
+
+func writeFiles(t *testing.T, root string, names ...string) {
+	for _, name := range names {
+		file := filepath.Join(root, filepath.FromSlash(name))
+		assert.Nil(t, os.MkdirAll(filepath.Dir(file), 0755))
+		assert.Nil(t, ioutil.WriteFile(file, []byte(name), 0644))
+	}
+}
+
+func listFiles(t *testing.T, root string) []string {
+	var names []string
+	assert.Nil(t, filepath.Walk(root, func(file string, info os.FileInfo, err error) error {
+		if err == nil && !info.IsDir() {
+			name, _ := filepath.Rel(root, file)
+			names = append(names, filepath.ToSlash(name))
+		}
+		return err
+	}))
+	return names
+}
+
+func zipEntries(t *testing.T, zw *ZipWritter) []string {
+	var buf bytes.Buffer
+	assert.Nil(t, zw.ZipTo(&buf))
+	reader, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	assert.Nil(t, err)
+	var names []string
+	for _, file := range reader.File {
+		names = append(names, file.Name)
+	}
+	return names
+}
+
+func TestZipWritter_IncludeExclude(t *testing.T) {
+	root := t.TempDir()
+	writeFiles(t, root,
+		"actions/greeting/index.js",
+		"actions/greeting/test/index_test.js",
+		"actions/libs/lib1/utils.js",
+		"actions/libs/lib1/utils.md",
+		"actions/libs/lib2/utils.js",
+		"common/utils.js",
+		"common/node_modules/dep/index.js",
+	)
+	before := listFiles(t, root)
+	src := filepath.Join(root, "actions", "greeting")
+
+	include := [][]string{
+		{"actions/libs/**/*.js", "libs/"},
+		{"common/utils.js", "common/"},
+		{"common", "vendor"},
+	}
+	exclude := []string{"actions/greeting/test", "**/node_modules"}
+	assert.Equal(t, []string{
+		"common/utils.js",
+		"index.js",
+		"libs/lib1/utils.js",
+		"libs/lib2/utils.js",
+		"vendor/utils.js",
+	}, zipEntries(t, NewZipWritter(src, "", include, exclude, root)))
+
+	// an explicit include overrides the excludes
+	include = [][]string{{"common/node_modules/dep/index.js", "dep.js"}}
+	assert.Equal(t, []string{"dep.js", "index.js"},
+		zipEntries(t, NewZipWritter(src, "", include, exclude, root)))
+
+	// a single file can be excluded
+	assert.Equal(t, []string{"test/index_test.js"},
+		zipEntries(t, NewZipWritter(src, "", nil, []string{"actions/greeting/index.js"}, root)))
+
+	assert.Nil(t, NewZipWritter(src, filepath.Join(t.TempDir(), "greeting.zip"), include, exclude, root).Zip())
+	assert.Equal(t, before, listFiles(t, root), "the source tree must not change")
+}
+
+func TestZipWritter_IgnoreFiles(t *testing.T) {
+	root := t.TempDir()
+	writeFiles(t, root,
+		"actions/greeting/index.js",
+		"actions/greeting/.DS_Store",
+		"actions/greeting/node_modules/dep/index.js",
+		"actions/greeting/node_modules/.cache/data",
+		"actions/greeting/test/index_test.js",
+		"actions/greeting/test/fixtures/data.json",
+		"actions/greeting/lib/debug.log",
+		"common/.DS_Store",
+		"common/utils.js",
+	)
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(root, IGNORE_FILE_NAME), []byte(".DS_Store\n**/node_modules/.cache/\n*.log\n"), 0644))
+	src := filepath.Join(root, "actions", "greeting")
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(src, IGNORE_FILE_NAME), []byte("test/*\n!test/index_test.js\n!debug.log\n"), 0644))
+
+	include := [][]string{{"common", "common"}, {"actions/greeting/.DS_Store", "DS_Store"}}
+	zw := NewZipWritter(src, "", include, []string{"**/dep"}, root)
+	assert.Equal(t, []string{
+		"DS_Store",
+		"common/utils.js",
+		"index.js",
+		"lib/debug.log",
+		"test/index_test.js",
+	}, zipEntries(t, zw))
+
+	reasons := make(map[string]string)
+	for _, content := range zw.Contents() {
+		rel, err := filepath.Rel(root, content.Path)
+		assert.Nil(t, err)
+		if len(content.Name) != 0 {
+			rel = content.Name
+		}
+		reasons[filepath.ToSlash(rel)] = content.Reason
+	}
+	assert.Equal(t, "included by [actions/greeting/.DS_Store, DS_Store]", reasons["DS_Store"])
+	assert.Equal(t, "in the directory of the action", reasons["index.js"])
+	assert.Equal(t, "kept by [!debug.log] at line 3 of [actions/greeting/.wskignore]", reasons["lib/debug.log"])
+	assert.Equal(t, "ignored by [test/*] at line 1 of [actions/greeting/.wskignore]", reasons["actions/greeting/test/fixtures"])
+	assert.Equal(t, "ignored by [.DS_Store] at line 1 of [.wskignore]", reasons["common/.DS_Store"])
+	assert.Equal(t, "ignored by [**/node_modules/.cache/] at line 2 of [.wskignore]", reasons["actions/greeting/node_modules/.cache"])
+	assert.Equal(t, "excluded by [**/dep] of the manifest", reasons["actions/greeting/node_modules/dep"])
+	assert.Equal(t, "ignore file", reasons["actions/greeting/.wskignore"])
+	assert.Equal(t, 10, len(reasons))
+}
+
+func TestZipSizes(t *testing.T) {
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+	for _, name := range []string{"index.js", "lib/utils.js", "node_modules/a/index.js", "node_modules/b/index.js"} {
+		wr, err := writer.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Store})
+		assert.Nil(t, err)
+		_, err = wr.Write(bytes.Repeat([]byte("x"), len(name)))
+		assert.Nil(t, err)
+	}
+	assert.Nil(t, writer.Close())
+
+	archive := filepath.Join(t.TempDir(), "action.zip")
+	assert.Nil(t, ioutil.WriteFile(archive, buf.Bytes(), 0644))
+
+	files, dirs, err := ZipSizes(archive)
+	assert.Nil(t, err)
+	assert.Equal(t, []ZipSize{
+		{"node_modules/a/index.js", 23},
+		{"node_modules/b/index.js", 23},
+		{"lib/utils.js", 12},
+		{"index.js", 8},
+	}, files)
+	assert.Equal(t, []ZipSize{
+		{"node_modules/", 46},
+		{"node_modules/a/", 23},
+		{"node_modules/b/", 23},
+		{"lib/", 12},
+	}, dirs)
+
+	assert.Nil(t, ioutil.WriteFile(archive, []byte("not a zip file"), 0644))
+	_, _, err = ZipSizes(archive)
+	assert.NotNil(t, err)
+}
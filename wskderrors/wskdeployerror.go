@@ -49,6 +49,11 @@ const (
 	STR_API                   = "API"
 	STR_API_METHOD            = "API gateway method"
 	STR_API_SUPPORTED_METHODS = "API gateway supported methods"
+	STR_EXPRESSION            = "Expression"
+	STR_VALUE                 = "Value"
+	STR_SUPPORTED_UNITS       = "Supported units"
+	STR_SOURCE                = "Source"
+	STR_JSON_POINTER          = "JSON pointer"
 
 	// Formatting
 	STR_INDENT_1 = "==>"
@@ -69,6 +74,10 @@ const (
 	ERROR_YAML_INVALID_API_GATEWAY_METHOD = "ERROR_YAML_INVALID_API_GATEWAY_METHOD"
 	ERROR_RUNTIME_PARSER_FAILURE          = "ERROR_RUNTIME_PARSER_FAILURE"
 	ERROR_ACTION_ANNOTATION               = "ERROR_ACTION_ANNOTATION"
+	ERROR_INTERPOLATION_FAILURE           = "ERROR_INTERPOLATION_FAILURE"
+	ERROR_YAML_INVALID_SCALAR_UNIT        = "ERROR_YAML_INVALID_SCALAR_UNIT"
+	ERROR_YAML_INVALID_PARAMETER_VALUE    = "ERROR_YAML_INVALID_PARAMETER_VALUE"
+	ERROR_JSON_SCHEMA_VALIDATION          = "ERROR_JSON_SCHEMA_VALIDATION"
 )
 
 /*
@@ -357,6 +366,99 @@ func NewInvalidParameterTypeError(fpath string, param string, actualType string)
 	return err
 }
 
+/*
+ * InvalidScalarUnit
+ */
+type InvalidScalarUnitError struct {
+	FileError
+	Parameter      string
+	Value          string
+	Type           string
+	SupportedUnits []string
+}
+
+func NewInvalidScalarUnitError(fpath string, param string, value string, typ string, supportedUnits []string) *InvalidScalarUnitError {
+	var err = &InvalidScalarUnitError{
+		Parameter:      param,
+		Value:          value,
+		Type:           typ,
+		SupportedUnits: supportedUnits,
+	}
+	err.SetErrorFilePath(fpath)
+	err.SetErrorType(ERROR_YAML_INVALID_SCALAR_UNIT)
+	err.SetCallerByStackFrameSkip(2)
+	err.SetMessageFormat("%s [%s]: %s [%s]: %s [%s]: %s [%s]")
+	str := fmt.Sprintf(err.MessageFormat,
+		STR_PARAMETER, param,
+		STR_VALUE, value,
+		STR_TYPE, typ,
+		STR_SUPPORTED_UNITS, strings.Join(supportedUnits, ", "))
+	err.SetMessage(str)
+	return err
+}
+
+/*
+ * InvalidParameterValue
+ */
+type InvalidParameterValueError struct {
+	FileError
+	Parameter string
+	Value     string
+	Type      string
+	Source    string
+}
+
+func NewInvalidParameterValueError(fpath string, param string, value string, typ string, source string, msg string) *InvalidParameterValueError {
+	var err = &InvalidParameterValueError{
+		Parameter: param,
+		Value:     value,
+		Type:      typ,
+		Source:    source,
+	}
+	err.SetErrorFilePath(fpath)
+	err.SetErrorType(ERROR_YAML_INVALID_PARAMETER_VALUE)
+	err.SetCallerByStackFrameSkip(2)
+	err.SetMessageFormat("%s [%s]: %s [%s]: %s [%s]: %s [%s]: %s")
+	str := fmt.Sprintf(err.MessageFormat,
+		STR_PARAMETER, param,
+		STR_VALUE, value,
+		STR_TYPE, typ,
+		STR_SOURCE, source,
+		msg)
+	err.SetMessage(str)
+	return err
+}
+
+/*
+ * JSONSchemaValidation
+ */
+type JSONSchemaValidationError struct {
+	FileError
+	Parameter string
+	Pointers  []string
+}
+
+// pointers and msgs are the JSON pointers of the violations and their messages
+func NewJSONSchemaValidationError(fpath string, param string, pointers []string, msgs []string) *JSONSchemaValidationError {
+	var err = &JSONSchemaValidationError{
+		Parameter: param,
+		Pointers:  pointers,
+	}
+	err.SetErrorFilePath(fpath)
+	err.SetErrorType(ERROR_JSON_SCHEMA_VALIDATION)
+	err.SetCallerByStackFrameSkip(2)
+	err.SetMessageFormat("%s [%s]: %s [%s]: %s")
+	violations := make([]string, 0, len(pointers))
+	for i, pointer := range pointers {
+		violations = append(violations, fmt.Sprintf(err.MessageFormat,
+			STR_PARAMETER, param,
+			STR_JSON_POINTER, pointer,
+			msgs[i]))
+	}
+	err.SetMessage(strings.Join(violations, STR_NEWLINE))
+	return err
+}
+
 /*
  * YAMLParserErr
  */
@@ -506,6 +608,26 @@ func NewActionSecureKeyError(errorMsg string) *DeployError {
 	return err
 }
 
+/*
+ * Failed to interpolate an expression such as ${VAR:?message}
+ */
+type InterpolationError struct {
+	WskDeployBaseErr
+	Expression string
+}
+
+func NewInterpolationError(expression string, errorMessage string) *InterpolationError {
+	var err = &InterpolationError{
+		Expression: expression,
+	}
+	err.SetErrorType(ERROR_INTERPOLATION_FAILURE)
+	err.SetCallerByStackFrameSkip(2)
+	err.SetMessageFormat("%s: [%s]: %s")
+	str := fmt.Sprintf(err.MessageFormat, STR_EXPRESSION, expression, errorMessage)
+	err.SetMessage(str)
+	return err
+}
+
 func IsCustomError(err error) bool {
 
 	switch err.(type) {
@@ -519,6 +641,10 @@ func IsCustomError(err error) bool {
 	case *YAMLFileFormatError:
 	case *ParameterTypeMismatchError:
 	case *InvalidParameterTypeError:
+	case *InterpolationError:
+	case *InvalidScalarUnitError:
+	case *InvalidParameterValueError:
+	case *JSONSchemaValidationError:
 	case *YAMLParserError:
 		return true
 	}
@@ -0,0 +1,189 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package wskenv
+
+import (
+	"io/ioutil"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/sciabarracom/openwhisk-wskdeploy/wskderrors"
+	"github.com/sciabarracom/openwhisk-wskdeploy/wski18n"
+)
+
+// DOTENV_FILE is loaded from the project directory, its variables are used
+// for interpolation when they are not set in the environment
+const (
+	DOTENV_FILE   = ".env"
+	DOTENV_EXPORT = "export "
+)
+
+var dotenvNameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.]*$`)
+
+// variables of the loaded .env file, the environment takes precedence over them
+var dotenv = struct {
+	sync.Mutex
+	path string
+	vars map[string]string
+}{vars: map[string]string{}}
+
+/*
+   ParseDotenv reads KEY=VALUE lines:
+
+   - blank lines and lines starting with # are ignored
+   - an optional "export " prefix is dropped
+   - values in single quotes are taken literally
+   - values in double quotes support the \n, \r, \t, \" and \\ escapes
+   - unquoted values are trimmed and end at a " #" comment
+*/
+func ParseDotenv(path string, content []byte) (map[string]string, error) {
+	vars := make(map[string]string)
+	text := strings.TrimPrefix(string(content), "\ufeff")
+	for n, line := range strings.Split(strings.Replace(text, "\r\n", "\n", -1), "\n") {
+		line = strings.TrimSpace(line)
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimPrefix(line, DOTENV_EXPORT)
+		index := strings.Index(line, "=")
+		if index < 0 {
+			return nil, invalidDotenvLine(path, n+1)
+		}
+		name := strings.TrimSpace(line[:index])
+		if !dotenvNameRegex.MatchString(name) {
+			return nil, invalidDotenvLine(path, n+1)
+		}
+		value, ok := dotenvValue(strings.TrimSpace(line[index+1:]))
+		if !ok {
+			return nil, invalidDotenvLine(path, n+1)
+		}
+		vars[name] = value
+	}
+	return vars, nil
+}
+
+func dotenvValue(value string) (string, bool) {
+	if len(value) == 0 {
+		return value, true
+	}
+	switch value[0] {
+	case '\'':
+		end := strings.Index(value[1:], "'")
+		if end < 0 {
+			return "", false
+		}
+		return value[1 : end+1], true
+	case '"':
+		var sb strings.Builder
+		for i := 1; i < len(value); i++ {
+			c := value[i]
+			if c == '"' {
+				return sb.String(), true
+			}
+			if c == '\\' && i+1 < len(value) {
+				i++
+				switch value[i] {
+				case 'n':
+					c = '\n'
+				case 'r':
+					c = '\r'
+				case 't':
+					c = '\t'
+				default:
+					c = value[i]
+				}
+			}
+			sb.WriteByte(c)
+		}
+		return "", false
+	}
+	if index := strings.Index(value, " #"); index >= 0 {
+		value = strings.TrimSpace(value[:index])
+	}
+	return value, true
+}
+
+func invalidDotenvLine(path string, line int) error {
+	return wskderrors.NewFileReadError(path,
+		wski18n.T(wski18n.ID_ERR_DOTENV_INVALID_LINE_X_path_X_line_X,
+			map[string]interface{}{
+				wski18n.KEY_PATH: path,
+				wski18n.KEY_LINE: line}))
+}
+
+// LoadDotenv makes the variables of a .env file available to the interpolation
+// of expressions, a missing file is not an error. It returns whether the file was loaded.
+func LoadDotenv(path string) (bool, error) {
+	content, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return false, nil
+	} else if err != nil {
+		return false, wskderrors.NewFileReadError(path, err.Error())
+	}
+	vars, err := ParseDotenv(path, content)
+	if err != nil {
+		return false, err
+	}
+	dotenv.Lock()
+	defer dotenv.Unlock()
+	dotenv.path = path
+	dotenv.vars = vars
+	return true, nil
+}
+
+// ResetDotenv forgets the variables of the loaded .env file
+func ResetDotenv() {
+	dotenv.Lock()
+	defer dotenv.Unlock()
+	dotenv.path = ""
+	dotenv.vars = map[string]string{}
+}
+
+// LookupEnv returns the value of a variable from the environment or, when it
+// is not set there, from the loaded .env file
+func LookupEnv(name string) (string, bool) {
+	if v, ok := os.LookupEnv(name); ok {
+		return v, true
+	}
+	dotenv.Lock()
+	defer dotenv.Unlock()
+	v, ok := dotenv.vars[name]
+	return v, ok
+}
+
+// Getenv is LookupEnv ignoring whether the variable is set
+func Getenv(name string) string {
+	v, _ := LookupEnv(name)
+	return v
+}
+
+// DotenvSource returns the path of the .env file which provides the variable,
+// or an empty string when the variable is set in the environment or not at all
+func DotenvSource(name string) string {
+	if _, ok := os.LookupEnv(name); ok {
+		return ""
+	}
+	dotenv.Lock()
+	defer dotenv.Unlock()
+	if _, ok := dotenv.vars[name]; ok {
+		return dotenv.path
+	}
+	return ""
+}
@@ -0,0 +1,81 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package wskenv
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDotenv(t *testing.T) {
+	content := `# comment
+export NAME=Amy
+PLACE = Paris # the capital
+EMPTY=
+SINGLE='a $literal # value'
+DOUBLE="two\nlines \"quoted\""
+`
+	vars, err := ParseDotenv(".env", []byte(content))
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{
+		"NAME":   "Amy",
+		"PLACE":  "Paris",
+		"EMPTY":  "",
+		"SINGLE": "a $literal # value",
+		"DOUBLE": "two\nlines \"quoted\"",
+	}, vars)
+
+	for _, invalid := range []string{"NAME", "1NAME=x", "NAME='unterminated", "NAME=\"unterminated"} {
+		_, err = ParseDotenv(".env", []byte("A=b\n"+invalid))
+		assert.NotNil(t, err, invalid)
+		assert.Contains(t, err.Error(), "line 2", invalid)
+	}
+}
+
+func TestLoadDotenv(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "dotenv")
+	defer os.RemoveAll(dir)
+	defer ResetDotenv()
+
+	loaded, err := LoadDotenv(filepath.Join(dir, DOTENV_FILE))
+	assert.Nil(t, err)
+	assert.False(t, loaded, "a missing .env file should be ignored")
+
+	path := filepath.Join(dir, DOTENV_FILE)
+	ioutil.WriteFile(path, []byte("DOTENV_ONLY=from-file\nDOTENV_BOTH=from-file\n"), 0644)
+	os.Setenv("DOTENV_BOTH", "from-env")
+	defer os.Unsetenv("DOTENV_BOTH")
+
+	loaded, err = LoadDotenv(path)
+	assert.Nil(t, err)
+	assert.True(t, loaded)
+
+	v, err := InterpolateString("${DOTENV_ONLY}-${DOTENV_BOTH}", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "from-file-from-env", v, "the environment should take precedence over the .env file")
+	assert.Equal(t, path, DotenvSource("DOTENV_ONLY"))
+	assert.Equal(t, "", DotenvSource("DOTENV_BOTH"))
+
+	ResetDotenv()
+	_, ok := LookupEnv("DOTENV_ONLY")
+	assert.False(t, ok)
+}
@@ -14,17 +14,52 @@
  * See the License for the specific language governing permissions and
  * limitations under the License.
  */
-
 package wskenv
 
 import (
-	"os"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os/exec"
+	"path/filepath"
 	"reflect"
 	"strings"
-	/** Fixes #797
+	"sync"
+
+	"github.com/sciabarracom/openwhisk-wskdeploy/utils"
+	"github.com/sciabarracom/openwhisk-wskdeploy/wskderrors"
 	"github.com/sciabarracom/openwhisk-wskdeploy/wski18n"
 	"github.com/sciabarracom/openwhisk-wskdeploy/wskprint"
-	**/)
+)
+
+// Expression prefixes and operators understood inside ${...}
+const (
+	EXPR_INPUTS_PREFIX     = "inputs."
+	EXPR_PROJECT_NAME      = "project.name"
+	EXPR_GIT_COMMIT        = "git.commit"
+	EXPR_FILE_PREFIX       = "file:"
+	EXPR_BASE64FILE_PREFIX = "base64file:"
+	EXPR_OPERATOR_DEFAULT  = ":-"
+	EXPR_OPERATOR_REQUIRED = ":?"
+	EXPR_DOLLAR            = '$'
+	EXPR_OPEN_BRACE        = '{'
+	EXPR_CLOSE_BRACE       = '}'
+	EXPR_ESCAPED_DOLLAR    = "$$"
+)
+
+// Context holds the values that expressions other than plain environment
+// variables are resolved against, i.e. ${inputs.x}, ${project.name},
+// ${git.commit} and relative paths in ${file:path} and ${base64file:path}.
+type Context struct {
+	ProjectName string
+	BaseDir     string
+	Inputs      map[string]interface{}
+}
+
+// git commit is resolved once per directory
+var gitCommits = make(map[string]string)
+var gitCommitsLock sync.Mutex
 
 // Test if a string
 func isValidEnvironmentVar(value string) bool {
@@ -44,9 +79,275 @@ func isValidEnvironmentVar(value string) bool {
 	return false
 }
 
+// Interpolate replaces all the expressions found in a string value:
+//
+//	$VAR, ${VAR}           value of the env. variable VAR
+//	${VAR:-default}        value of VAR, or default (itself interpolated) when VAR is unset or empty
+//	${VAR:?message}        value of VAR, fails with message when VAR is unset or empty
+//	${inputs.name}         value of the input "name" from the context
+//	${project.name}        name of the project being deployed
+//	${git.commit}          commit hash of HEAD in the project directory
+//	${file:path}           content of the file, relative paths are resolved against the project directory
+//	${base64file:path}     base64 encoded content of the file
+//	$$                     a literal '$'
+//
+// Values other than strings are returned unchanged. A string made of a single
+// ${inputs.name} expression evaluates to the input value preserving its type.
+// When strict mode is enabled, unset variables and undefined inputs are errors,
+// otherwise they are reported as warnings and replaced with an empty string.
+func Interpolate(value interface{}, ctx *Context) (interface{}, error) {
+	str, ok := value.(string)
+	if !ok {
+		return value, nil
+	}
+	if ctx == nil {
+		ctx = &Context{}
+	}
+	if strings.HasPrefix(str, "${"+EXPR_INPUTS_PREFIX) && strings.Index(str, "}") == len(str)-1 {
+		name := strings.TrimPrefix(str[2:len(str)-1], EXPR_INPUTS_PREFIX)
+		if v, ok := ctx.Inputs[name]; ok && v != nil {
+			return v, nil
+		}
+	}
+	return InterpolateString(str, ctx)
+}
+
+// InterpolateString is Interpolate for string values
+func InterpolateString(value string, ctx *Context) (string, error) {
+	if ctx == nil {
+		ctx = &Context{}
+	}
+	i := interpolator{ctx: ctx, strict: utils.Flags.Strict, warn: true}
+	return i.interpolate(value)
+}
+
+type interpolator struct {
+	ctx    *Context
+	strict bool
+	warn   bool
+}
+
+func (i *interpolator) interpolate(value string) (string, error) {
+	// unbalanced braces are left alone unless strict mode reports them
+	if !isValidEnvironmentVar(value) && !(i.strict && strings.Contains(value, "${")) {
+		return value, nil
+	}
+
+	var result strings.Builder
+	for pos := 0; pos < len(value); {
+		c := value[pos]
+		if c != EXPR_DOLLAR {
+			result.WriteByte(c)
+			pos++
+			continue
+		}
+
+		// $$ is an escaped dollar
+		if strings.HasPrefix(value[pos:], EXPR_ESCAPED_DOLLAR) {
+			result.WriteByte(EXPR_DOLLAR)
+			pos += len(EXPR_ESCAPED_DOLLAR)
+			continue
+		}
+
+		// ${expression}
+		if pos+1 < len(value) && value[pos+1] == EXPR_OPEN_BRACE {
+			end := matchingBrace(value, pos+1)
+			if end < 0 {
+				if i.strict {
+					return value, wskderrors.NewInterpolationError(value,
+						wski18n.T(wski18n.ID_ERR_INTERPOLATION_UNTERMINATED_X_value_X,
+							map[string]interface{}{wski18n.KEY_VALUE: value[pos:]}))
+				}
+				result.WriteString(value[pos:])
+				break
+			}
+			v, err := i.evaluate(value[pos+2 : end])
+			if err != nil {
+				return value, err
+			}
+			result.WriteString(v)
+			pos = end + 1
+			continue
+		}
+
+		// $VAR extends up to the next '$', '{' or '}'
+		end := pos + 1
+		for end < len(value) && !strings.ContainsRune("${}", rune(value[end])) {
+			end++
+		}
+		if end == pos+1 {
+			result.WriteByte(c)
+			pos++
+			continue
+		}
+		v, err := i.variable(value[pos+1 : end])
+		if err != nil {
+			return value, err
+		}
+		result.WriteString(v)
+		pos = end
+	}
+	return result.String(), nil
+}
+
+// returns the index of the '}' closing the '{' at position open, -1 if none
+func matchingBrace(value string, open int) int {
+	depth := 0
+	for pos := open; pos < len(value); pos++ {
+		switch value[pos] {
+		case EXPR_OPEN_BRACE:
+			depth++
+		case EXPR_CLOSE_BRACE:
+			depth--
+			if depth == 0 {
+				return pos
+			}
+		}
+	}
+	return -1
+}
+
+func (i *interpolator) evaluate(expr string) (string, error) {
+	switch {
+	case len(strings.TrimSpace(expr)) == 0:
+		// an empty expression is kept as is
+		if i.strict {
+			return "", wskderrors.NewInterpolationError("${"+expr+"}",
+				wski18n.T(wski18n.ID_ERR_INTERPOLATION_INVALID_X_value_X,
+					map[string]interface{}{wski18n.KEY_VALUE: "${" + expr + "}"}))
+		}
+		return "${" + expr + "}", nil
+	case expr == EXPR_PROJECT_NAME:
+		return i.ctx.ProjectName, nil
+	case expr == EXPR_GIT_COMMIT:
+		return gitCommit(i.ctx.BaseDir)
+	case strings.HasPrefix(expr, EXPR_INPUTS_PREFIX):
+		name := strings.TrimPrefix(expr, EXPR_INPUTS_PREFIX)
+		if v, ok := i.ctx.Inputs[name]; ok && v != nil {
+			return stringValue(v), nil
+		}
+		return "", i.missing(expr)
+	case strings.HasPrefix(expr, EXPR_FILE_PREFIX):
+		content, err := i.readFile(strings.TrimPrefix(expr, EXPR_FILE_PREFIX))
+		return strings.TrimRight(string(content), "\r\n"), err
+	case strings.HasPrefix(expr, EXPR_BASE64FILE_PREFIX):
+		content, err := i.readFile(strings.TrimPrefix(expr, EXPR_BASE64FILE_PREFIX))
+		return base64.StdEncoding.EncodeToString(content), err
+	}
+
+	// the first operator splits the expression, the other one may appear in the default or the message
+	index := strings.Index(expr, EXPR_OPERATOR_DEFAULT)
+	required := strings.Index(expr, EXPR_OPERATOR_REQUIRED)
+	if required >= 0 && (index < 0 || required < index) {
+		index = -1
+	}
+
+	if index >= 0 {
+		if v := Getenv(expr[:index]); len(v) != 0 {
+			return v, nil
+		}
+		return i.interpolateNested(expr[index+len(EXPR_OPERATOR_DEFAULT):])
+	}
+
+	if index := required; index >= 0 {
+		name := expr[:index]
+		if v := Getenv(name); len(v) != 0 {
+			return v, nil
+		}
+		message := expr[index+len(EXPR_OPERATOR_REQUIRED):]
+		if len(message) == 0 {
+			message = wski18n.T(wski18n.ID_ERR_INTERPOLATION_VARIABLE_NOT_SET_X_name_X,
+				map[string]interface{}{wski18n.KEY_NAME: name})
+		}
+		return "", wskderrors.NewInterpolationError("${"+expr+"}", message)
+	}
+
+	return i.variable(expr)
+}
+
+// a default value may itself contain expressions, including a lone "$"
+func (i *interpolator) interpolateNested(value string) (string, error) {
+	if !isValidEnvironmentVar(value) {
+		return value, nil
+	}
+	return i.interpolate(value)
+}
+
+func (i *interpolator) variable(name string) (string, error) {
+	if v, ok := LookupEnv(name); ok {
+		return v, nil
+	}
+	return "", i.missing(name)
+}
+
+func (i *interpolator) missing(name string) error {
+	if i.strict {
+		return wskderrors.NewInterpolationError(name,
+			wski18n.T(wski18n.ID_ERR_INTERPOLATION_VARIABLE_NOT_SET_X_name_X,
+				map[string]interface{}{wski18n.KEY_NAME: name}))
+	}
+	if i.warn {
+		wskprint.PrintlnOpenWhiskWarning(wski18n.T(wski18n.ID_WARN_MISSING_ENVIRONMENT_VARIABLE,
+			map[string]interface{}{wski18n.KEY_VALUE: name}))
+	}
+	return nil
+}
+
+func (i *interpolator) readFile(path string) ([]byte, error) {
+	path = strings.TrimSpace(path)
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(i.ctx.BaseDir, path)
+	}
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, wskderrors.NewInterpolationError(path,
+			wski18n.T(wski18n.ID_ERR_INTERPOLATION_FILE_READ_X_path_X_err_X,
+				map[string]interface{}{
+					wski18n.KEY_PATH: path,
+					wski18n.KEY_ERR:  err.Error()}))
+	}
+	return content, nil
+}
+
+// inputs of type json are rendered as JSON text, everything else as is
+func stringValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case map[string]interface{}, []interface{}:
+		if data, err := json.Marshal(v); err == nil {
+			return string(data)
+		}
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+func gitCommit(dir string) (string, error) {
+	gitCommitsLock.Lock()
+	defer gitCommitsLock.Unlock()
+
+	if commit, ok := gitCommits[dir]; ok {
+		return commit, nil
+	}
+	cmd := exec.Command("git", "rev-parse", "HEAD")
+	cmd.Dir = dir
+	out, err := cmd.Output()
+	if err != nil {
+		return "", wskderrors.NewInterpolationError("${"+EXPR_GIT_COMMIT+"}",
+			wski18n.T(wski18n.ID_ERR_INTERPOLATION_GIT_COMMIT_X_path_X_err_X,
+				map[string]interface{}{
+					wski18n.KEY_PATH: dir,
+					wski18n.KEY_ERR:  err.Error()}))
+	}
+	gitCommits[dir] = strings.TrimSpace(string(out))
+	return gitCommits[dir], nil
+}
+
 // Get the env variable value by key.
 // Get the env variable if the key is start by $
 // Replace all occurrences of env. variables in the input string
+// Unset variables are silently replaced with an empty string, use Interpolate
+// to honour strict mode and resolve inputs, project and file references.
 func InterpolateStringWithEnvVar(key interface{}) interface{} {
 	// Assure the key itself is not nil
 	if key == nil {
@@ -55,63 +356,13 @@ func InterpolateStringWithEnvVar(key interface{}) interface{} {
 
 	if reflect.TypeOf(key).String() == "string" {
 		keystr := key.(string)
-		if isValidEnvironmentVar(keystr) {
-			// retrieve the value of the env. var. from the host system.
-			var thisValue string
-			//split the string with ${}
-			//test if the substr is a environment var
-			//if it is, replace it with the value
-			f := func(c rune) bool {
-				return c == '$' || c == '{' || c == '}'
-			}
-			for _, substr := range strings.FieldsFunc(keystr, f) {
-				//if the substr is a $${ENV_VAR}
-				// return ${ENV_VAR}
-				if strings.Contains(keystr, "$${"+substr+"}") {
-					keystr = strings.Replace(keystr, "$${"+substr+"}", "${"+substr+"}", -1)
-					//if the substr is a $ENV_VAR
-					// return interpolated string using env. variable
-				} else if strings.Contains(keystr, "$"+substr) {
-					thisValue = os.Getenv(substr)
-					// TODO(920) disabling the warning for now, since we want to get rid of it eventually
-					// TODO(920) please add/remove this based on what we decide in issue #920
-
-					/** Fixes #797
-					if thisValue == "" {
-						warningString := wski18n.T(
-							wski18n.ID_WARN_MISSING_ENVIRONMENT_VARIABLE,
-							map[string]interface{}{
-								wski18n.KEY_VALUE: keystr})
-						wskprint.PrintOpenWhiskWarning(warningString)
-					}
-					**/
-
-					keystr = strings.Replace(keystr, "$"+substr, thisValue, -1)
-					//if the substr is a ${ENV_VAR}
-					// return interpolated string using env. variable
-				} else if strings.Contains(keystr, "${"+substr+"}") {
-					thisValue = os.Getenv(substr)
-					// TODO(920) disabling the warning for now, since we want to get rid of it eventually
-					// TODO(920) please add/remove this based on what we decide in issue #920
-
-					/** Fixes #797
-					if thisValue == "" {
-						warningString := wski18n.T(
-							wski18n.ID_WARN_MISSING_ENVIRONMENT_VARIABLE,
-							map[string]interface{}{
-								wski18n.KEY_VALUE: keystr})
-						wskprint.PrintOpenWhiskWarning(warningString)
-					}
-					**/
-
-					keystr = strings.Replace(keystr, "${"+substr+"}", thisValue, -1)
-				}
-			}
+		i := interpolator{ctx: &Context{}}
+		value, err := i.interpolate(keystr)
+		if err != nil {
+			wskprint.PrintOpenWhiskWarning(err.Error())
 			return keystr
 		}
-
-		// The key was not a valid env. variable, simply return it as the value itself (of type string)
-		return keystr
+		return value
 	}
 	return key
 }
@@ -18,10 +18,14 @@
 package wskenv
 
 import (
+	"encoding/base64"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/sciabarracom/openwhisk-wskdeploy/utils"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -45,3 +49,87 @@ func TestInterpolateStringWithEnvVar(t *testing.T) {
 	assert.Equal(t, "ddd..aaa", InterpolateStringWithEnvVar("ddd.${WithDollarAgain}.aaa"), "String concatenation fail")
 	assert.Equal(t, "oh, dollars!NO dollar.NO dollar", InterpolateStringWithEnvVar("${WithDollar}${NoDollar}.${NoDollar}"), "String concatenation fail")
 }
+
+func TestInterpolateDefaultAndRequired(t *testing.T) {
+	os.Setenv("WithDollar", "oh, dollars!")
+	os.Unsetenv("UnsetVar")
+
+	v, err := InterpolateString("${UnsetVar:-fallback}", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "fallback", v, "Default value should be used for unset variable.")
+
+	v, err = InterpolateString("${WithDollar:-fallback}", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "oh, dollars!", v, "Default value should not be used for set variable.")
+
+	v, err = InterpolateString("${UnsetVar:-${WithDollar}}.aaa", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "oh, dollars!.aaa", v, "Default value should be interpolated.")
+
+	_, err = InterpolateString("${UnsetVar:?UnsetVar is needed}", nil)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "UnsetVar is needed")
+
+	// the first operator splits the expression
+	_, err = InterpolateString("${UnsetVar:?use :- to set a default}", nil)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "use :- to set a default")
+
+	v, err = InterpolateString("${UnsetVar:-what:?}", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "what:?", v)
+
+	v, err = InterpolateString("$${WithDollar} costs $$5", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "${WithDollar} costs $5", v, "Escaped dollar should be preserved.")
+}
+
+func TestInterpolateContext(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "wskenv")
+	defer os.RemoveAll(dir)
+	ioutil.WriteFile(filepath.Join(dir, "secret.txt"), []byte("s3cr3t\n"), 0644)
+
+	ctx := &Context{
+		ProjectName: "helloworld",
+		BaseDir:     dir,
+		Inputs:      map[string]interface{}{"dbName": "users", "port": 5984},
+	}
+
+	v, err := InterpolateString("${project.name}-${inputs.dbName}", ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, "helloworld-users", v)
+
+	i, err := Interpolate("${inputs.port}", ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 5984, i, "A single input reference should preserve the input type.")
+
+	v, err = InterpolateString("${file:secret.txt}", ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, "s3cr3t", v)
+
+	v, err = InterpolateString("${base64file:secret.txt}", ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, base64.StdEncoding.EncodeToString([]byte("s3cr3t\n")), v)
+
+	_, err = InterpolateString("${file:missing.txt}", ctx)
+	assert.NotNil(t, err)
+}
+
+func TestInterpolateStrict(t *testing.T) {
+	os.Unsetenv("UnsetVar")
+	utils.Flags.Strict = true
+	defer func() { utils.Flags.Strict = false }()
+
+	_, err := InterpolateString("${UnsetVar}", nil)
+	assert.NotNil(t, err, "Unset variable should fail in strict mode.")
+	_, err = InterpolateString("${inputs.undefined}", nil)
+	assert.NotNil(t, err, "Undefined input should fail in strict mode.")
+	_, err = InterpolateString("prefix-${UnsetVar", nil)
+	assert.NotNil(t, err, "Unterminated expression should fail in strict mode.")
+
+	v, err := InterpolateString("${UnsetVar:-fallback}", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "fallback", v)
+
+	assert.Equal(t, "", InterpolateStringWithEnvVar("${UnsetVar}"), "InterpolateStringWithEnvVar should not fail.")
+}
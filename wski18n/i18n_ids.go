@@ -32,14 +32,17 @@ const (
 	BINDING            = "binding"
 	CLI_FLAGS          = "CLI Flags"
 	CMD_DEPLOY         = "deploy"
+	CMD_RUNTIMES       = "runtimes"
 	CMD_UNDEPLOY       = "undeploy"
 	COMMAND_LINE       = "command line"
+	COMPONENTS         = "components"
 	CONFIGURATION      = "Configuration"
 	DEPLOYMENT_FILE    = "deployment file"
 	FEATURE_DISABLED   = "disabled"
 	FEATURE_ENABLED    = "enabled"
 	MANIFEST_FILE      = "manifest file"
 	NAME_PROJECT       = "project name"
+	NEXT_FIRE_TIMES    = "next fire times"
 	PACKAGE_BINDING    = "package binding"
 	PACKAGE_LICENSE    = "package license"
 	PACKAGE_VERSION    = "package version"
@@ -59,41 +62,57 @@ const (
 	KEY_BINDINGS          = "bindings"
 	KEY_CMD               = "cmd"
 	KEY_CODE              = "code"
+	KEY_COMBINATOR        = "combinator"
+	KEY_COMPOSITION       = "composition"
+	KEY_COUNT             = "count"
 	KEY_DEPENDENCY        = "dependency"
 	KEY_DEPLOYMENT_NAME   = "dname"
 	KEY_DEPLOYMENT_PATH   = "dpath"
 	KEY_DESTINATION       = "destination"
+	KEY_DIGEST            = "digest"
 	KEY_DUMMY_TOKEN       = "dummytoken"
 	KEY_ERR               = "err"
+	KEY_EXCLUDE           = "exclude"
 	KEY_EXTENSION         = "ext"
+	KEY_FETCHED           = "fetched"
 	KEY_FILE_TYPE         = "filetype"
 	KEY_HOST              = "host"
+	KEY_IMAGE             = "image"
 	KEY_INCLUDE           = "include"
 	KEY_INPUTS            = "inputs"
 	KEY_KEY               = "key"
 	KEY_LIMIT             = "limit"
+	KEY_LINE              = "line"
 	KEY_LOCATION          = "location"
+	KEY_LOG               = "log"
 	KEY_MANIFEST_NAME     = "mname"
 	KEY_MANIFEST_PATH     = "mpath"
 	KEY_NAME              = "name"
 	KEY_NAMESPACE         = "namespace"
 	KEY_NEW               = "newkey"
 	KEY_OLD               = "oldkey"
+	KEY_OTHER             = "other"
 	KEY_PACKAGE           = "package"
 	KEY_PATH              = "path"
+	KEY_PATTERN           = "pattern"
 	KEY_PROJECT           = "project"
+	KEY_PROVIDER          = "provider"
+	KEY_PROVIDERS         = "providers"
 	KEY_RESPONSE          = "response"
 	KEY_RULE              = "rule"
 	KEY_RUNTIME           = "runtime"
 	KEY_SEQUENCE          = "sequence"
+	KEY_SIZE              = "size"
 	KEY_SOURCE            = "source"
 	KEY_TRIGGER           = "trigger"
 	KEY_TRIGGER_FEED      = "feed"
+	KEY_TYPE              = "type"
+	KEY_UNIT              = "unit"
 	KEY_URL               = "url"
 	KEY_UUID              = "uuid"
 	KEY_VALUE             = "value"
-	KEY_VALUE_MAX         = "max" // TODO() attempt to use this for Limit value range errors
-	KEY_VALUE_MIN         = "min" // TODO() attempt to use this for Limit value range errors
+	KEY_VALUE_MAX         = "max"
+	KEY_VALUE_MIN         = "min"
 )
 
 // DO NOT TRANSLATE
@@ -118,6 +137,10 @@ const (
 	ID_CMD_DESC_SHORT_UNDEPLOY = "msg_cmd_desc_short_undeploy"
 	ID_CMD_DESC_SHORT_EXPORT   = "msg_cmd_desc_short_export"
 
+	ID_CMD_DESC_SHORT_RUNTIMES         = "msg_cmd_desc_short_runtimes"
+	ID_CMD_DESC_LONG_RUNTIMES          = "msg_cmd_desc_long_runtimes"
+	ID_CMD_DESC_SHORT_RUNTIMES_REFRESH = "msg_cmd_desc_short_runtimes_refresh"
+
 	// Cobra Flag messages
 	ID_CMD_FLAG_API_HOST    = "msg_cmd_flag_api_host"
 	ID_CMD_FLAG_API_VERSION = "msg_cmd_flag_api_version"
@@ -139,6 +162,19 @@ const (
 	ID_CMD_FLAG_PARAM       = "msg_cmd_flag_allow_param"
 	ID_CMD_FLAG_PARAM_FILE  = "msg_cmd_flag_allow_param_file"
 
+	ID_CMD_FLAG_DEFAULTS_MIN_ACTIONS = "msg_cmd_flag_defaults_min_actions"
+	ID_CMD_FLAG_ALARMS_PACKAGE       = "msg_cmd_flag_alarms_package"
+	ID_CMD_FLAG_EXPLAIN_PARAMS       = "msg_cmd_flag_explain_params"
+	ID_CMD_FLAG_LIST_ZIP_CONTENTS    = "msg_cmd_flag_list_zip_contents"
+	ID_CMD_FLAG_CODE_SIZE_LIMIT      = "msg_cmd_flag_code_size_limit"
+	ID_CMD_FLAG_MEMORY_BUDGET        = "msg_cmd_flag_memory_budget"
+	ID_CMD_FLAG_OFFLINE              = "msg_cmd_flag_offline"
+	ID_CMD_FLAG_CACERT               = "msg_cmd_flag_cacert"
+	ID_CMD_FLAG_INSECURE             = "msg_cmd_flag_insecure"
+	ID_CMD_FLAG_RUNTIMES_TTL         = "msg_cmd_flag_runtimes_ttl"
+	ID_CMD_FLAG_JSON                 = "msg_cmd_flag_json"
+	ID_CMD_FLAG_CHECK_RUNTIMES       = "msg_cmd_flag_check_runtimes"
+
 	// Root <command> using <manifest | deployment> file
 	ID_MSG_COMMAND_USING_X_cmd_X_filetype_X_path_X = "msg_command_using_filename_at_path"
 
@@ -164,6 +200,28 @@ const (
 	ID_MSG_DEPLOYMENT_REPORT    = "msg_deployment_report_status"
 	ID_MSG_DEPLOYMENT_SUCCEEDED = "msg_deployment_succeeded"
 
+	ID_MSG_RUNTIMES_CACHE_X_path_X_fetched_X  = "msg_runtimes_cache"
+	ID_MSG_RUNTIMES_REFRESHED_X_host_X_path_X = "msg_runtimes_refreshed"
+	ID_MSG_RUNTIMES_CATALOG_X_host_X_source_X = "msg_runtimes_catalog"
+	ID_MSG_RUNTIMES_CHECK_OK_X_path_X         = "msg_runtimes_check_ok"
+
+	ID_MSG_BUILD_STARTED_X_action_X_cmd_X    = "msg_build_started"
+	ID_MSG_BUILD_SKIPPED_X_action_X_path_X   = "msg_build_skipped"
+	ID_MSG_BUILD_SUCCEEDED_X_action_X_path_X = "msg_build_succeeded"
+
+	ID_MSG_ZIP_CONTENTS_X_action_X_path_X        = "msg_zip_contents"
+	ID_MSG_ZIP_ACTION_DIRECTORY                  = "msg_zip_action_directory"
+	ID_MSG_ZIP_INCLUDED_X_include_X              = "msg_zip_included"
+	ID_MSG_ZIP_EXCLUDED_X_exclude_X              = "msg_zip_excluded"
+	ID_MSG_ZIP_IGNORED_X_pattern_X_path_X_line_X = "msg_zip_ignored"
+	ID_MSG_ZIP_KEPT_X_pattern_X_path_X_line_X    = "msg_zip_kept"
+	ID_MSG_ZIP_SKIPPED_FILE                      = "msg_zip_skipped_file"
+	ID_MSG_ZIP_IGNORE_FILE                       = "msg_zip_ignore_file"
+
+	ID_MSG_CODE_SIZE_OVER_X_action_X_size_X_limit_X  = "msg_code_size_over"
+	ID_MSG_CODE_SIZE_CLOSE_X_action_X_size_X_limit_X = "msg_code_size_close"
+	ID_MSG_CODE_SIZE_LARGEST                         = "msg_code_size_largest"
+
 	ID_MSG_UNDEPLOYMENT_CANCELLED = "msg_undeployment_cancelled"
 	ID_MSG_UNDEPLOYMENT_FAILED    = "msg_undeployment_failed"
 	ID_MSG_UNDEPLOYMENT_SUCCEEDED = "msg_undeployment_succeeded"
@@ -182,6 +240,9 @@ const (
 
 	ID_MSG_DEFAULT_PACKAGE = "msg_default_package"
 
+	ID_MSG_EXPLAIN_PARAMS         = "msg_explain_params"
+	ID_MSG_DOTENV_LOADED_X_path_X = "msg_dotenv_loaded"
+
 	// Managed deployments
 	ID_MSG_MANAGED_UNDEPLOYMENT_FAILED                    = "msg_managed_undeployment_failed"
 	ID_MSG_MANAGED_FOUND_DELETED_X_key_X_name_X_project_X = "msg_managed_found_deleted_entity"
@@ -196,7 +257,14 @@ const (
 	ID_ERR_NAME_MISMATCH_X_key_X_dname_X_dpath_X_mname_X_moath_X         = "msg_err_name_mismatch"
 	ID_ERR_RUNTIME_INVALID_X_runtime_X_action_X                          = "msg_err_runtime_invalid"
 	ID_ERR_RUNTIME_MISMATCH_X_runtime_X_ext_X_action_X                   = "msg_err_runtime_mismatch"
+	ID_ERR_RUNTIME_MAPPING_NO_EXTENSIONS_X_runtime_X                     = "msg_err_runtime_mapping_no_extensions"
+	ID_ERR_RUNTIME_MAPPING_EXTENSION_X_ext_X_runtime_X_other_X           = "msg_err_runtime_mapping_extension"
 	ID_ERR_RUNTIMES_GET_X_err_X                                          = "msg_err_runtimes_get"
+	ID_ERR_RUNTIMES_EMPTY_X_source_X                                     = "msg_err_runtimes_empty"
+	ID_ERR_RUNTIMES_CACERT_INVALID                                       = "msg_err_runtimes_cacert_invalid"
+	ID_ERR_RUNTIMES_TLS_X_path_X_err_X                                   = "msg_err_runtimes_tls"
+	ID_ERR_RUNTIMES_REFRESH_OFFLINE                                      = "msg_err_runtimes_refresh_offline"
+	ID_ERR_RUNTIMES_CHECK_X_count_X_path_X                               = "msg_err_runtimes_check"
 	ID_ERR_RUNTIME_ACTION_SOURCE_NOT_SUPPORTED_X_ext_X_action_X          = "msg_err_runtime_action_source_not_supported"
 	ID_ERR_URL_INVALID_X_urltype_X_url_X_filetype_X                      = "msg_err_url_invalid"
 	ID_ERR_URL_MALFORMED_X_urltype_X_url_X                               = "msg_err_url_malformed"
@@ -204,6 +272,8 @@ const (
 	ID_ERR_ACTION_INVALID_X_action_X                                     = "msg_err_action_invalid"
 	ID_ERR_ACTION_MISSING_RUNTIME_WITH_CODE_X_action_X                   = "msg_err_action_missing_runtime_with_code"
 	ID_ERR_ACTION_FUNCTION_REMOTE_DIR_NOT_SUPPORTED_X_action_X_url_X     = "msg_err_action_function_remote_dir_not_supported"
+	ID_ERR_ACTION_DOCKER_IMAGE_INVALID_X_action_X_image_X                = "msg_err_action_docker_image_invalid"
+	ID_ERR_ACTION_CODE_SIZE_X_action_X_limit_X                           = "msg_err_action_code_size"
 	ID_ERR_CANT_SAVE_DOCKER_RUNTIME                                      = "msg_err_cant_save_docker"
 	ID_ERR_FILE_ALREADY_EXISTS                                           = "msg_err_file_already_exists"
 	ID_ERR_DEPENDENCIES_WITH_SAME_LABEL_X_dependency_X_location_X        = "msg_err_different_dependencies_with_same_label"
@@ -221,6 +291,72 @@ const (
 	ID_ERR_API_MISSING_WEB_SEQUENCE_X_sequence_X_api_X                   = "msg_err_api_missing_web_sequence"
 	ID_ERR_RUNTIME_PARSER_ERROR                                          = "msg_err_runtime_parser_error"
 	ID_ERR_WEB_ACTION_REQUIRE_AUTH_TOKEN_INVALID_X_action_X_key_X_value  = "msg_err_web_action_require_auth_token_invalid"
+	ID_ERR_INTERPOLATION_VARIABLE_NOT_SET_X_name_X                       = "msg_err_interpolation_variable_not_set"
+	ID_ERR_INTERPOLATION_UNTERMINATED_X_value_X                          = "msg_err_interpolation_unterminated"
+	ID_ERR_INTERPOLATION_INVALID_X_value_X                               = "msg_err_interpolation_invalid"
+	ID_ERR_INTERPOLATION_FILE_READ_X_path_X_err_X                        = "msg_err_interpolation_file_read"
+	ID_ERR_INTERPOLATION_GIT_COMMIT_X_path_X_err_X                       = "msg_err_interpolation_git_commit"
+	ID_ERR_PARAMETER_VALUE_TYPE_MISMATCH                                 = "msg_err_parameter_value_type_mismatch"
+	ID_ERR_PARAMETER_VALUE_TIMESTAMP                                     = "msg_err_parameter_value_timestamp"
+	ID_ERR_PARAMETER_VALUE_VERSION                                       = "msg_err_parameter_value_version"
+	ID_ERR_PARAMETER_VALUE_TOO_LONG_X_max_X                              = "msg_err_parameter_value_too_long"
+	ID_ERR_PARAMETER_VALUE_NOT_IN_ENUM_X_value_X                         = "msg_err_parameter_value_not_in_enum"
+	ID_ERR_PARAMETER_VALUE_BELOW_MIN_X_min_X                             = "msg_err_parameter_value_below_min"
+	ID_ERR_PARAMETER_VALUE_ABOVE_MAX_X_max_X                             = "msg_err_parameter_value_above_max"
+	ID_ERR_LIMIT_ABOVE_MAX_X_limit_X_value_X_max_X                       = "msg_err_limit_above_max"
+	ID_ERR_LIMIT_DECIMAL_UNIT_X_limit_X_value_X_unit_X                   = "msg_err_limit_decimal_unit"
+	ID_ERR_ACTIONS_FROM_PATH_X_path_X_err_X                              = "msg_err_actions_from_path"
+	ID_ERR_ACTIONS_FROM_NAME_COLLISION_X_action_X_path_X                 = "msg_err_actions_from_name_collision"
+	ID_ERR_FEED_NAME_COLLISION_X_feed_X                                  = "msg_err_feed_name_collision"
+	ID_ERR_FEED_ACTION_NOT_FOUND_X_feed_X_action_X                       = "msg_err_feed_action_not_found"
+	ID_ERR_FEED_OPERATION_X_feed_X_key_X                                 = "msg_err_feed_operation"
+	ID_ERR_FEED_OPERATION_NOT_INVOKED_X_feed_X_key_X                     = "msg_err_feed_operation_not_invoked"
+	ID_ERR_SCHEDULE_WITH_FEED_X_trigger_X                                = "msg_err_schedule_with_feed"
+	ID_ERR_SCHEDULE_CRON_X_trigger_X_err_X                               = "msg_err_schedule_cron"
+	ID_ERR_SCHEDULE_TIMEZONE_X_trigger_X_value_X                         = "msg_err_schedule_timezone"
+	ID_ERR_SCHEDULE_DATE_X_trigger_X_key_X_value_X                       = "msg_err_schedule_date"
+	ID_ERR_SCHEDULE_DATE_PAST_X_trigger_X_key_X_value_X                  = "msg_err_schedule_date_past"
+	ID_ERR_SCHEDULE_STOP_BEFORE_START_X_trigger_X                        = "msg_err_schedule_stop_before_start"
+	ID_ERR_SCHEDULE_NEVER_FIRES_X_trigger_X                              = "msg_err_schedule_never_fires"
+	ID_ERR_SCHEDULE_MAX_TRIGGERS_X_trigger_X_value_X                     = "msg_err_schedule_max_triggers"
+	ID_ERR_SEQUENCE_COMPONENT_UNRESOLVED_X_sequence_X_action_X           = "msg_err_sequence_component_unresolved"
+	ID_ERR_SEQUENCE_CYCLE_X_sequence_X_path_X                            = "msg_err_sequence_cycle"
+	ID_ERR_SEQUENCE_COMPONENT_MISSING_X_sequence_X_action_X_namespace_X  = "msg_err_sequence_component_missing"
+	ID_ERR_SEQUENCE_ACTIONS_AND_STEPS_X_sequence_X                       = "msg_err_sequence_actions_and_steps"
+	ID_ERR_SEQUENCE_STEP_INPUTS_X_sequence_X_action_X                    = "msg_err_sequence_step_inputs"
+	ID_ERR_SEQUENCE_STEP_NAME_COLLISION_X_sequence_X_action_X            = "msg_err_sequence_step_name_collision"
+	ID_ERR_COMPOSITION_X_composition_X_err_X                             = "msg_err_composition"
+	ID_ERR_COMPOSITION_RUNTIME_X_composition_X_runtime_X                 = "msg_err_composition_runtime"
+	ID_ERR_COMPOSITION_NAME_COLLISION_X_composition_X                    = "msg_err_composition_name_collision"
+	ID_ERR_COMPOSITION_COMPONENT_UNRESOLVED_X_composition_X_action_X     = "msg_err_composition_component_unresolved"
+	ID_ERR_FLOW_STEP                                                     = "msg_err_flow_step"
+	ID_ERR_FLOW_MISSING_KEY_X_combinator_X_key_X                         = "msg_err_flow_missing_key"
+	ID_ERR_FLOW_PARALLEL                                                 = "msg_err_flow_parallel"
+	ID_ERR_FLOW_EQUALS_X_value_X                                         = "msg_err_flow_equals"
+	ID_ERR_SECRET_X_name_X_err_X                                         = "msg_err_secret"
+	ID_ERR_SECRET_PROVIDER_X_provider_X_providers_X                      = "msg_err_secret_provider"
+	ID_ERR_SECRET_MISSING_KEY_X_provider_X_key_X                         = "msg_err_secret_missing_key"
+	ID_ERR_SECRET_ENV_NOT_SET_X_name_X                                   = "msg_err_secret_env_not_set"
+	ID_ERR_DOTENV_INVALID_LINE_X_path_X_line_X                           = "msg_err_dotenv_invalid_line"
+	ID_ERR_JSON_SCHEMA_TYPE_X_type_X                                     = "msg_err_json_schema_type"
+	ID_ERR_JSON_SCHEMA_REQUIRED_X_key_X                                  = "msg_err_json_schema_required"
+	ID_ERR_JSON_SCHEMA_ADDITIONAL_PROPERTY_X_key_X                       = "msg_err_json_schema_additional_property"
+	ID_ERR_JSON_SCHEMA_CONST_X_value_X                                   = "msg_err_json_schema_const"
+	ID_ERR_JSON_SCHEMA_TOO_SHORT_X_min_X                                 = "msg_err_json_schema_too_short"
+	ID_ERR_JSON_SCHEMA_PATTERN_X_value_X                                 = "msg_err_json_schema_pattern"
+	ID_ERR_JSON_SCHEMA_MIN_ITEMS_X_min_X                                 = "msg_err_json_schema_min_items"
+	ID_ERR_JSON_SCHEMA_MAX_ITEMS_X_max_X                                 = "msg_err_json_schema_max_items"
+	ID_ERR_JSON_SCHEMA_UNIQUE_ITEMS                                      = "msg_err_json_schema_unique_items"
+	ID_ERR_JSON_SCHEMA_MIN_PROPERTIES_X_min_X                            = "msg_err_json_schema_min_properties"
+	ID_ERR_JSON_SCHEMA_MAX_PROPERTIES_X_max_X                            = "msg_err_json_schema_max_properties"
+	ID_ERR_JSON_SCHEMA_MULTIPLE_OF_X_value_X                             = "msg_err_json_schema_multiple_of"
+	ID_ERR_JSON_SCHEMA_KEYWORD_X_key_X                                   = "msg_err_json_schema_keyword"
+	ID_ERR_JSON_SCHEMA_REF_X_value_X                                     = "msg_err_json_schema_ref"
+	ID_ERR_JSON_SCHEMA_READ_X_path_X_err_X                               = "msg_err_json_schema_read"
+	ID_ERR_JSON_SCHEMA_INVALID_PATTERN_X_value_X_err_X                   = "msg_err_json_schema_invalid_pattern"
+	ID_ERR_BUILD_KEY_MISSING_X_key_X_action_X                            = "msg_err_build_key_missing"
+	ID_ERR_BUILD_OUTPUT_MISSING_X_path_X                                 = "msg_err_build_output_missing"
+	ID_ERR_BUILD_FAILED_X_action_X_err_X_log_X                           = "msg_err_build_failed"
 
 	// Server-side Errors (wskdeploy as an Action)
 	ID_ERR_JSON_MISSING_KEY_CMD = "msg_err_json_missing_cmd_key"
@@ -234,10 +370,18 @@ const (
 	ID_WARN_KEYVALUE_NOT_SAVED_X_key_X                        = "msg_warn_key_value_not_saved"
 	ID_WARN_LIMIT_IGNORED_X_limit_X                           = "msg_warn_limit_ignored"
 	ID_WARN_LIMIT_UNCHANGEABLE_X_name_X                       = "msg_warn_limit_changeable"
-	ID_WARN_LIMITS_LOG_SIZE                                   = "msg_warn_limits_log_size"    // TODO() remove for value range
-	ID_WARN_LIMITS_MEMORY_SIZE                                = "msg_warn_limits_memory_size" // TODO() remove for value range
-	ID_WARN_LIMITS_TIMEOUT                                    = "msg_warn_limits_timeout"     // TODO() remove for value range
+	ID_WARN_LIMIT_ROUNDED_X_limit_X_value_X                   = "msg_warn_limit_rounded"
+	ID_WARN_SEQUENCE_INPUT_MISSING_X_sequence_X_name_X        = "msg_warn_sequence_input_not_in_outputs"
+	ID_WARN_SEQUENCE_INPUT_TYPE_X_sequence_X_name_X_type_X    = "msg_warn_sequence_output_type_mismatch"
+	ID_WARN_LIMIT_RANGE_X_limit_X_value_X_min_X_max_X         = "msg_warn_limit_range"
+	ID_WARN_ACTIONS_FROM_NO_MATCH_X_path_X                    = "msg_warn_actions_from_no_match"
+	ID_WARN_ACTIONS_FROM_OVERRIDE_X_action_X_path_X           = "msg_warn_actions_from_unused_override"
 	ID_WARN_RUNTIME_CHANGED_X_runtime_X_action_X              = "msg_warn_runtime_changed"
+	ID_WARN_RUNTIMES_CACHE_WRITE_X_path_X_err_X               = "msg_warn_runtimes_cache_write"
+	ID_WARN_SCHEDULE_START_PAST_X_trigger_X_value_X           = "msg_warn_schedule_start_past"
+	ID_WARN_BUILD_DIGEST_X_path_X_err_X                       = "msg_warn_build_digest"
+	ID_WARN_NATIVE_EXEC_MISSING_X_action_X_path_X             = "msg_warn_native_exec_missing"
+	ID_WARN_NATIVE_EXEC_NOT_LINUX_X_action_X_path_X           = "msg_warn_native_exec_not_linux"
 	ID_WARN_VALUE_RANGE_X_name_X_key_X_filetype_X_min_X_max_X = "msg_warn_value_range" // TODO() not used, but should be used for limit ranges
 	ID_WARN_WHISK_PROPS_DEPRECATED                            = "msg_warn_whisk_properties"
 	ID_WARN_ENTITY_NAME_EXISTS_X_key_X_name_X                 = "msg_warn_entity_name_exists"
@@ -249,9 +393,7 @@ const (
 	ID_WARN_API_MISSING_WEB_ACTION_X_action_X_api_X           = "msg_warn_api_missing_web_action"
 	ID_WARN_API_MISSING_WEB_SEQUENCE_X_sequence_X_api_X       = "msg_warn_api_missing_web_sequence"
 	ID_WARN_API_INVALID_RESPONSE_TYPE                         = "msg_warn_api_invalid_response_type"
-	/** Fixes #797
 	ID_WARN_MISSING_ENVIRONMENT_VARIABLE                      = "msg_warn_missing_environment_variable"
-	**/
 
 	// Verbose (Debug/Trace) messages
 	ID_DEBUG_PROJECT_SEARCH_X_path_X_key_X                                = "msg_dbg_searching_project_directory"
@@ -267,6 +409,7 @@ const (
 	ID_VERBOSE_DELETING_FILE_X_path_X                                     = "msg_verbose_deleting_file"
 	ID_VERBOSE_LIST_OF_FILES_MATCHING_PATTERN                             = "msg_verbose_list_of_files_matching_pattern"
 	ID_VERBOSE_ACTION_AUTH_X_action_X_value_X                             = "msg_action_authentication"
+	ID_VERBOSE_ACTION_DIGEST_X_action_X_digest_X                          = "msg_verbose_action_digest"
 )
 
 // DO NOT TRANSLATE
@@ -276,14 +419,29 @@ var I18N_ID_SET = [](string){
 	ID_CMD_DESC_LONG_ROOT,
 	ID_CMD_DESC_SHORT_REPORT,
 	ID_CMD_DESC_SHORT_ROOT,
+	ID_CMD_DESC_SHORT_RUNTIMES,
+	ID_CMD_DESC_LONG_RUNTIMES,
+	ID_CMD_DESC_SHORT_RUNTIMES_REFRESH,
 	ID_CMD_DESC_SHORT_VERSION,
+	ID_CMD_FLAG_ALARMS_PACKAGE,
 	ID_CMD_FLAG_API_HOST,
 	ID_CMD_FLAG_API_VERSION,
 	ID_CMD_FLAG_AUTH_KEY,
 	ID_CMD_FLAG_CERT_FILE,
 	ID_CMD_FLAG_CONFIG,
 	ID_CMD_FLAG_DEFAULTS,
+	ID_CMD_FLAG_DEFAULTS_MIN_ACTIONS,
 	ID_CMD_FLAG_DEPLOYMENT,
+	ID_CMD_FLAG_EXPLAIN_PARAMS,
+	ID_CMD_FLAG_LIST_ZIP_CONTENTS,
+	ID_CMD_FLAG_CODE_SIZE_LIMIT,
+	ID_CMD_FLAG_MEMORY_BUDGET,
+	ID_CMD_FLAG_OFFLINE,
+	ID_CMD_FLAG_CACERT,
+	ID_CMD_FLAG_INSECURE,
+	ID_CMD_FLAG_RUNTIMES_TTL,
+	ID_CMD_FLAG_JSON,
+	ID_CMD_FLAG_CHECK_RUNTIMES,
 	ID_CMD_FLAG_KEY_FILE,
 	ID_CMD_FLAG_MANAGED,
 	ID_CMD_FLAG_MANIFEST,
@@ -306,14 +464,87 @@ var I18N_ID_SET = [](string){
 	ID_ERR_ENTITY_CREATE_X_key_X_err_X_code_X,
 	ID_ERR_ENTITY_DELETE_X_key_X_err_X_code_X,
 	ID_ERR_FILE_ALREADY_EXISTS,
+	ID_ERR_INTERPOLATION_VARIABLE_NOT_SET_X_name_X,
+	ID_ERR_INTERPOLATION_UNTERMINATED_X_value_X,
+	ID_ERR_INTERPOLATION_INVALID_X_value_X,
+	ID_ERR_INTERPOLATION_FILE_READ_X_path_X_err_X,
+	ID_ERR_INTERPOLATION_GIT_COMMIT_X_path_X_err_X,
 	ID_ERR_JSON_MISSING_KEY_CMD,
 	ID_ERR_JSON_MISSING_KEY_CMD,
+	ID_ERR_JSON_SCHEMA_TYPE_X_type_X,
+	ID_ERR_JSON_SCHEMA_REQUIRED_X_key_X,
+	ID_ERR_JSON_SCHEMA_ADDITIONAL_PROPERTY_X_key_X,
+	ID_ERR_JSON_SCHEMA_CONST_X_value_X,
+	ID_ERR_JSON_SCHEMA_TOO_SHORT_X_min_X,
+	ID_ERR_JSON_SCHEMA_PATTERN_X_value_X,
+	ID_ERR_JSON_SCHEMA_MIN_ITEMS_X_min_X,
+	ID_ERR_JSON_SCHEMA_MAX_ITEMS_X_max_X,
+	ID_ERR_JSON_SCHEMA_UNIQUE_ITEMS,
+	ID_ERR_JSON_SCHEMA_MIN_PROPERTIES_X_min_X,
+	ID_ERR_JSON_SCHEMA_MAX_PROPERTIES_X_max_X,
+	ID_ERR_JSON_SCHEMA_MULTIPLE_OF_X_value_X,
+	ID_ERR_JSON_SCHEMA_KEYWORD_X_key_X,
+	ID_ERR_JSON_SCHEMA_REF_X_value_X,
+	ID_ERR_JSON_SCHEMA_READ_X_path_X_err_X,
+	ID_ERR_JSON_SCHEMA_INVALID_PATTERN_X_value_X_err_X,
+	ID_ERR_BUILD_KEY_MISSING_X_key_X_action_X,
+	ID_ERR_BUILD_OUTPUT_MISSING_X_path_X,
+	ID_ERR_BUILD_FAILED_X_action_X_err_X_log_X,
 	ID_ERR_KEY_MISSING_X_key_X,
 	ID_ERR_MANIFEST_FILE_NOT_FOUND_X_path_X,
 	ID_ERR_NAME_MISMATCH_X_key_X_dname_X_dpath_X_mname_X_moath_X,
+	ID_ERR_PARAMETER_VALUE_TYPE_MISMATCH,
+	ID_ERR_PARAMETER_VALUE_TIMESTAMP,
+	ID_ERR_PARAMETER_VALUE_VERSION,
+	ID_ERR_PARAMETER_VALUE_TOO_LONG_X_max_X,
+	ID_ERR_PARAMETER_VALUE_NOT_IN_ENUM_X_value_X,
+	ID_ERR_PARAMETER_VALUE_BELOW_MIN_X_min_X,
+	ID_ERR_PARAMETER_VALUE_ABOVE_MAX_X_max_X,
+	ID_ERR_LIMIT_ABOVE_MAX_X_limit_X_value_X_max_X,
+	ID_ERR_LIMIT_DECIMAL_UNIT_X_limit_X_value_X_unit_X,
+	ID_ERR_ACTIONS_FROM_PATH_X_path_X_err_X,
+	ID_ERR_ACTIONS_FROM_NAME_COLLISION_X_action_X_path_X,
+	ID_ERR_FEED_NAME_COLLISION_X_feed_X,
+	ID_ERR_FEED_ACTION_NOT_FOUND_X_feed_X_action_X,
+	ID_ERR_FEED_OPERATION_X_feed_X_key_X,
+	ID_ERR_FEED_OPERATION_NOT_INVOKED_X_feed_X_key_X,
+	ID_ERR_SCHEDULE_WITH_FEED_X_trigger_X,
+	ID_ERR_SCHEDULE_CRON_X_trigger_X_err_X,
+	ID_ERR_SCHEDULE_TIMEZONE_X_trigger_X_value_X,
+	ID_ERR_SCHEDULE_DATE_X_trigger_X_key_X_value_X,
+	ID_ERR_SCHEDULE_DATE_PAST_X_trigger_X_key_X_value_X,
+	ID_ERR_SCHEDULE_STOP_BEFORE_START_X_trigger_X,
+	ID_ERR_SCHEDULE_NEVER_FIRES_X_trigger_X,
+	ID_ERR_SCHEDULE_MAX_TRIGGERS_X_trigger_X_value_X,
+	ID_ERR_SEQUENCE_COMPONENT_UNRESOLVED_X_sequence_X_action_X,
+	ID_ERR_SEQUENCE_CYCLE_X_sequence_X_path_X,
+	ID_ERR_SEQUENCE_COMPONENT_MISSING_X_sequence_X_action_X_namespace_X,
+	ID_ERR_SEQUENCE_ACTIONS_AND_STEPS_X_sequence_X,
+	ID_ERR_SEQUENCE_STEP_INPUTS_X_sequence_X_action_X,
+	ID_ERR_SEQUENCE_STEP_NAME_COLLISION_X_sequence_X_action_X,
+	ID_ERR_COMPOSITION_X_composition_X_err_X,
+	ID_ERR_COMPOSITION_RUNTIME_X_composition_X_runtime_X,
+	ID_ERR_COMPOSITION_NAME_COLLISION_X_composition_X,
+	ID_ERR_COMPOSITION_COMPONENT_UNRESOLVED_X_composition_X_action_X,
+	ID_ERR_FLOW_STEP,
+	ID_ERR_FLOW_MISSING_KEY_X_combinator_X_key_X,
+	ID_ERR_FLOW_PARALLEL,
+	ID_ERR_FLOW_EQUALS_X_value_X,
+	ID_ERR_SECRET_X_name_X_err_X,
+	ID_ERR_SECRET_PROVIDER_X_provider_X_providers_X,
+	ID_ERR_SECRET_MISSING_KEY_X_provider_X_key_X,
+	ID_ERR_SECRET_ENV_NOT_SET_X_name_X,
+	ID_ERR_DOTENV_INVALID_LINE_X_path_X_line_X,
 	ID_ERR_RUNTIME_INVALID_X_runtime_X_action_X,
 	ID_ERR_RUNTIME_MISMATCH_X_runtime_X_ext_X_action_X,
+	ID_ERR_RUNTIME_MAPPING_NO_EXTENSIONS_X_runtime_X,
+	ID_ERR_RUNTIME_MAPPING_EXTENSION_X_ext_X_runtime_X_other_X,
 	ID_ERR_RUNTIMES_GET_X_err_X,
+	ID_ERR_RUNTIMES_EMPTY_X_source_X,
+	ID_ERR_RUNTIMES_CACERT_INVALID,
+	ID_ERR_RUNTIMES_TLS_X_path_X_err_X,
+	ID_ERR_RUNTIMES_REFRESH_OFFLINE,
+	ID_ERR_RUNTIMES_CHECK_X_count_X_path_X,
 	ID_ERR_URL_INVALID_X_urltype_X_url_X_filetype_X,
 	ID_ERR_URL_MALFORMED_X_urltype_X_url_X,
 	ID_ERR_WEB_ACTION_REQUIRE_AUTH_TOKEN_INVALID_X_action_X_key_X_value,
@@ -335,6 +566,26 @@ var I18N_ID_SET = [](string){
 	ID_MSG_DEPLOYMENT_CANCELLED,
 	ID_MSG_DEPLOYMENT_FAILED,
 	ID_MSG_DEPLOYMENT_REPORT,
+	ID_MSG_RUNTIMES_CACHE_X_path_X_fetched_X,
+	ID_MSG_RUNTIMES_REFRESHED_X_host_X_path_X,
+	ID_MSG_RUNTIMES_CATALOG_X_host_X_source_X,
+	ID_MSG_RUNTIMES_CHECK_OK_X_path_X,
+	ID_MSG_BUILD_STARTED_X_action_X_cmd_X,
+	ID_MSG_BUILD_SKIPPED_X_action_X_path_X,
+	ID_MSG_BUILD_SUCCEEDED_X_action_X_path_X,
+	ID_MSG_ZIP_CONTENTS_X_action_X_path_X,
+	ID_MSG_ZIP_ACTION_DIRECTORY,
+	ID_MSG_ZIP_INCLUDED_X_include_X,
+	ID_MSG_ZIP_EXCLUDED_X_exclude_X,
+	ID_MSG_ZIP_IGNORED_X_pattern_X_path_X_line_X,
+	ID_MSG_ZIP_KEPT_X_pattern_X_path_X_line_X,
+	ID_MSG_ZIP_SKIPPED_FILE,
+	ID_MSG_ZIP_IGNORE_FILE,
+	ID_MSG_CODE_SIZE_OVER_X_action_X_size_X_limit_X,
+	ID_MSG_CODE_SIZE_CLOSE_X_action_X_size_X_limit_X,
+	ID_MSG_CODE_SIZE_LARGEST,
+	ID_MSG_EXPLAIN_PARAMS,
+	ID_MSG_DOTENV_LOADED_X_path_X,
 	ID_MSG_DEPLOYMENT_SUCCEEDED,
 	ID_MSG_ENTITY_DEPLOYED_SUCCESS_X_key_X_name_X,
 	ID_MSG_ENTITY_DEPLOYING_X_key_X_name_X,
@@ -361,10 +612,18 @@ var I18N_ID_SET = [](string){
 	ID_WARN_KEYVALUE_NOT_SAVED_X_key_X,
 	ID_WARN_LIMIT_IGNORED_X_limit_X,
 	ID_WARN_LIMIT_UNCHANGEABLE_X_name_X,
-	ID_WARN_LIMITS_LOG_SIZE,
-	ID_WARN_LIMITS_MEMORY_SIZE,
-	ID_WARN_LIMITS_TIMEOUT,
+	ID_WARN_LIMIT_ROUNDED_X_limit_X_value_X,
+	ID_WARN_SEQUENCE_INPUT_MISSING_X_sequence_X_name_X,
+	ID_WARN_SEQUENCE_INPUT_TYPE_X_sequence_X_name_X_type_X,
+	ID_WARN_LIMIT_RANGE_X_limit_X_value_X_min_X_max_X,
+	ID_WARN_ACTIONS_FROM_NO_MATCH_X_path_X,
+	ID_WARN_ACTIONS_FROM_OVERRIDE_X_action_X_path_X,
 	ID_WARN_PACKAGES_NOT_FOUND_X_path_X,
 	ID_WARN_RUNTIME_CHANGED_X_runtime_X_action_X,
+	ID_WARN_RUNTIMES_CACHE_WRITE_X_path_X_err_X,
+	ID_WARN_SCHEDULE_START_PAST_X_trigger_X_value_X,
+	ID_WARN_BUILD_DIGEST_X_path_X_err_X,
+	ID_WARN_NATIVE_EXEC_MISSING_X_action_X_path_X,
+	ID_WARN_NATIVE_EXEC_NOT_LINUX_X_action_X_path_X,
 	ID_WARN_WHISK_PROPS_DEPRECATED,
 }
@@ -97,7 +97,7 @@ func wski18nResourcesDe_deAllJson() (*asset, error) {
 	return a, nil
 }
 
-var _wski18nResourcesEn_usAllJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x7c\x7b\x8f\x1b\x37\x92\xf8\xff\xf9\x14\x85\x60\x01\x27\x80\x46\xf6\x2e\x7e\xf8\x61\x31\x77\x3e\x60\xd6\x1e\x27\xb3\xb1\x33\xbe\x79\x24\xc8\xd9\x83\x36\xd5\x5d\x92\xb8\xd3\x4d\xf6\x92\x6c\xc9\xca\x40\xdf\xfd\x50\x45\xb2\xbb\xa5\x51\x3f\x34\x76\x70\xf1\x3f\xd6\x88\x64\xbd\x58\x2c\xd6\x8b\xfa\xf0\x0d\xc0\xc3\x37\x00\x00\xdf\xca\xec\xdb\x53\xf8\xb6\xb0\x8b\xa4\x34\x38\x97\x9f\x13\x34\x46\x9b\x6f\x27\x7e\xd4\x19\xa1\x6c\x2e\x9c\xd4\x8a\xa6\x9d\xf3\xd8\x37\x00\xdb\x49\x0f\x04\xa9\xe6\xba\x03\xc0\x05\x0d\x0d\xad\xb7\x55\x9a\xa2\xb5\x1d\x20\xae\xc3\xe8\x10\x94\xb5\x30\x4a\xaa\x45\x07\x94\x5f\xc3\x68\x27\x94\xb4\xc8\x92\x0c\x6d\x9a\xe4\x5a\x2d\x12\x83\xa5\x36\xae\x03\xd6\x15\x0f\x5a\xd0\x0a\x32\x2c\x73\xbd\xc1\x0c\x50\x39\xe9\x24\x5a\xf8\x4e\x4e\x71\x3a\x81\xf7\x22\xbd\x17\x0b\xb4\x13\x38\x4b\x69\x9d\x9d\xc0\x8d\x91\x8b\x05\x1a\x3b\x81\xab\x2a\xa7\x11\x74\xe9\xf4\x7b\x10\x16\xd6\x98\xe7\xf4\xbf\xc1\x14\x95\xe3\x15\x2b\xc6\x66\x41\x2a\x70\x4b\x04\x5b\x62\x2a\xe7\x12\x33\x50\xa2\x40\x5b\x8a\x14\xa7\xa3\x79\xd1\xba\x8b\x93\x9b\x25\xc2\x65\x89\xea\xd7\xa5\xb4\xf7\xf0\x9a\x99\x29\x88\x84\x1b\xad\xf3\x8f\xea\xa3\xba\xd1\x30\xc3\x85\x54\xb0\xd6\xe6\x5e\xaa\x05\xac\xa5\x5b\xc2\xda\xde\x7b\xc6\x27\x60\x2a\x4f\xe0\xb3\xfa\xbb\x67\x90\xea\xa2\x10\x2a\x3b\x25\x00\x1f\xdd\x5f\x9a\xe9\x0c\x71\x29\x2d\xac\x65\x9e\x07\xd9\xb5\xf0\x0b\x6b\xd1\xd9\x16\xaf\x52\x41\x21\x94\x9c\xa3\x75\xd3\x8d\x28\x72\xd0\xa6\xf5\x45\x91\x7f\x54\x17\x73\x48\x2b\x63\x88\xe4\x4c\x1a\x4c\x9d\x36\x1b\xc8\x34\x5a\xe5\x60\x29\x56\x08\x42\x6d\xea\x25\x30\x97\x39\x4e\x1a\x72\xa0\x34\x52\x39\x0b\x8e\x48\x5a\x62\x5e\x42\x81\xd6\x8a\x05\x4e\x3d\xa1\x08\x85\xb6\x8e\xd9\xd1\x0a\xd6\x62\x63\x41\xcf\xa1\xb2\x2c\x87\x1a\x88\xd3\x91\x13\xa1\xb2\xe7\xda\x40\xa5\xba\x38\x13\x06\x59\x28\x3b\x22\x69\xfd\x01\x27\x05\x94\xc2\x2d\x9f\x3b\xfd\x7c\x87\xf1\x71\xb3\xe0\x24\xab\x07\xb2\x7a\x2f\x0f\x00\x88\x14\x1e\xfe\x76\x24\x15\x83\xd3\x7b\xc9\xf9\xa8\xce\x2a\xe5\x96\x74\x6c\x52\x56\xc7\xd3\x8f\xaa\x81\x6d\x50\x64\x16\x52\x83\x19\x4d\x10\xb9\x85\xb9\xd1\x05\xfc\xe5\xc7\xcb\x77\xe7\xcf\xa7\x6b\x7b\x5f\x1a\x5d\x5a\x98\x6d\x20\xc3\xb9\xa8\x72\xf7\x51\x5d\xae\xd0\xac\x8d\x74\x18\xbf\x82\x54\xab\xb9\x5c\xf0\xa6\xd3\x51\x7d\xf5\xf6\xe2\xf4\xa3\x02\xd8\x91\xe4\x49\x98\xf4\x9f\xad\xc9\xff\xd5\x23\x80\x4b\x13\xd4\x73\x03\x22\xcf\xc1\x2d\x0d\xf6\x00\x17\xa5\x5c\x92\x06\xfd\x78\x79\x7d\x43\x7f\x56\x6e\x09\x3f\x9d\xff\x06\x27\x27\xf5\x29\x86\x9f\xcf\xde\x9d\x5f\xbf\x3f\x7b\x75\xde\x89\x75\xc4\x39\xb7\x4b\x6d\x5c\xbf\xd1\x7a\x6f\xf4\x4a\x66\x68\x41\x80\xad\x8a\x42\x18\x92\x32\xcd\x27\x9d\x7e\xa4\xa9\x33\x24\x25\x8f\xd6\xed\x79\xdc\x6b\xcc\x60\x26\x2c\x66\xc4\x72\xa4\xb1\xb5\xb7\xf0\xdb\xd9\xbb\xb7\x63\xec\x52\xa0\xb7\xdb\x30\x9d\x81\xd3\x3a\x07\x8b\x8e\xce\x17\x9f\xcd\x20\xd5\x8d\xae\x0c\xe8\x12\xd5\x9a\xe9\x2d\x83\x9d\x0d\xc7\x52\xec\x1e\xf6\xf1\xb4\xac\xd0\x58\xc2\xdd\x25\x3c\xa9\x1c\xdb\xb9\x30\x0f\x54\x55\xcc\xd0\x90\xec\xea\x0d\x1f\x8d\xcb\x6e\x54\xda\xcf\xb7\xd3\x40\x93\x3c\xb3\xcd\xe6\xd4\xcc\xce\xd0\xad\x11\x15\xa4\xb9\x24\xb1\x0b\x95\x81\x45\xb3\x42\x33\xfa\x52\x18\x4f\x43\x6b\x7b\x09\x4f\x54\x05\xfe\x62\x47\x75\xba\xb7\x82\xd6\xe9\x92\xe0\x8b\xbc\x0d\x8f\xb6\x28\x4e\x67\xd5\x21\xbb\xf0\x5a\xce\xe7\xc8\x16\x3d\x5a\x5c\x53\x29\xba\xbb\x99\x9c\xd3\x5d\x23\x44\x5f\x3d\xfe\x66\xa4\x05\xeb\x9d\xda\xb6\x5e\x4f\x87\x71\x52\x1a\xfd\x2f\x4c\x1d\x9d\x77\x78\x7f\x75\xf9\xcf\xf3\x57\x37\xa3\xf5\x24\x8a\xba\x63\x9f\x6e\x3b\xef\x19\x36\x96\x5e\x21\xc6\xea\xc3\x58\x5c\x06\x0b\xbd\x42\xfb\x18\xe7\x7a\x29\xd3\x25\xac\xd1\x60\xe3\x14\x31\x1d\x74\x6a\x76\x34\x61\xdf\x5e\xec\xf8\x19\x19\xe6\xe8\x68\xb3\x0f\x33\xb5\x03\xcc\x5f\xe7\xa6\x52\xa7\x7f\xba\xeb\xed\x30\xa4\x43\xda\x00\xdf\x69\x95\x6f\xd8\xbf\xb2\x30\xd7\xa6\x25\x1e\xf6\xfe\x58\xc1\x0a\x9d\xe1\xf7\xa3\xf5\x06\x3f\xf7\xdc\x03\xe7\x3c\x08\x81\x92\x1d\xe1\xd6\x22\x1f\xab\x34\x23\x10\x59\xda\x2e\xb1\xc0\xac\x1f\x23\x59\x9b\x1d\x25\x99\x57\x8a\xfd\x66\x6f\x23\x3a\xfc\x31\x5a\x45\x0e\xa8\xa7\x63\x4f\x0b\xfc\x97\x1d\x42\x6f\x6d\xaa\x9f\x87\xd9\xc9\x11\x97\xee\x3c\x17\x8b\x44\x94\x32\xa1\xeb\xbd\x83\x7f\x7f\x3f\x9d\xbd\xbf\x80\x4f\x74\xff\x7f\x1a\x09\xb1\xff\x22\x6a\x01\xfd\xe5\xfc\xea\xfa\xe2\xf2\xe7\x51\x70\x2b\xb7\x4c\xee\xb1\xeb\x70\xd3\xb0\x36\xf2\x77\xfe\x02\x3e\xfd\x74\xfe\xdb\x18\xa0\x29\x1a\x97\xd0\xee\x74\x40\x25\xf9\x92\xf5\xa6\x23\x3b\xa5\xc9\xbc\x95\x63\x00\xb3\x2b\xd6\x01\xb5\xed\xd4\x7d\x17\x3d\x3d\x69\xf7\x5d\xc3\x81\xc3\xe2\xa5\x92\xe7\x7a\x9d\x04\x18\x5d\xd1\x27\x4f\x82\x7a\xd2\x30\xd4\xe6\xf8\xf6\xc9\xa5\x0e\x1a\xea\x7b\x70\x04\xe8\xd2\xe0\x4a\xe2\xba\x03\xae\x5d\x32\xa1\x11\xe8\xf3\x9d\x8b\xba\xcc\x85\x1a\x81\xe1\x1e\x37\xa3\xb7\xf4\x1e\x37\x63\x09\xf7\x92\x0e\x86\xa0\x57\xd0\xd1\x48\xd4\xe1\xb4\xa3\x8b\x01\x0a\x61\xee\x31\x8b\xa6\x64\x94\xa8\x18\x4e\x42\x87\xbe\x8b\x99\x80\x8a\xa7\x0c\x43\x8c\xd6\x61\x60\x57\x77\x2e\xa7\x11\x60\xeb\x40\xa0\x03\x6e\x33\x3e\x9a\xe9\x01\x0a\xbd\x5f\x90\xa3\xb5\x51\xda\x23\x40\x5b\x67\x64\x27\x64\xbf\x75\x95\x45\xba\xbc\xe6\x52\x61\x46\x56\xd9\xc9\xa2\x76\x97\x47\x60\x70\xa6\x5b\x08\x3c\x06\xba\x72\x65\x35\x86\x58\xaf\x6e\x2b\x34\x33\x6d\xbb\x40\x86\xd1\x63\x81\x96\xc2\x88\xa2\x53\xc0\x46\x14\xe8\xd0\xc0\x4a\xe4\x15\xf2\xed\x4d\xc6\x14\x7e\x39\x7b\x7b\x7b\xfe\x89\x2e\xf7\x42\x1c\x89\xaa\xef\x34\x7e\x7a\x73\xf1\xf6\xfc\x13\x85\xb9\x4e\x48\x76\x90\x0f\x51\xf0\xcf\xeb\xcb\x9f\x87\x51\xb3\x55\x4d\x0a\x69\xc9\x17\xe7\xfb\xa2\xfb\xba\xa0\x8b\x98\x66\x34\xb1\x3b\x90\x2d\x90\x16\x94\x8e\x51\x77\x65\x30\x9b\x7e\xec\xdb\xf7\x3d\x8c\x3e\x52\xee\xc1\x48\x77\x1e\x07\xd3\x5f\x84\x67\xe8\xb8\x11\xa6\x26\x36\x7f\x12\xaa\xc0\x4a\x5f\x56\x74\x9f\x9f\x0f\x0f\x0f\x53\xfa\xbc\xdd\xde\x4d\xbc\x63\xf4\xf0\x30\xb5\xba\x32\x29\x6e\xb7\xa3\x70\xfa\x0d\x1b\xc2\xc9\x09\x88\xb0\x57\x16\xdd\xd3\x70\xd5\xe2\x19\xc2\xb6\x23\x47\x62\xb1\xfe\xe2\xe9\x7c\x96\x72\xb1\x4e\x1c\x2a\xa1\x5c\x22\xb3\x31\x32\xfe\x41\x38\x24\x57\xf1\x86\x17\xc1\xc5\xeb\x48\x4d\x55\xc9\xec\x0b\x09\x11\x9c\x99\x4e\x9c\xbe\x47\x75\x0c\x2d\x7e\x1d\xf0\xba\xa7\xed\x45\xa5\x0a\x61\xec\x52\xe4\x49\xae\x53\x91\x77\x46\x6d\x61\x56\xcb\xd1\x0e\x96\x39\x38\xe0\xbc\x3a\x58\x8b\x91\x08\x15\x3a\x0a\x56\x9e\x8c\x52\x2a\x87\x46\xa1\x03\xe1\x88\xdd\xca\xe4\x03\xbc\x36\x6e\x4c\x92\x0a\x95\x62\x9e\x77\x3a\x11\x97\x3f\x4d\xe1\x95\x9f\xd3\xe4\xaf\x38\x2c\x1b\x89\x60\x2e\x64\x37\xf4\x56\x7e\x3c\x93\x59\x30\x0d\x45\x99\xa3\x43\xb0\x15\x6d\xe9\xbc\xca\xf3\xcd\x14\xae\x2a\x05\x9f\x1e\x07\x80\x9f\x38\x5e\xe1\x00\x9a\x4c\xb5\x93\x22\xcf\x37\x4d\xb4\xec\x03\xa3\xb1\x94\xfa\xe4\x5d\x62\x9d\x70\x55\x97\xf3\x7a\x72\x72\x72\xf2\xf2\xe5\xcb\x97\x87\x73\xfc\xd7\xbc\x14\x68\x02\x4d\x1c\x85\x95\x4b\x35\x98\x8d\x11\x51\x14\x4d\x06\xa1\xbe\xe3\x85\xd3\xaf\x64\x4f\xdf\xeb\xf6\xda\xf1\x48\x7a\xf7\xfb\xb6\xed\x41\xf7\xee\xf8\x68\x7c\x43\xf2\xdb\x41\xf9\x04\x09\x86\xd2\x4b\xc2\x39\x35\x76\x1e\xc8\xe8\x26\xc2\x25\xe4\xfe\x75\x20\x7d\x78\x98\xa6\x45\xb6\xdd\x86\x4c\xdc\xc3\xc3\x94\x16\xba\x4d\x89\xdb\x2d\x9b\x4a\x5a\xbb\xdd\xde\x4d\xa7\xbd\xb8\xd9\x67\xdf\x24\x51\x9f\x07\xca\x7a\x0f\x0f\x14\x41\x04\x04\x44\xe4\x76\x7b\x07\x4b\x61\x61\x86\xa8\x76\x18\xae\x4f\xc8\x78\xec\xdd\x75\xc0\xd7\x71\x1c\x0e\x12\x30\x9d\xf6\x64\x50\x03\x8a\x26\x19\xfe\xf5\x58\x6c\x60\x8e\x61\x32\xce\xee\x66\xf3\xb6\x99\x71\x90\xd1\x5e\x3e\x33\x2c\x51\x65\xa8\xd2\x63\xc4\xd9\x2c\x7a\x3a\x9e\xe6\x88\x74\xca\xf4\xf5\x41\x34\x5f\xa2\x38\x87\xa9\x20\xc3\x50\x99\x2e\x37\xf1\xf5\x4e\x0a\xfc\x30\xeb\xff\x87\x77\x44\xe4\xe7\x38\x3d\xf9\xb2\x1d\x7c\x6c\xe6\xbe\xce\x1e\x8e\x3c\x19\x5d\x94\xf4\xef\xe3\xed\x5e\x31\xe3\x29\x3b\xd9\x47\x55\x48\x58\x3c\xf5\xce\x61\x8a\xfc\x0d\x50\x27\x44\xfa\x68\x81\xac\x32\xb4\x93\x31\xe5\xda\xba\x11\xff\x38\x7d\x8b\x3c\xce\x75\xa5\xb2\x24\xd0\x1b\x2c\x55\xa7\x02\x84\x24\xff\x41\x0b\x19\x2a\x09\xdc\x0f\x41\x74\xb5\xea\x08\xb1\xd6\xbf\x9f\x53\xe6\x4b\xca\x7f\x26\x08\xc2\x32\x2f\x5c\xad\x1f\xeb\x16\x84\x14\x5f\x12\xaa\x58\x5d\x85\x40\x3f\xca\xb1\x0d\xb4\xd2\x8f\x06\x39\xad\x92\x4d\xb8\x2c\xdc\xb8\x5b\xf5\xb6\x11\x1d\xa6\x5e\x11\x90\x80\x68\x55\x4b\xda\x45\x56\xdf\xcb\x10\xb4\xdf\xf8\x32\xe0\x50\xe3\xc7\xf9\xd5\xd5\xe5\xd5\x75\x07\xdd\x2f\xf7\xff\x81\x9f\x0e\x8f\x06\x5e\xbe\xec\xb9\x7e\x8c\xd9\x3d\x68\xf7\x4a\xaf\x55\x42\x9e\xc2\xf0\x51\xa7\x59\x24\xaa\xb0\x6a\x0a\xad\x5c\x3d\x97\x40\x6c\x55\xfa\x8a\xc1\x73\xce\x72\x4f\xed\xc6\x3a\x2c\x60\x26\x55\x26\xd5\xc2\x82\x36\xb0\x90\x6e\x59\xcd\xa6\xa9\x2e\xea\x6a\x63\xff\x7d\x69\x4c\xbc\x33\x53\x83\xc2\x75\x91\xc9\x7d\x4e\xc0\x53\x76\xd4\x92\xbb\x5d\xb8\x41\x2a\xb6\x86\x9c\xd2\x20\x1a\xb3\xdd\x72\x99\xc2\x8f\xa5\x3a\xf3\x03\xf4\x61\x20\x9a\x69\x91\xe4\xcf\x4a\x2f\x49\xd9\xa3\x93\xf2\x07\x91\x34\x47\xa4\x70\x7a\xa5\xef\xbb\x08\x7a\xc3\x66\x8b\xcc\x85\x9f\xc6\x07\x92\x96\xc1\x7a\x89\xad\xc2\x9d\xf3\x6d\x4e\x61\xe8\x8f\xa1\xf6\x1e\x37\x75\x4a\x87\xfc\x5d\xe1\xb4\xe9\x4b\x57\xd5\x73\x38\xfb\xf1\x21\x0a\xf3\x8e\xf4\x31\xc0\x19\xc4\x19\x33\xbb\x89\xd2\xce\x1b\xbb\x0e\x84\xef\xda\x29\x60\xb6\xd5\x3c\x9b\xe2\x5d\xce\xc1\xb6\x3d\xea\x21\xa4\xec\xbd\x17\xd2\x16\xc2\xa5\x5d\xee\x3b\x31\x58\xab\x07\x2d\xc8\x18\x45\x16\xed\xa9\x54\xfb\xb5\x06\x3f\x1e\x68\xe0\x76\x29\x26\x93\x91\xf0\xb6\xb2\x79\xa3\x49\x45\x0b\xc8\x4e\x6a\xdb\x8f\x46\x36\xfa\x99\x08\xf1\x3f\xa9\x97\xc8\x65\x97\xd8\x2e\xfc\x28\xf7\x78\xf9\x2d\xa9\xb3\xc8\x84\x2b\x7c\x26\x5a\x0e\x36\x88\x71\xed\x94\x68\x17\xbe\x6e\x48\x6b\xfc\xc7\x31\x72\x8e\x24\x0e\x88\xfa\xea\x18\x82\xf6\xe4\xca\x47\xc1\x53\xf4\xcc\x82\xcf\xf2\x78\x51\xe2\x67\x87\xca\x46\xa2\xf1\x33\xdf\x61\xc4\xce\x97\xb0\x62\x93\x05\x76\xe5\x53\x9b\xa3\xbc\x40\xdf\xd6\x12\x6c\x6f\x93\xb9\x7f\x54\xa0\xa5\xfb\x4d\xa6\xad\xe3\x3b\x5a\xa6\x9e\xf4\xc4\x73\xcc\xa7\xa7\xc6\xd6\x41\xdf\x0e\xc3\xec\x17\x92\x18\x1b\x29\x0b\xb5\xa9\x75\x83\x8c\x48\x6b\xdb\x07\xe5\x1a\x72\xba\x35\x09\x83\x6c\x54\x26\x3f\x5e\x73\x7d\x62\x2b\x84\xd0\xb7\x57\x6f\x7d\xc6\xd1\xe4\xe1\x28\x7d\xd8\x89\xb1\xef\x7c\xaf\xd2\x18\x42\x0a\x91\xcf\xb5\x29\x3a\x25\xf7\x2e\x8e\xf7\x51\x30\x85\x1b\xb3\x01\xb1\x10\x52\x0d\x85\xf4\xc6\x24\xff\xb2\x5a\xd5\xc6\x36\x2d\xb2\x9e\x42\x32\xd7\x1a\xa4\x2a\x2b\x07\x99\x70\x02\xde\x05\x69\x3c\x4b\x8b\xec\x19\x99\xde\x7e\x4c\xa2\x94\x4d\x41\xc0\x2b\x8d\x36\x89\xc5\x7f\x57\xa8\x3a\x33\xf6\xbe\xbd\xf6\xf9\x75\x98\xb5\x7b\x58\x5a\xf6\xdd\xeb\xf3\x5e\xef\xc8\xd9\xfb\x0b\xbf\xa0\x94\x34\x3b\x15\xca\xbb\x22\x33\xf4\xce\x40\xbb\xdf\xad\x51\xb2\xe7\x91\xa4\x03\x30\xa7\xf0\x3e\x47\x61\x11\xaa\x32\x13\x6e\xaf\x59\xc5\x5f\x9e\x69\x5e\x65\xfb\x74\x0a\x0b\x02\xd6\x38\xdb\xc7\x30\xb8\x3b\x41\x4e\xfd\x0a\x7a\x76\xc0\x8e\x90\x68\xc2\xaa\x29\x5c\x38\x1f\x7d\x69\xb7\xe4\xbb\x78\xb7\x05\xa3\x3e\x78\x13\x2f\x1d\xad\x30\x54\x81\x0b\x82\x82\x9f\x4b\x4c\xc7\x9c\xa4\x40\x6b\xdc\xe2\x68\x1f\xc8\x30\x26\x84\xf5\x0b\xa9\x67\xc2\x1b\x23\x41\x60\x75\xe5\xda\xc6\x62\x0a\xbf\x36\x46\x38\x9a\x0a\x5a\x36\xa9\xcd\x89\xb4\x8d\xb3\x30\x70\xad\x05\x76\xa2\x98\x12\x8a\x56\x1c\x26\x99\x34\xa3\x8c\xdc\x41\xb6\x88\x8f\x5a\xee\xa5\x96\xca\xbb\x54\x3e\x44\x73\xd8\x6a\x72\x6e\x8e\xf3\x84\x42\xc0\xc8\x15\x37\x19\xef\x59\xb8\x7e\x36\x52\x41\x01\xbb\x58\x61\x92\xe9\xf4\x1e\xbb\x9e\x02\xbc\x12\x8a\xa1\x8a\x15\xc2\x6b\x9e\x08\xb2\x60\x07\x7c\xc0\xb1\x94\x39\x26\x22\x37\x28\xb2\x4d\x82\x9f\xa5\xed\x6c\xb5\x78\x43\x27\x24\xcc\x04\x3f\x73\x00\x76\x16\x5b\x05\x9b\xa8\x44\xa2\xf5\x0a\x65\xc9\x73\xca\xc5\x0c\xbb\x8a\x23\x97\x0a\x81\xf4\x30\xc7\xfd\xb0\xbf\xf9\x33\x6e\x89\x5b\x6b\xa8\x91\x71\xd1\xc4\xcb\x9a\x66\xc7\xbf\xbc\x61\x5d\x4a\x0b\xf7\x52\x65\x74\x40\x82\x2e\x86\x1a\xe9\xa3\x8b\x67\xcf\x52\x90\x7d\x69\x11\xc2\xa4\x1f\x20\x27\x3c\x08\x78\x64\x57\x58\x59\xb8\xbe\x4f\xbe\x5b\x24\x0a\x62\x58\x83\xcc\x83\xc5\x52\x18\xfa\x83\xa1\xfb\x7e\xb3\x0e\xde\xc6\x29\x7f\x38\x64\x09\xb1\x7c\xac\x9e\x2b\xed\x25\x65\xd1\x1d\x87\xec\x58\x5b\x11\x90\xb5\xce\xfb\x00\xbe\x68\x7d\x93\xa5\x58\x91\xa5\x62\x5d\xf2\x89\x74\x1b\x88\xe9\x7a\xac\xd2\xbe\x86\x22\x98\x60\xaf\xa2\x6a\xc7\x1e\x09\xb2\xf9\x2a\x1a\x23\x1f\xe8\xb3\x2b\x46\xfb\x17\xa2\xdb\x69\x7c\x3d\x12\x5a\x7c\x3d\x3c\xcb\x17\x15\x29\x13\x3f\x71\xe0\x05\xec\xb1\x4b\x05\x22\xea\x74\x84\x30\x70\xf8\xb5\x9a\xe7\x32\x25\x2b\x93\x84\xc0\x8d\x38\x34\xda\xda\x98\x09\xe9\x3a\xae\xad\xf3\x13\x43\x3e\x62\x3a\x7c\x0e\x3c\x47\x5e\xd9\xf9\x2d\xaa\xdc\xc9\x32\xf7\x51\xa3\x3f\x3c\xf4\x29\x78\x24\x1e\x39\x9b\xaf\x78\xf7\xee\xa5\x41\x5c\xbb\xa8\x3c\x01\xe9\xfc\x89\x2a\xb5\xb5\x72\xe6\x4f\x01\x0b\x24\x32\xe2\xb1\x36\xe2\x99\x91\x5f\x52\x6b\x3a\x13\xf1\xe8\x10\x06\x4e\x18\xcd\xa3\xa0\xe7\x08\x61\x9a\x2a\xc7\x27\x48\x92\x96\x85\xe8\x22\xc7\x43\x32\x6c\xe8\x8f\xf6\x7e\xcf\x91\xf0\x6f\x50\x6a\x11\xec\x6e\xc9\xd4\x3f\x3d\xfa\x1a\x42\x66\x06\x0f\x49\x58\x58\xab\x53\xc9\xa0\x0f\x53\xfc\x3c\x12\xb7\x2f\x7c\x66\xfe\x49\x92\x17\xa6\x69\xf1\xe0\x62\x76\x67\x6b\x7b\x28\x90\x41\x2e\x15\x82\x30\x8b\x8a\x83\x62\x12\xa1\x59\x6c\xb7\x6d\x7f\x91\xe1\x4c\xa0\xf4\x24\xc6\x57\x1f\x24\x0f\x1e\x39\x82\xa2\x7b\xdc\x7c\x35\xaa\xee\x71\xf3\x9c\x61\x41\x29\xa4\x79\x44\xde\xee\x30\xdb\x77\xfc\x2c\x8a\x92\x9c\xdd\x1a\xdc\x3d\x6e\x46\xf1\x10\x1c\xac\xe1\x4e\xa4\x2e\x06\xbe\x8b\x28\xbf\x67\x1b\x1c\xe0\xf9\x36\x25\x7f\x71\xd5\xa9\x90\x89\x4f\x48\xb6\xc2\xcb\xa8\x1c\xf5\x7b\x1b\xf0\xab\x39\xc8\x68\x40\x0c\xe5\x1e\xf0\xdf\x95\x34\x9c\xdb\x2a\x2b\x67\x47\x69\xc9\x55\x58\xe3\x43\x19\x7f\x5a\x76\xb4\xc2\x02\xae\x50\x81\x98\x3b\x34\x20\xca\x32\xe7\xfa\x09\x37\x36\x94\xda\xc3\x09\xb5\x54\x54\xab\x29\xac\x84\x91\x62\x96\x63\xa3\xf0\x16\x5d\x0d\x71\x77\x4a\x3c\xc0\x3e\x8a\x6a\xda\xb8\x0e\xbd\xb6\xf1\x2f\x9b\x4c\x78\x7f\xc4\x9b\x3d\xd7\x79\xae\xd7\x9e\x1a\xa2\x9d\xe5\xe9\x3f\x6e\xb7\xc3\xd1\xd7\xc2\x37\xa8\x24\x14\xf4\x70\xc5\x78\x28\xb0\x68\x35\xb5\xd0\x9a\x26\xc1\x25\x4a\x49\x5f\xc4\x1c\xd3\x01\x77\x9d\xa7\xd6\x1d\x6b\xf1\x01\xc1\xbe\x97\x14\x42\x0e\x83\x84\x74\x15\x10\xd4\x99\xe2\x3d\x18\x43\x67\xb2\x15\x5f\xae\x71\xd6\x7f\x93\x77\x45\x1d\x44\x5d\x3b\x54\x1b\x15\x44\xc6\x17\x31\xcd\xb2\xe1\x60\x69\x8f\xd8\x81\x30\xb8\xcf\xf1\x68\x48\x8e\x03\x47\x13\x3d\x3a\x1e\x8d\x41\x5d\x29\x8c\x45\xd3\xfb\xb8\xb8\xc9\x42\x19\x74\x46\x22\x5f\x2a\x21\xf9\x54\x5b\x81\x7e\x6c\xcd\x2e\xc6\x83\xee\x7b\xdd\x63\x47\x56\x9f\xee\xde\x2a\x11\xee\x33\x8b\x69\x65\xbc\x03\xde\x6c\xd0\x7f\xc0\x41\x0d\x38\xa3\x28\x48\xd4\x03\x21\x8d\xdc\xb6\x6e\xde\xfc\xd2\x20\x7f\xea\x4e\x8f\xfe\x7a\x76\xf5\xf3\xc5\xcf\x3f\x8c\x2f\xd9\xc4\x05\xc7\x15\x6d\xd6\xc2\xa8\xba\x2f\x84\x24\xdd\x95\xbd\xb9\xa2\x31\xda\xf2\x0f\xb1\x21\xe4\x2e\x98\x38\xde\xc5\x53\x9f\x45\xa3\x5d\xb9\xeb\xd3\x82\x80\x8f\xdb\xe4\x8e\xce\x9b\xb5\xdb\xfb\x5b\x79\x72\xc8\xd0\x0d\xe7\x18\x18\x33\x5d\xb6\x19\x96\x06\x53\x52\xe2\xc4\x60\x99\x8b\xb4\x33\x08\xbf\x59\x7a\x3c\x3a\xcf\xc2\x56\x72\x7b\xa4\x8f\xb1\x76\x1b\x61\xf8\xcd\xb2\xd5\x5a\xd1\x19\x69\x30\xd4\x57\x70\x65\xbd\x0a\x71\x29\x13\xd7\x3b\xe0\xac\x43\x31\x92\xf6\x20\x89\xa7\x14\x33\xec\x52\x57\x79\x46\xe4\x51\x48\x05\xb7\xd6\x57\xf5\x7d\xc9\xf1\x80\x5a\xf6\xe7\xfe\x6a\x8a\x78\xfe\xc0\x56\x12\x5d\x1e\x03\xdd\x42\x8f\x8b\x2c\x64\x82\xbc\xf9\x3f\x02\x25\x67\x51\xc4\xaa\x77\xf3\x86\x90\xf2\xfa\xb8\xa1\xb1\x7c\x1c\x1f\x71\xb6\x5f\x6f\x0e\x13\x96\xcb\x42\xba\x44\x2e\x94\x36\x9d\x24\x45\x95\x0e\x51\x1d\x2f\xf1\x59\x02\xfa\xb4\x5f\x48\xa1\x5b\xd1\x83\x1b\x8b\x3d\x5d\x0a\xb5\x40\x32\x5c\xfd\xd7\xd6\xdb\x1a\x71\x5d\xc0\xb1\x91\xfd\x7c\xe3\x1b\x08\x6a\x50\x53\xb8\x20\x2a\xa4\x5a\x8c\x51\x09\x26\xc4\x26\xb9\x5e\x24\x56\xfe\x3e\x40\x07\x4f\x3e\x85\x5c\x2f\xae\xe5\xef\xa4\xba\x7c\xc3\xe8\xca\x59\x99\xc5\x94\x87\xd7\x4f\x43\xd4\xd0\x8e\x7c\x78\x31\x81\xbf\xbe\xb8\x83\x77\xff\xa8\xdd\xa5\x15\x1a\xf2\x00\xb9\x0c\x5e\xfa\x77\xcc\xa6\x71\x02\xf8\xf9\xbe\xf7\x67\xc7\x12\x5f\x60\xa1\xcd\x66\x3c\xfd\x7e\xfe\x78\x16\xfe\xfa\xb7\xbf\x4f\xe0\x6f\x2f\xfe\xdf\xdf\xff\x58\x36\xe8\xae\xd4\x55\xe7\x1b\xea\x1d\x16\xc2\xdc\x91\xf4\xbf\x78\x31\x81\xff\xff\x82\xfe\xdd\x41\x21\xf3\x5c\x5a\x4c\xb5\x6a\xc5\xcb\x5f\x8f\x17\x2e\xf6\x27\xa5\xd1\x25\x1a\x27\x3b\x83\xe4\x68\xa9\x5b\x76\xd5\xb7\x88\x78\xd7\x21\x34\x89\xf8\xce\x81\x06\x58\x6c\x26\x39\x6c\xbb\xa3\xe9\xce\x34\x9f\x08\xb2\xe0\xd2\xd5\xa2\xd1\x73\xb8\x31\x62\x25\x2d\xcc\x2a\x99\x67\xfd\x9d\x06\xcc\x8a\x37\x5b\x2c\xc6\x51\x26\xab\x3e\x9e\x3b\x86\x4b\xed\x5d\x3c\xc1\xac\x73\xff\x04\x45\xf3\xfe\xdb\xf8\x04\xfc\xe1\x61\x5a\x48\x15\xaa\xe9\xf4\x87\x48\x07\x6a\x73\x4c\x6a\xf4\xd3\xbc\x15\xe8\x32\x67\xb1\xde\x19\x66\x91\xb3\xb4\x57\xfa\x3c\x50\x1e\xe9\xac\x6e\x3e\xa9\xa4\xc9\xd4\x86\x86\x09\x4e\xc1\xf5\xe6\x90\x1f\xd5\xc2\x77\x6c\xe0\x5e\x72\xb9\x89\xc6\x72\x7e\x98\xaa\xb4\x5b\x86\xdc\xcf\x30\x49\x31\xa7\x33\xd8\x0e\x70\xf3\x28\x5b\xdb\x76\x6c\xc2\xeb\x1d\xcc\x40\xe9\x71\x3d\x2d\x8c\xbd\xd5\x4e\xc6\x42\x19\x43\xc4\xc1\x66\xab\x70\x33\xee\x47\x95\xeb\x50\x73\xf5\x9d\x0b\x87\x72\xce\x23\x24\xd4\x7a\x83\x97\xe8\x15\x1a\x23\xb3\x0c\xbb\xe2\x2d\xa2\xb0\xfd\x24\xaf\x69\x07\x6c\x96\x46\x9f\xa6\xdd\xed\x35\x76\xa3\x12\x69\x93\xb2\x9a\xe5\xb2\xeb\x67\x0f\xfc\xae\xf0\xdc\x58\x39\xf4\xaf\x0e\x29\x56\xe5\x85\x8f\xb2\x52\x13\x32\x17\x6c\x5b\x66\x08\x2b\xe9\x13\x64\x74\x0e\x29\x9c\x9a\x61\x78\xe7\x81\x19\xcc\x36\x20\xd4\x46\xab\x9e\x57\x7c\x4c\x6b\x4c\x74\xe3\x2c\xbc\xad\x1e\x70\x37\x1e\xe7\xb9\xb9\x84\xc7\x51\x8c\xca\xe8\xff\x93\xf0\x0c\x7a\xbf\x86\x47\x07\x81\x7f\xc7\x06\x67\x13\xef\x84\x84\xbf\xc2\x82\x9e\xc0\xcb\x53\xfa\x67\x8a\xa5\xe1\x95\x56\x2b\x32\xf8\x21\x78\x69\x90\x38\x3d\x3e\xea\x3e\xc8\xd7\x9f\x24\xec\xde\xe7\xb0\x8d\xaa\xe6\x71\x54\x90\x5e\x73\x19\xb3\x7b\x06\x6d\xa9\x95\xc5\xbe\x36\xbe\x3d\xb2\x39\xaf\xbb\x9f\xbf\x09\xe3\x31\x53\xd3\xca\xfc\xc4\x1c\x5c\x9d\x3b\x5e\x3a\x57\xfa\xdf\xbb\xf2\xa8\xf9\x6e\x9b\xc2\x2b\xba\x65\xb8\xef\xa7\xfd\xbd\xbf\xd8\xf9\xda\x09\x5f\x07\xa6\x19\x0a\xdd\x29\x0d\x65\x43\x5a\x1b\x77\x16\xd5\x4a\x1a\xad\xd8\x7e\xc6\xd4\x5b\x57\x47\x45\x88\x4c\xcf\x9b\x25\xf0\x4b\x58\x32\x26\xca\x7f\x7d\xfe\x8f\xdb\x1f\x46\x87\xf8\x3c\xfb\xb8\xf8\x3e\x9b\x2d\x12\x8b\xc2\xa4\x4b\xe2\x2c\x1a\xdd\xba\x50\xdc\xa9\xb8\x61\x45\x6d\x74\x77\x4b\xcb\x71\xfb\xa2\x7c\xbd\x73\x32\x10\x1f\x10\x29\xfb\x37\xd3\xd7\xbe\x95\x9e\x78\x23\x11\x69\xf5\x95\xed\x5b\x95\x7b\x7e\x7e\xe8\xf5\x81\x7e\xb9\x20\x91\x53\x78\xc3\x14\x34\xbf\x76\xc3\x65\x13\x02\x76\x2c\x01\xfd\xef\xb5\x8f\xa7\xa1\xdd\x0d\x1d\xbb\xf7\x8f\x7b\x83\xbb\xf7\xa6\xb1\xef\x29\x29\x4d\x7e\xf4\x90\xf1\xf8\xd7\xb2\x21\x76\xa8\xdb\xaf\xbf\x3a\x11\x13\x76\xeb\x9f\x3d\x3c\x4c\xb3\xaa\x28\x36\x3c\x6b\xbb\x7d\x46\xe6\xa7\x1d\xfb\x68\xd5\xaf\x3f\xe1\xbd\x78\xf2\xbb\x2c\x13\xfc\xcc\x2d\x3c\xbe\xb5\xa1\xe7\x5d\xd5\x39\xcf\xa3\x33\xf6\x5e\xb8\xe5\x69\x7b\x07\xc7\xa2\x12\x59\x16\x1f\x72\xf5\x61\x3a\xe3\x69\x3b\x07\xd7\x69\xf8\x1f\x59\xc2\x9b\xa1\x83\xd1\xc6\x16\x7a\x93\x62\xab\x5e\x0f\xc2\x37\xa1\xd9\xf2\xda\x3b\xfa\x4f\xe6\xef\x00\xc6\x24\x43\xeb\xa4\x62\x54\x5f\x42\x02\x7b\x40\xaf\x1b\x58\xad\x19\x2d\x0c\x23\x69\x8d\x97\x65\xa4\x17\x55\x77\x1e\x35\x26\x53\xe0\x22\xb4\x7a\x9d\xd3\x64\x52\x38\xe9\x5a\x85\x10\xa6\x24\xc0\xe3\xd2\x6c\x9c\xce\xb0\xd9\x35\x40\xc9\x01\x09\xdf\x99\x1f\x3c\x9f\x77\x40\x06\xd9\x7f\x9e\xb4\xd9\xeb\x3f\xea\x91\x8f\xd8\xe2\xce\xc2\xef\xa9\xe8\xbd\x8a\xad\xf0\x24\xe1\xa8\x47\x47\xef\x70\x2e\xad\x4b\xf4\x9c\x11\xd9\x84\xdb\x60\xf9\x8e\x12\xce\xa1\xe9\x3a\xd8\xde\xb4\x71\x73\x79\x5d\xcc\xf2\x3f\x14\xe6\x9b\x08\x02\x94\xb8\xef\xdc\x35\xf4\xde\xfb\x22\x0c\xb6\x57\x0e\xc1\xc1\xde\xfd\xf9\x82\xae\x43\xb5\xfb\x1b\x07\x74\x13\x76\xb6\x97\x70\xa0\xd2\xf6\x06\x82\x1b\x47\x6c\x5c\x9d\xff\xf7\xed\xc5\xd5\x79\xf2\xeb\x8f\x17\xd7\x3f\x25\x67\xb7\x37\x3f\xb6\xaa\x08\x91\xda\x6f\xee\xbe\xf9\xdf\x00\x00\x00\xff\xff\x40\xdb\x8b\x92\xa5\x55\x00\x00")
+var _wski18nResourcesEn_usAllJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xd5\x3d\xfd\x6f\xdc\xc6\x95\xbf\xf7\xaf\x18\x04\x05\x9c\x00\xab\x95\x93\xb4\x07\x9c\xee\x72\x80\x6a\xcb\x8d\x1a\x3b\xf6\xc9\x72\x83\xd6\x31\x18\xee\x72\x76\xc5\x88\x4b\xee\xf1\x43\x1f\x29\xf4\xbf\xdf\xfb\x9a\xe1\x90\xcb\x21\x87\xb2\x8a\xeb\x19\x68\xb3\x22\x67\xe6\xbd\xf9\x7a\xdf\xef\xf1\xe3\xef\x94\xfa\x07\xfc\x4f\xa9\x2f\xd2\xe4\x8b\x13\xf5\xc5\xae\xda\x46\xfb\x52\x6f\xd2\xbb\x48\x97\x65\x51\x7e\xb1\xe0\xb7\x75\x19\xe7\x55\x16\xd7\x69\x91\x63\xb3\x33\x7a\x07\xaf\x1e\x16\x23\x23\xa4\xf9\xa6\xf0\x0c\x70\x8e\xaf\xa6\xfa\x57\xcd\x7a\xad\xab\xca\x33\xc4\x7b\x79\x3b\x35\xca\x6d\x5c\xe6\x69\xbe\xf5\x8c\xf2\x93\xbc\xf5\x8e\xb2\xde\x25\x51\xa2\xab\x75\x94\x15\xf9\x36\x2a\xf5\xbe\x28\x6b\xcf\x58\x17\xf4\xb2\x52\x45\xae\x12\xbd\xcf\x8a\x7b\x9d\x28\x9d\xd7\x69\x9d\xea\x4a\x7d\x99\x2e\xf5\x72\xa1\xde\xc5\xeb\xeb\x78\xab\xab\x85\x3a\x5d\x63\x3f\xf8\x71\x59\xa6\xdb\xad\x2e\xe1\xd7\x45\x93\xe1\x1b\x5d\xaf\x97\x5f\xa9\xb8\x52\xb7\x3a\xcb\xf0\xbf\xa5\x5e\xc3\x38\xd4\xe3\x86\xa0\x55\x2a\xcd\x55\x7d\xa5\x55\xb5\xd7\xeb\x74\x93\x02\xa0\x3c\xde\xe9\x6a\x1f\xaf\xf5\x32\x78\x2e\x45\xe1\x9b\xc9\x25\x0c\xfd\x76\xaf\xf3\x9f\xae\xd2\xea\x5a\xbd\xa4\xc9\xec\x10\x85\xcb\xa2\xc8\x7e\xce\x7f\xce\x2f\x0b\xb5\xd2\x5b\x40\xe2\xb6\x28\xaf\x61\xfd\xd4\x6d\x5a\x5f\xa9\xdb\xea\x9a\x27\xbe\x50\x65\xc3\x08\x3e\xb3\xcf\x9e\xa9\x75\xb1\xdb\xc5\x79\x72\x82\x03\xfc\x5c\xff\xbe\x6d\x4e\x23\x02\x28\x18\x05\x26\xcc\xcf\x1c\xf8\x71\x55\x69\x58\xd6\x76\xae\x00\x17\x06\x4a\x37\xba\xaa\x97\xf7\xf1\x2e\x53\x45\xe9\x3c\xd8\x01\x86\xe7\x1b\xb5\x6e\xca\x12\x51\x4e\x52\x58\xbe\xba\x28\xef\x55\x52\xe8\x0a\x1e\x5c\xc5\x37\x5a\xc5\xf9\xbd\xed\xa2\x36\x69\xa6\x17\x2d\x3a\x6a\x5f\xa6\x39\x00\xac\x11\xa5\x2b\x9d\xed\x15\x2c\x6d\x05\xbb\xb6\x64\x44\xb5\xda\x15\xd0\x0b\xa7\x03\x5b\x7d\x1b\xdf\xc3\x96\x6f\x54\x53\xd1\x3a\xd8\x41\xea\xc2\xcc\x04\xe6\x7c\x0c\x18\x36\xb9\x6f\x66\x71\xa9\x69\x51\x3a\x4b\xe2\xfc\xa1\x8e\x76\x6a\x1f\xd7\x57\xc7\x75\x71\xdc\x99\x78\x58\x2b\x75\x94\xd8\x17\x89\xdd\xcb\x81\x01\x0c\x86\xc3\x4f\x03\xb1\x98\x6c\x3e\x8a\xce\xcf\xf9\x69\x93\xc3\xc1\x81\x6b\xb3\xa6\xe3\x08\x0b\xd3\x8e\x5d\xea\x38\xa9\xd4\xba\xd4\x09\x36\x88\xb3\x4a\x6d\xca\x62\xa7\x7e\xff\xfd\xdb\x37\x67\xc7\x4b\x68\xb7\x2f\x8b\x7d\xa5\x56\xb0\xd7\x7a\x13\x37\x59\xfd\x73\xfe\xf6\x46\x97\xb7\x65\x5a\x6b\xf3\x08\xf6\x2d\xdf\xa4\x5b\xda\x74\xbc\xaa\x2f\x5e\x9f\x03\x0c\xa5\x3a\x2b\x79\x24\x8d\xfe\xd3\x69\xfc\x5f\x23\x0b\xf0\xb6\x94\xe3\x09\xbb\x0d\x47\xb8\xbe\x2a\xf5\xc8\xe0\xf1\x3e\xbd\xc2\x13\xf4\xfd\xdb\xf7\x97\xf8\x67\x03\x77\xe7\x87\xb3\xbf\xc1\x4f\x7b\x8b\xd5\x8f\xa7\x6f\xce\xde\xbf\x3b\x7d\x71\xe6\x85\x1a\x70\xcf\xab\x2b\x20\x48\xe3\x44\xeb\x5d\x59\xdc\xa4\xd0\x58\xc5\xaa\x6a\xe0\x7e\x96\xb8\xca\xd8\x1e\xcf\xf4\xc1\x49\x5d\x69\x3c\xe4\x86\xba\x1d\x9b\xbd\x86\x3b\xb9\x8a\x2b\xf8\xff\xa2\xbd\x99\xce\xde\xaa\xbf\x9d\xbe\x79\xbd\x0c\xc7\xd7\x4f\x98\x4e\xe1\x5a\x15\x99\x02\x5c\xf0\x7e\xd1\xdd\x94\x55\xbd\x2f\x9a\x52\x15\x80\xef\x2d\xe1\xbb\x17\x3a\x2b\xd7\x32\xee\x5e\xf6\x70\x5c\xe0\xf4\x54\x08\xdb\xb7\x78\x40\x28\x88\xce\x49\x3b\x95\x37\xbb\x95\x2e\x71\xed\xec\x86\x07\xc3\xaa\xee\xf3\xf5\xf8\xbc\x61\xce\xd8\x88\x27\xdb\x6e\x8e\x9d\xec\x4a\xd7\xb7\x5a\xe7\x6a\x9d\xa5\xb8\xec\x40\x78\x60\xa9\x4a\xc0\x2d\x98\x29\x84\xe3\xe0\x6c\x2f\xc2\x31\x47\x81\x1e\x74\x8e\x8e\x7f\x2b\xb0\x5f\xb1\xc7\xf1\xe3\xcc\x1d\x0f\xb7\xc8\x34\xa7\xa3\x83\x74\xe1\x65\xba\xd9\x68\xa2\xe8\x86\xe2\x02\x8f\x41\xde\x4d\xe8\x9c\x74\x89\x10\x3e\x3a\x7c\x12\x48\xc1\x46\x9b\xba\xd4\xeb\xf1\x63\x1c\x01\xa1\xfa\x15\xd8\x12\xde\x77\xf5\xee\xe2\xed\x5f\xce\x5e\x5c\x06\x9f\x13\xb3\xd4\x9e\x7d\xfa\xe0\xe5\x33\x44\x2c\xf9\x40\x84\x9e\x87\x50\x58\xa5\xde\x15\x37\xb0\x69\x07\x30\xe1\x3a\xae\x41\x32\x80\x9d\x6b\x85\x22\xc2\x03\x6f\x4d\xe7\x24\xf4\xe9\x45\x47\xce\x48\x74\xa6\x6b\xdc\xec\xe1\x49\x75\x06\x63\x76\x0e\xa7\xe3\xe4\x5f\x8e\xbd\x0d\x8f\x34\x74\x1a\xd4\x97\x45\x9e\xdd\x93\x7c\x05\x73\x04\xf1\xa1\x1d\x8b\xa4\x3f\x3a\x60\xbb\x22\xd1\x5f\x05\x9f\x1b\x7d\x37\xc2\x07\xce\xe8\xa5\x12\x4c\x3a\x8b\x6b\x97\x3c\x9c\x82\x03\x0f\x4f\x81\x93\x79\x60\xbd\x4e\x2b\xa6\x9a\xa6\xdd\x82\xb9\xb1\xbe\xab\x75\x5e\x91\x7c\x8b\x07\x22\x4b\x77\x69\x4d\x37\xbd\xee\xc8\xa3\x78\x82\xd3\xb5\x0e\x96\x73\x03\x91\x01\x39\x16\x84\x8b\xf8\x26\x4e\xb3\x78\x05\xd8\xc4\xfc\xd8\x30\x6b\x92\x70\xe1\x41\x5a\x5a\x49\x42\x4e\x2d\xc8\x97\x71\x8d\xc7\x3a\x8b\xb7\x30\x15\x33\x96\xd2\x31\x9c\xfc\xee\xc4\x14\x48\x94\xf6\x1a\xd0\x88\x38\x06\xf6\xe8\x4e\x36\x66\xe5\x60\xa9\x7e\xc2\x36\x20\x8e\x5c\xe9\xf5\xf5\x02\x1a\x09\xae\xf2\xde\x34\xb7\x47\xdf\x90\x58\x07\x2b\x92\x3c\xdb\x59\x21\x66\x56\x8e\x85\x16\x71\x56\x6c\x85\x98\xca\x56\xa0\x90\xc5\x1b\x7f\x0c\x73\x3f\x46\x2d\xce\xa2\xc5\x6b\xb1\xc0\x69\xac\x61\x76\x2c\x8b\xe3\x9b\x06\x36\xc5\x48\x56\xad\xc4\x8d\x93\x03\x2d\xac\xd4\x15\x36\xbd\x05\xb1\x4e\xa5\x35\x76\x2e\xb2\x04\xda\xd7\x57\x71\x0e\x93\x33\xa0\x8f\xea\x3a\xb3\x33\x2e\x36\x9b\x2c\xcd\x35\x0d\x2e\xa0\x04\xdb\x05\x4e\x89\x94\x9f\x3c\xde\xc3\x79\xab\xd5\x0a\xae\x52\x66\x16\xd4\x51\x42\x52\x64\x22\xf2\xbc\x68\x60\xbf\x48\x63\xc4\x15\xc2\xee\x39\x30\x4b\xb8\x5d\xcb\xd9\xa7\x3a\x92\x29\x79\x0e\xd4\xcb\xe2\x36\xcf\x8a\x38\x11\xd4\x0f\x56\xb8\xa5\x58\xe6\x68\x11\xff\xdc\x27\xb0\x5d\xed\x74\x43\x4f\x77\xc0\xa5\xae\xf0\x7c\x00\x07\x4e\xc6\x6f\x37\x72\xf6\x0e\x41\xde\x34\x39\x1d\x33\xe6\xc7\x1e\xdd\x07\x7b\xa1\xb2\xc7\x78\xf4\x28\x2e\x3f\xf4\x10\x38\x87\x80\x72\x3b\x9d\x1c\xcd\x10\x70\xf1\xb6\x45\xb0\x82\x11\x2e\xa1\x67\xfe\x2c\x0b\x9e\xbe\x3b\x57\xbf\xa0\xac\xfd\x4b\xe0\x88\xe3\x42\x9f\x33\xe8\x5f\xcf\x2e\xde\x9f\xbf\xfd\x31\x68\x5c\x10\xf2\xa3\x6b\xed\x63\xa4\xf8\xba\x28\xd3\xdf\xe8\x81\xfa\x05\xb4\x81\x90\x41\xd7\x1a\x8e\x25\xee\x8e\x67\x54\x5c\x5f\x73\x77\x97\xd8\x98\xb6\x32\x64\x60\xba\xc9\x9e\x51\x5d\x05\xea\x4b\x43\x0b\xe1\xae\xf5\xd4\xb0\xaf\x42\x56\x25\xcb\x8a\xdb\x48\xc6\xf0\x51\x68\x6a\xa4\x6c\xa3\xe9\x51\x5b\x56\x39\xb6\x2e\x56\x41\xb7\x32\x67\xc0\xd0\x40\x56\x6f\x52\x7d\xeb\x19\x17\xe8\xc4\xad\x33\xe8\x71\x47\x28\xde\x67\x71\x1e\x00\x01\xce\x48\xf0\x96\x42\xdb\x50\xc4\x79\xa5\x85\x10\x8c\x2e\xb4\x21\x12\xd6\x74\x55\xa3\x10\x06\xa4\xa1\xbc\x06\x12\x62\x46\x08\x59\x2a\x1a\x27\xc2\x4b\xef\x9b\x8c\x80\xa2\x26\xd3\x23\x1a\xea\x30\xb1\xab\x1d\x41\x30\x60\x58\xab\x74\x7b\xc6\x6d\xdf\x07\x4f\x7a\x02\x43\x96\xc1\x81\xa8\x56\x66\xb5\x03\x86\xae\xea\x32\xf5\x8e\xcc\x5b\x47\x5c\x18\x2e\x0a\x30\xce\xc4\xf0\x1b\xa3\x9a\x06\x40\x80\x31\xbd\x8b\x40\xef\x14\x70\xd1\x7d\x53\x07\x1f\x37\x00\xbd\x2a\x2a\xdf\x90\xf2\x76\xee\xa0\xfb\xb8\x8c\x77\xde\x05\x86\x77\xba\x86\x55\xb8\x89\xb3\x46\x93\xa4\x8c\xc4\x54\xfd\xf5\xf4\xf5\x87\xb3\x5f\x50\x90\xde\xc5\x33\x41\x8d\xdd\xc6\x5f\x5e\x9d\xbf\x86\x61\x81\x22\xd6\x71\x4a\xca\xe8\x10\x06\x7f\x79\xff\xf6\xc7\x05\x29\x35\x28\xba\x24\x05\x08\x83\x37\x82\xca\x02\xb8\x7d\x8e\xd7\xab\xd4\x7b\x8d\xf2\x5a\x10\x79\x63\x4a\x18\xed\xd2\x3c\x12\x49\xd0\x83\xdf\x26\x46\x41\x8c\x2d\x14\x8c\x4e\x75\x15\x97\x68\xae\xb9\x47\xf9\x36\xd3\x31\x09\x94\x40\xbc\x5b\xc3\x85\x23\x5b\xc6\x46\x79\x87\x59\xc0\xb1\x45\x01\xd5\x00\x5f\xa8\xe7\x20\xe8\x55\x28\x53\xc2\x1c\xc3\x96\x34\x2e\x77\x55\x24\x23\x7a\x37\x90\xe1\x19\x81\x13\xfb\xa8\x8d\x06\x8c\x49\x92\x03\xb4\xf1\x71\x2d\x26\x73\xc0\x66\x0d\x2d\x58\xe6\xad\x50\x48\x6c\x82\x6e\x3c\xc8\x1b\x19\xec\x17\xef\x6f\xe5\x25\x4e\x68\xdc\x01\xa1\xb5\xd4\xed\x02\x22\x62\x24\xd7\xb7\xfb\x0c\xb2\x90\x08\x75\x01\x90\x51\x82\x8f\x7e\x4b\xf7\xc8\x61\x6b\xd4\xe5\x46\x81\x23\x58\x36\x86\x88\x98\x1d\x97\xeb\xab\xf4\xa6\xc5\x82\xf7\x8a\xa4\xb5\xdb\x2b\x5a\x9b\x7b\xb4\x26\x63\x73\x10\xb4\x61\xe7\x33\xbd\xa9\xf1\x82\x05\xf1\xfc\x44\x47\x55\xfa\x9b\x8e\x48\x17\xf1\x20\xb6\x8b\xef\xd2\x5d\xb3\x53\xd8\xd0\xec\x12\xf6\xa4\xe3\x92\x1b\x84\x00\xfe\x9b\x3f\x81\xa0\x0e\x57\xbc\x4c\x13\x23\x71\xd3\xb8\x62\x5e\x6c\x37\x53\xa4\xe0\x10\xfa\xaf\x77\xa0\x55\x44\xab\x26\xd9\x6a\x1f\x7e\x75\x01\x02\x37\x63\x47\x48\x58\x15\x89\xfa\xa2\xc9\x30\x31\x9a\x5d\x85\xd2\x28\x91\x48\x83\x89\x9c\xfc\xae\x95\x73\xc1\x4b\xbd\x2e\x90\x9e\xe2\x4c\x6e\x41\xf9\xe4\x01\x64\x0d\xf0\x5e\xe0\x1a\x04\x4c\x41\xd4\x1a\x0f\xf2\x70\xc6\x07\x14\x9e\xae\x7a\x26\xd7\xd9\xe8\x3c\x46\x09\x5a\x78\xb4\x9c\xf0\xd5\x05\xa0\xba\xac\x03\xc4\x8e\x17\xa7\x0a\x5b\xa6\x1b\x34\xd1\x6b\xbe\x98\x40\x1d\x60\xaf\xd1\xfe\x3d\x0f\x68\x9a\x57\x7a\xdd\x94\xbe\x05\xa9\xae\xd3\xbd\xb1\xaf\x32\x3c\x3c\x5d\xe6\xd8\x39\x48\x74\x15\xd4\x00\xc0\x56\x99\x03\xa5\xd3\x03\x1c\x85\x39\xd4\xb1\x26\xb6\xc4\xaa\x99\x2b\x0d\x34\x5d\x8b\x86\x6b\xd5\xde\x00\x5c\x7e\xad\xbc\xca\x46\x4b\x07\x98\x4b\x1a\x76\x12\xb2\x9f\x68\x33\x98\xb2\x7e\x3c\x81\x45\x41\x20\x2c\xc4\x9e\xa1\xaa\x06\x68\x73\x85\x0d\xe1\xa6\x67\x70\xa8\x48\x10\xf7\xe3\x4b\xda\x04\xf0\x32\x3a\xb3\xa4\x27\xf9\xd5\x24\x54\x40\xb1\x45\xeb\x1f\x52\x28\x03\x23\xff\x2a\x8c\x67\x07\x4e\x13\x5a\x38\xc2\x21\xca\x99\xf1\x43\x44\x5d\x8f\x14\xf5\xcf\x82\x33\x25\x66\x22\xa4\xd6\xff\xf3\x28\x50\x32\x95\x31\xcf\x7b\x7f\x3e\x1f\xff\xf1\x8f\x25\xfe\x7e\x78\xf8\xb4\x60\x83\x00\x3c\xa8\x8a\xa6\x5c\xeb\x87\x87\x20\x98\xbc\x61\x53\x30\xc9\xc9\x25\x7b\x55\xe9\xfa\x71\xb0\xec\xf2\x4c\x41\xeb\xac\x23\x4e\xd1\x3e\x78\xfc\x3c\xf7\xe9\xf6\x36\x02\x9e\x1d\xe7\xb0\xc0\x49\xc8\x1a\xff\x19\xae\x0b\x9a\x48\x2e\xa9\x93\x3a\x7f\x69\xb0\x69\x9a\x34\xf9\x4c\x44\x98\xca\x47\x75\x71\xad\xf3\x39\xb8\x70\x3f\x45\xfd\x1e\xb7\x17\x4d\x0e\xaa\x20\x88\x91\x59\x94\x15\xeb\x38\xf3\x7a\x06\xa4\x95\x63\x60\xea\x5a\xc0\xa8\xb7\x88\xa5\x81\x00\xc5\x64\xf7\x68\x90\x40\x4b\x75\x09\x83\xa0\x04\x80\xdb\x50\x66\x13\x73\x6d\xd5\x77\x60\x8f\xf9\x5a\x67\x99\x57\x79\x7e\xfb\xc3\x52\xbd\xe0\x36\xad\xf4\x40\xa6\xff\x40\x00\x1b\x20\xa8\xde\xd1\x9d\x18\x8c\x24\x4d\x84\x34\xec\xf6\x19\x48\xa0\x40\x70\x71\x4b\x37\x4d\x96\xdd\x2f\xd5\x45\x03\x1a\xcf\xa1\x93\xe1\x17\xb2\xd3\x91\x93\x06\x45\x57\x74\x9e\x67\xf7\xad\x29\x9a\x0d\x82\xa1\x98\xb2\x04\x07\x0a\x69\x5c\x37\x3e\xc6\x72\x04\xff\xbe\x83\x7f\xc3\x71\x24\xef\xa9\xab\xc2\x06\xd8\xd0\x0f\x35\x48\x52\x7f\x67\x64\xf1\xca\x9a\xd3\xf9\x24\x0b\x37\x4b\x8d\x32\x76\x32\x32\x3d\xd2\xca\x22\x34\xdc\x7a\x37\xe1\x35\xbd\x54\xd0\x2e\x2d\x8b\x9c\x26\x72\x03\xda\x07\xeb\x40\x74\xc0\xf0\x72\xa3\xa8\x04\x97\x7b\x19\xb4\x94\x14\xe3\xa4\x93\x90\x7d\x37\xfb\x9d\x28\x09\x8c\xe2\x1d\x1f\xdb\x33\x2b\xe4\xb0\x49\xd9\x73\x6d\xac\xac\x38\x24\xdc\x88\xdc\x03\x17\xc6\x99\x1b\xfa\xf9\xe1\xaf\x8d\xae\xf1\xe5\xc4\x1d\xea\x9b\xcd\xbd\x73\xf5\x78\x24\xf0\xb7\xc3\xa5\xd4\x55\x8c\x12\x3a\x7a\xb9\x87\x50\x0b\x5d\x0f\x02\xe3\x0b\xe4\xf2\x40\xb6\x86\x7b\x87\x54\x9e\x84\xc1\x23\x59\xac\xb8\x1e\x99\xb9\x3b\x5d\x8e\x21\xf1\x0b\x65\xee\x56\xa0\xba\x67\x85\xb1\xd1\xd9\xaf\x9a\x34\x4b\xf0\xd6\xa2\xf6\xe5\xc1\xe4\x4f\xd8\x86\x24\x3e\x56\xe4\x10\x12\xff\x44\x58\x24\xe1\xe1\x23\x10\x32\xa7\xd6\x5a\xa0\x81\x00\xbf\xf7\x42\x7b\x8f\x6f\xcd\xe9\xa3\x0e\xad\x01\xa2\x0b\x7a\xd1\x99\x33\x4a\xdc\x7b\x32\xe0\x02\x57\x0b\xc1\x62\xe2\x96\xe1\xac\xeb\xe1\x29\x93\xe1\x23\xf4\x7c\x05\x28\xf6\x2f\xe4\xb5\xd5\x58\x5a\x8d\xfe\x74\x08\x7e\x9f\xac\x9c\x8c\x43\xe7\x8e\x91\x75\xc1\x79\xb0\x10\x73\x42\xeb\xa9\xeb\xf8\x1d\xc7\x41\xa4\xf9\x3a\x6b\xfc\x6b\x69\x5e\xa3\x52\x8d\x78\xcb\xdf\x80\xfa\xf8\xb0\xfa\x6e\x74\x58\xf3\xda\x0c\x2b\x7f\x13\x31\xea\xde\x8e\x09\xec\xb7\x39\x28\x69\x5e\xe4\xf9\xad\x01\x02\x6b\x8e\x02\x03\x5d\xb3\x5a\x91\x3f\x12\x9e\xe2\x7f\x1f\x1e\x0c\x71\x90\x7d\x19\x87\x7a\xad\xf7\x3e\x05\x03\x5f\x3d\x39\x3c\xb9\x76\x63\xa6\x4b\x14\x21\x62\x10\x09\xb6\x4d\x16\x97\x12\x0d\x57\x2a\xbd\xdb\xd7\xf7\x21\x2b\x38\x36\x34\xb7\x98\x32\xbf\x5b\x73\x13\x5a\x89\xc6\x58\x82\x58\x97\x06\xaf\x07\x50\x02\x24\xc6\x30\xcc\xc3\x03\xdb\x9b\x1c\x4b\x13\x74\xa2\xe5\x83\xdf\xc0\xa3\x42\x50\x59\x67\x7e\x73\xf5\x4c\x5c\x68\x28\xa4\x51\x9f\x83\x0f\x6c\xcd\x56\x8f\xea\xa6\xd2\x42\xcc\x84\x14\x91\x20\x97\x3a\x65\x5e\x92\x52\x80\x29\x11\x99\x05\x9e\x33\x14\x23\x80\x0d\xa3\xc1\x02\x41\x9c\x8c\xc9\xdd\x8f\x17\x7f\xdd\xbe\x13\xc2\x7d\xa8\x08\xfc\xc1\x75\xa6\x8d\x0a\xc1\xc1\xf0\xa6\xf8\x42\x07\xe4\x23\xe4\x2f\x89\x78\x8e\xc8\x88\x42\x37\x06\xf5\xd0\x28\xae\x23\xbc\xc5\x1e\xa0\x86\xbd\x8a\xe9\x05\xe5\x2c\xe8\x58\xdf\xef\x91\x08\xb8\x9c\x68\x39\x0a\x9b\xdc\x77\xf7\x91\x11\xf1\x27\xa2\xe9\x61\x58\x50\xce\x05\x00\x22\xd9\x91\xb4\xdc\x09\x5b\xa5\x21\x1c\xba\x3f\xfc\xfe\xa5\x79\xaf\x06\x11\x80\x29\x4e\x82\x68\x63\x50\x9f\x6e\x8a\xed\x98\x21\x93\x34\xad\xfd\xd3\xfc\xd0\xb6\x18\x9c\xe8\xe8\x3c\xa1\xab\x86\xfe\xf9\x7a\xce\x72\xb6\x9d\x1e\x0f\xa7\xbd\x22\xde\x35\x7d\x39\x08\xe6\x73\x0e\xce\x30\x16\x48\x18\xfc\x56\xe2\x97\x9d\xc8\xd3\xe1\xa9\xff\x1f\xaa\xcd\x66\x3e\xf3\xce\xc9\xe7\xed\xe0\x21\x99\x7b\x9a\x3d\x0c\xbc\x19\x3e\x4c\xc6\xf7\xf1\x43\x2f\x86\xf8\x31\x3b\x39\x86\x95\xc4\x2e\x3c\x96\xe7\x10\x46\xcc\x01\x6c\x6c\xc4\x18\x2e\x2a\x69\xc8\x35\x69\xa2\xaf\x1c\x8e\xf8\xcf\x3b\x6f\x66\x8e\x9b\x02\xc6\x8c\x04\x5f\xa1\x54\xde\x03\x20\xb1\xb5\x83\x14\x52\x02\x78\x29\x0d\x09\xf1\x72\xc2\x77\x4d\x8a\x4d\x3f\xbc\x8c\x98\x14\xff\x26\x51\xb6\xa2\xb9\x50\x92\x4c\x1e\x6c\x17\x23\x37\xf3\x84\xb7\x58\xb2\xa9\xc8\xdc\xab\x9c\x48\x24\x90\x6f\x30\xc2\x22\x59\x90\x26\xdd\x5a\xa0\xec\xb6\x21\x1e\x6d\x1c\xa7\x71\x3a\xc7\x4e\x90\xb2\x9b\xdb\xc0\x12\x95\x9c\xfe\x92\xa3\xef\xa7\xf2\xad\xce\x2e\x2e\xde\x5e\xbc\xf7\xe0\xfd\x5d\xff\x9f\xe2\xe6\xea\xbb\xc3\x7f\x23\xec\xa7\x2c\xbb\x17\xed\x3a\x2f\x6e\xf3\x08\x25\x85\xe9\xab\x8e\xad\x48\x8f\xe6\x5e\x4b\xe5\x84\xed\x51\xe4\x71\xd5\xec\x39\x78\xf0\x98\x02\xde\x96\xd5\x7d\x55\xeb\x9d\x5a\xa5\x39\xda\x07\x2a\x54\x16\xb6\x69\x7d\xd5\xac\x96\x70\xf6\x6d\x90\xff\x38\xbf\x04\x84\x85\x67\xae\x4b\x8c\x76\x18\x4b\x2f\x54\xd4\xa4\x73\x2c\xc9\xfc\x40\x79\x89\x26\x23\xeb\x04\x5f\xc2\x13\x78\x89\xa2\x2f\xbf\x43\xf1\x99\x5e\xe0\x8f\x09\xe3\x94\x83\x12\xdf\x95\x51\x94\x92\x83\x9b\xf2\x4f\x42\x09\x23\x1e\x40\xd1\xbe\x29\xae\x7d\x08\xbd\x22\xb2\x85\xe4\x82\x9b\x71\xac\x80\x36\x61\xb6\x16\x53\x09\x95\x90\x57\xff\x1c\x6c\xd1\xfd\x63\xbc\x5c\x28\xef\xc6\x23\x96\x87\x4b\xd6\xd4\xb9\x0d\x39\x84\x3e\x9a\xc5\x24\x0d\x4a\xc6\x99\x84\x69\xb4\xfd\x08\xa8\x2f\x13\x3b\x0f\xc0\x37\x6e\x34\x18\xd1\x6a\x6a\x8d\x0a\x36\x39\xb5\x43\x6d\x3b\x08\x94\xa4\x77\xc0\x70\x17\xd7\xeb\xab\x91\x09\xda\xe3\x81\x1d\x12\x02\x91\x18\x7a\x9a\xe6\xfd\xb0\x43\x7e\x6f\x6c\x5b\x98\xa5\x48\x68\x12\x10\x8e\x54\x46\xf2\x86\x8d\x76\xce\x20\x9d\x28\x37\x7e\x3b\x6d\x79\xc6\x49\x88\xa1\x11\x8f\x57\x9c\xa5\x89\x37\x43\x97\xde\x52\x6a\x25\x6f\x89\x0d\x28\x43\x58\xf2\x1b\x71\x19\xcc\xcb\xa4\x94\x85\xd6\xa2\xd4\xd5\x94\x27\xd7\xd9\xa0\x38\xb1\xd4\x17\x73\x10\xea\xad\x2b\x3b\xab\x09\xa3\x67\x95\x71\x17\xf4\x82\xf5\xd9\xce\xc4\xa6\x5f\x98\xce\xe7\x4d\x25\x26\x4b\x27\x1c\xd7\xa8\x4d\x73\x98\x36\x07\x2b\xe9\xd7\x9f\xa3\x9d\x0d\x39\xf0\x31\x9b\xb5\x8b\xfb\x72\x1e\x56\xb6\xdf\x08\x46\xfe\xc5\xc1\x6b\x1b\xa3\xbd\x89\x42\x45\x0b\xce\x93\x68\x0d\xda\xd8\x12\x1e\xea\x92\x84\x81\x3c\xe9\xcd\x25\x0c\xd5\x2a\xf2\xc7\xfc\xb4\xb4\x70\xab\x25\xb4\x87\x99\x57\x1b\x05\x79\x10\xec\x2e\x49\x24\x2d\xfd\x0b\xdd\xc9\x2a\x62\x1b\xd9\x3c\x1f\x86\x35\xe3\x1a\xbf\xc1\xd0\x16\x4a\xeb\x70\x4c\x38\x64\x67\xe2\x26\xe7\x85\xe2\xab\xfc\xee\xec\x4d\x27\x66\x86\xa9\x66\x18\xa4\x3a\xab\x26\x17\xdf\x26\x3d\x5c\xbe\x7e\xdf\x01\xe4\x9a\xaf\x1f\xb1\xde\xe2\x37\x9a\x88\x9d\xba\xf4\x3a\xb0\x72\x5c\x64\x0a\xb1\xb4\x99\x28\x9d\x1c\x93\xd0\x03\x48\x4e\x9c\x11\xa3\x0d\x06\x88\x21\x1f\x25\xda\xf0\x65\xf5\xd5\xa8\xdb\x06\x43\xbd\x02\xa2\x6a\x02\xef\xb1\x98\xfd\xf9\x70\x11\x4b\xb4\x37\xc0\x83\x70\xd7\x74\x89\xca\x1e\xae\x52\x4b\x3a\x9d\xf3\x68\x9d\xaa\x42\x00\x27\x89\xa5\xc4\xae\x58\x14\x26\xb7\xba\x29\xb3\xf9\xec\x88\x1d\xf8\x62\x17\xfb\x70\xf1\x9a\x23\x2b\xd0\xa5\x4f\xfc\xf1\x63\xc7\x70\xf6\x89\xf3\x7e\x43\x10\xd9\xc5\x19\xc6\xe6\x6a\xbf\x40\x21\xef\xc7\x30\x58\xaa\x4b\xcc\x7f\xda\xc6\x69\x3e\x65\xa7\x03\xb0\x18\x00\x66\x25\x28\x0c\xe0\xf2\x47\x40\x61\xf0\x17\x4c\x0f\x43\xc1\x40\x86\x8a\xd5\x1b\x59\x8d\x67\xd0\xed\x19\xca\x53\xe3\x90\x30\xbd\xc5\x06\x3e\xf1\xa1\x29\xca\xa8\xd2\xff\xd3\x80\x56\xe0\xbb\x5a\x6c\xed\x3e\x7e\x2f\xad\x0e\xcd\xde\x66\x4b\x88\xca\xf5\xf2\x30\x31\xf8\x84\x3a\xec\x53\x6c\x8d\xe1\xce\xa4\x5f\xc0\x85\x64\x25\xc0\xc9\x1d\x6f\x0f\xd9\xb1\x41\x69\x60\xcc\xa5\x7a\x87\x81\xcb\xda\xe4\x4c\x75\x25\x21\x92\x88\xc9\x37\xd4\xc3\x33\xc6\x1c\xf7\x5b\xbd\xea\x43\x98\xdc\x1d\x59\xa7\xf1\x03\xea\xf3\x08\x48\xaf\xa5\x3a\xaf\xd9\xa4\x82\xec\x91\xdc\x08\x9d\x14\x2b\x7b\xf1\x16\xbc\x3a\x45\x6e\x82\x17\x76\x38\x8a\xbe\x83\xf7\x21\x37\x49\x70\x35\x5b\x6c\xe8\x03\x12\x3c\x72\x2b\x7c\x26\xf6\x84\x78\x4b\x24\x6c\xc8\xa9\xc3\xbc\x38\x81\xaf\x4b\x2a\xb0\xdb\xc2\x92\x13\x12\x17\x44\x03\x58\x06\x4d\xc7\x2c\x53\x84\x26\x88\x5a\xa3\x7b\x33\x88\xc8\x0d\x4e\x0b\xe7\x61\xd7\x7d\x5f\xa4\x12\xd5\xcb\x76\x97\xda\x75\x8a\xb6\xd7\x79\x81\x76\x9d\x2b\xeb\x8f\x47\x43\x41\x97\xc2\x05\x4d\x23\x29\xd6\xd7\xba\x8c\xd2\x1d\x28\x5e\x13\xc7\x09\xb9\x19\x37\x57\xd4\x9c\x5d\xa9\xf8\x4b\x3c\x9e\xb1\x67\xc7\xd8\xad\xc7\xc4\x92\x7b\x02\xdb\xc3\xbc\xf9\xb5\x0e\x43\xd2\x3a\x9f\x82\xdd\x60\x55\x0f\x0b\x7d\x87\x4e\x95\xca\xe7\xf8\x5a\x00\x8e\x6c\x89\xb9\x27\xb3\x92\x35\x28\xaa\x33\xf6\xe9\x3a\x61\xef\x6e\x10\x44\x52\xd0\xe4\x72\x8d\x97\xa4\xd2\x1a\x18\x38\x4a\x4e\x47\xbf\xa5\xfb\x23\xe3\x74\x9f\x98\xe2\x1a\x63\xf7\xaa\xf8\x46\xcb\x56\xf8\x7c\xf4\x2c\x32\x60\x43\xf5\xd2\xd9\x84\xa9\xe1\x11\xe7\x28\xce\x30\x81\xf6\x1e\x44\x6a\x40\xce\x2b\x34\x21\xa5\x92\x96\x8a\x5b\x4e\x8c\x9d\x98\xf2\x07\xad\xc9\x27\x05\x99\x84\x2e\x36\x06\xb2\x47\x20\x36\x68\x5f\x30\xde\xdb\x1c\x83\xd5\xf3\x6d\xa6\xfb\x36\xd5\xf6\x4f\x73\x35\xea\xdb\x42\x59\x60\x14\xa4\xd7\xee\xb1\xf9\x8b\x19\x1c\xa6\x8d\x50\xa2\x33\x6c\xaf\xd0\x04\x89\xc9\x3d\x10\x00\x7a\x14\x9b\x62\x0f\x5a\x44\x08\xf5\x01\x74\x24\x4a\xe1\x80\xbe\xd3\xa5\xa5\x3c\x2a\x54\x8c\x0d\x52\xca\xd8\x8c\x34\xcd\xa1\xd2\x18\x33\x56\x6b\x1e\x9d\x73\xe8\x3d\x73\x0b\xbb\x18\x42\xec\x22\x9c\xf2\x5c\x7a\x03\x27\x9e\x56\xaa\xd2\xf5\x3c\x60\x73\x69\xb6\x00\x73\xe8\xee\x04\x3c\xc3\x05\xa3\xab\xf8\x06\x39\x06\x9d\x25\xf6\x52\x56\x82\x8c\x2f\x78\xc7\x15\x07\xcc\x30\x42\x85\xcc\xd1\x36\xb9\x68\xc8\x7b\x6d\x72\x08\x5b\x51\x6d\x06\x86\x98\x0e\x97\x96\x9a\x70\xd9\x12\x1e\x8f\xe4\x77\x3a\x4c\x54\xb6\x89\x3a\x90\x39\x04\xce\x46\x6c\xce\xb4\x19\x61\xe2\xf2\x17\x39\x88\xfb\x6b\xa4\xf6\x91\x49\x20\x82\x19\x96\x45\x65\x93\x92\xaa\xe9\xfb\x63\xec\x69\x38\x69\xf9\x2d\x73\x36\x73\x25\xe5\x62\xd7\x64\x75\xba\xcf\xd8\x24\xc7\x97\x07\x7f\x89\x64\x28\xd9\x4b\xc8\x46\x8c\x0c\xd4\xb3\x31\xd7\x6e\x10\xf3\x82\x9c\xf8\xb8\x08\x7b\x40\x36\x5d\xf1\x2d\xa0\x05\xb1\x99\x50\x04\xb5\x5d\x9e\x15\xca\x87\xf6\xa4\x13\x12\x07\x97\x50\x66\x42\x60\x0e\x2c\x4a\x33\x16\xb3\xc4\xb2\x65\xf3\x57\x12\xbb\x89\x29\x20\xd3\x43\x6b\xd8\xe2\x1f\x0f\xf2\x19\xa9\xab\x65\x97\xa0\xbb\x25\x4b\x2e\xa7\xf6\x14\x8b\x4c\x13\x1c\x5a\xe1\xb8\xaa\x8a\x75\x4a\x43\x0f\x63\x7c\x6c\x90\xeb\x2f\x3e\x4d\xfe\x51\x2b\x1f\x97\x6d\x4a\x01\x45\xb5\x7a\xcb\xf5\x48\xf4\x01\xc7\x11\x41\xb7\x86\x2c\x8e\xb8\x84\xe5\x16\x14\x16\x47\x6e\xa7\x71\x16\x6a\xcf\x28\x9a\x4a\x56\xb8\x1e\xf4\x66\x06\x46\x68\x0a\x7e\x2a\xac\x60\xac\x63\x4e\xba\xdb\xc7\x69\x79\x80\x5e\xf7\x35\xd1\x77\x7d\x17\xa3\x1b\x6e\xd1\x0e\x87\x06\xe6\x90\x39\x88\x34\x36\x9d\xf1\xe9\x9b\xc0\x97\x06\xe4\x57\x44\x83\x65\x3c\x4e\x13\x64\xc6\x65\x95\xff\x05\x7b\x7b\x1c\x53\x88\x39\x1c\xb6\x86\x98\x08\x70\x83\x89\xa3\xed\x90\x53\xc6\x01\xa0\xa1\x70\xe0\xd1\x91\x00\xea\x62\x15\x74\x6a\x2e\xa4\x0f\xab\x98\x7c\x7b\x3a\xa7\x04\x74\x91\x1b\x0d\xb4\x77\x83\xa9\x8f\xf1\x7e\x9f\x91\xb3\x9a\x02\xeb\xf7\x05\x8f\x23\x81\x2b\x80\xeb\xb2\x8d\x8f\x6e\xe7\xa8\x6b\x3b\x62\xb7\x89\xb9\xd0\xac\xdd\xb6\xe9\xb3\x43\x15\xc5\x4c\xbc\x1a\xd7\x58\xa3\xcd\xdf\x14\x98\xb3\xcb\xd8\x20\xee\xb4\xbe\xfc\xf3\xe1\x61\x5a\x2b\xde\x72\x82\x44\x84\xca\x28\x85\xe7\x4c\x29\x7c\x4e\x52\x05\xf6\x69\xbd\x09\x30\x1a\x3e\x70\x82\x55\xfb\x6a\x14\x35\xdd\xb7\x51\xeb\xec\x63\xed\x4b\x4d\xa2\x0a\x96\x1a\x81\xde\x08\x00\xeb\x96\xeb\x8d\xb1\x0c\xd7\xfb\x41\x07\x1e\xe7\xec\xa7\xa3\xba\x45\xab\x42\x07\x29\xf7\x26\x81\xac\xed\x36\xad\xc4\xf6\x90\x9d\x30\x4f\x8c\x09\x22\x2d\xca\xe6\xc5\x6c\xa4\x83\xed\x04\x46\xd9\x86\x4d\xa9\x40\xcd\x1b\x2b\xa0\xda\x1a\x4d\x4b\x0d\x2c\x42\xdf\x38\xe6\x72\x4b\x15\xc6\xa1\xb5\xbb\x68\x2e\x3a\xd7\x18\x31\x19\x41\x63\x67\xf7\x43\x1e\x0b\x7f\xe3\xec\x4b\xe2\x84\xed\x06\xfd\xc7\x70\x7c\xe3\x29\x6a\x45\xb1\x7d\x21\x3e\x3b\x97\xda\x31\x39\xc6\x97\xf4\x6b\xda\xb8\xdf\xa1\x1b\x91\xa1\x04\xac\xdb\x7b\xcd\xfd\x7f\x95\x66\x9d\x30\x05\x73\x79\x26\x05\xec\x2e\xc8\x06\xff\xda\xa5\x79\xec\x37\x22\x9c\xdd\x51\xbc\xa4\x99\xb6\x99\x99\xcb\xa9\x28\x18\x22\x2b\xd8\x10\xf7\xf0\x6c\x16\x06\xe3\x3b\x35\x02\x9c\xdc\x10\xb3\x40\x91\x7e\x8a\x82\xf9\xe4\xb1\xe0\x1a\x50\xc6\xb1\x78\x60\xbb\x9f\x03\x74\x9b\xd6\x14\x07\xe9\xcd\x28\xef\x41\xc5\xb5\x84\x3e\x8a\xfb\x90\xbb\xb4\x63\x90\x99\x89\x8c\xa5\x90\xcc\xf6\x28\x3a\x22\xc4\x85\xcb\x47\x79\xc0\x19\xcb\x25\x07\xf0\xf2\xc2\x48\xcb\x99\xc0\xf1\x82\xd7\x20\xa3\x4c\x02\x36\xa4\x2b\x57\x17\xaf\x5e\x7c\xfb\xed\xb7\xff\xae\x6c\x5f\xf5\xa5\x5e\x6e\x97\x0b\xf5\xcd\xf3\xe7\xff\x76\xf4\xfc\xeb\xa3\xe7\xdf\x5c\x7e\xfd\xc7\x93\xe7\x7f\x38\x79\xfe\xc7\xbf\x7f\x35\x13\xa1\xf1\x4a\x47\x87\xe8\xc0\xfd\x02\x6e\x5c\xa7\x6b\x5b\xf0\x52\x90\xf9\x7a\xf9\xcd\xf2\xdb\xb9\xd0\xeb\xa2\xa0\x22\x56\x21\xe0\xb1\x9d\x29\x19\x86\xbe\xee\xf8\x0e\xa4\xbb\xf5\x15\x8c\xb8\x0e\x60\x7f\x7d\xc8\x48\x60\xd2\x3c\xd2\x79\xb3\x0b\x9d\xbb\x58\x64\x67\x10\xb7\x3e\xd0\x95\xa6\x12\x3c\x69\xd0\x72\x53\x71\x18\x9a\x2d\x99\x40\xd2\x9c\xaa\x2e\x90\x9b\x3f\xcd\xe7\xc3\x8e\x57\xc5\x0d\xba\x7b\xef\x42\x60\x6f\x89\x0b\x96\x0e\x78\x29\xfa\xf0\xd1\xac\xfc\x14\x78\x32\xf5\x4d\x02\x15\x2e\xc3\x36\xc2\x8f\xad\x85\xf0\xd3\x00\x23\xe9\x58\x15\x0f\xf1\x71\x3c\xbe\x52\xcc\x61\xcf\x85\x6d\xcb\x20\x4c\xe1\x52\xa7\xbb\x38\x03\x76\xe0\x25\x53\x7e\x64\xd1\x58\xca\x92\xf2\xbe\xb8\x45\x71\x0e\x8e\xc9\xd7\xcf\xbf\xf9\xc3\x82\x9c\x7c\x64\x44\xce\xb9\x65\x9a\x57\x35\xd2\xb8\xde\x39\x6a\xe5\xbf\x98\x87\xe0\x11\x9e\x3f\x57\x31\xd7\x7a\x5c\x63\x1d\x94\x23\x1c\x85\xd2\x0b\x66\x88\x7d\xac\x86\x46\xe8\x44\x19\x8b\x39\x37\xee\x35\x69\x7f\x44\x4e\x97\x7e\x78\x4c\x28\xc9\xed\x00\x25\x53\xd2\x1a\x44\xf3\xb4\x9a\x29\x70\x6e\x75\xae\x4b\x2e\xac\xd8\xcb\x81\x62\x3b\xe5\x95\x6b\x0e\x22\x03\x13\x05\x19\x18\x2b\x93\x38\x5f\xc3\x6c\x43\x14\x6f\x15\x84\xea\x2b\x2d\x01\x3d\x62\xce\xf1\xe1\xf2\x78\x34\x44\xa8\x9b\x8a\x6b\x1a\x5c\x33\x80\xb6\xe9\x23\x28\xf4\xcb\xf2\x2d\xb1\xaa\xce\xc0\xa8\xd8\xe3\x46\xf8\xd7\xe4\xad\x79\xef\xca\x85\x23\xa8\xc4\x70\x8b\x36\x7a\x7d\xbf\x46\x07\x32\x68\x93\xf5\xc2\x3a\xb8\x0c\x99\x65\x41\x7c\x61\xcc\x25\x26\xf6\x6e\x1e\xba\x42\xe6\x31\x3c\x2e\x79\x0a\xd4\x35\xa6\x17\xc9\x78\x48\x66\x9c\x52\x93\x4f\x84\xbf\xa9\x61\xc4\x76\x7d\x84\xed\xa3\xd7\xc3\x66\x48\xd9\x64\x71\x33\xc6\x3c\x0d\x34\x56\xb4\xe5\x91\x42\x31\x58\x97\xde\xfd\x36\xd4\x02\x9b\xe0\xcc\x8d\x84\x6a\x3e\x4a\x20\x43\xd0\xd9\x1f\xc4\xf3\x24\x54\xc9\xb1\xd8\xa0\xf4\xf3\x5b\x91\xfb\x23\xc7\x29\x8a\x55\x99\x66\x3d\x61\x39\x14\xaf\x50\x6c\x12\x7f\xec\xaa\x59\x1b\x1b\x02\x98\x98\x08\x98\xf9\xe8\x38\xc7\x2a\xe6\x71\xd8\x0b\x59\x39\xb2\x9f\x2b\x07\x2a\x92\x03\xf1\xb0\x0d\xc9\x86\x73\x26\x07\xbc\x62\x34\xeb\xec\x29\x66\x67\xa8\x81\x74\xd8\x34\x35\x68\xa2\xa1\x48\x56\x75\xb1\x8f\xb8\xe0\x0e\x67\x34\x8f\x20\x8b\x6d\x19\xd1\xd9\xb8\xb1\x99\x0b\x0d\xa1\x04\x84\xf3\x8d\x03\x51\x24\x72\x01\x3a\x57\xa9\xc7\xe2\xfc\x02\x70\x61\xba\x43\x03\xb5\x35\x86\xaa\x76\x5a\xa1\x08\x81\xa4\x64\x1d\x1f\x13\xa7\x17\x9a\x1e\x75\xec\xea\x9f\x79\x78\xf7\xa0\x1b\x93\x0d\x8b\xcb\xcc\x85\xfa\xa4\x30\xab\x02\x6e\x73\x8e\x35\xcf\x61\xf6\x45\x76\xa3\xe7\xb2\xc3\x6a\xcc\x38\xa4\x53\x96\x18\x5a\x6e\x5d\xb6\x1d\xfa\xd1\x5b\x79\x51\x76\xf9\x7a\xec\xf8\x30\x59\xd6\xc3\xd7\x2b\x40\xb3\xa9\x45\x14\x30\xf7\xf5\xd8\xba\x19\x8e\x85\xef\x1e\xcb\x38\x9b\xce\xa8\xad\xdf\x4c\x7c\xe2\xa1\x0b\x85\xbc\x74\xb6\x79\x8c\xc3\x64\xb0\x7c\x5f\xa5\x33\x9c\x6d\x59\x34\xdb\xab\xc0\x22\x16\x9e\x8d\x12\x93\xc8\x53\xed\x92\x55\xc0\xc9\x49\x8e\xa7\xaf\x2d\xed\xd3\xaf\xeb\x13\x8a\xac\x91\x4f\x31\x33\x13\xe4\xf1\x7d\x35\x77\xe1\x7a\x5c\xd6\x75\x5b\xe2\x70\x7c\x16\xfa\x61\x3c\xa1\xd8\xe1\x10\x62\xb1\xf7\x5e\x52\x32\xcd\x1b\xc7\xe8\x4a\x03\x1e\x18\xd1\x5e\x17\xc3\x51\x21\x23\xeb\x8b\x4e\x2f\xb5\x6b\x2a\x1a\xe5\x40\x68\x35\x47\x7f\xd1\x3f\xf7\x26\x61\x46\x92\x41\x5c\x5f\xbe\x1c\xe7\x79\xb7\x60\xd6\xda\x04\x89\xe9\x48\x5a\x25\xc6\xc4\xd1\x23\x3c\x41\xdc\xa3\x27\xd0\xc8\xf7\x34\x13\x2e\x72\x28\x03\xf7\x56\x69\xd2\x63\xba\x63\x2a\x38\x29\x56\x6d\xb0\x94\x2b\xca\x90\x6d\x0f\x2e\xba\xd1\xfe\x3d\x43\x1b\x73\x7a\x19\x0b\xb5\xb7\x34\xc5\x18\x3c\xf7\xbc\xe1\x1b\x32\x60\xe3\x8a\x0e\xa7\x05\x08\x41\x84\x1e\x89\xfe\xb5\x0a\x0c\x67\x75\x71\x0d\xda\xe6\x09\x94\x07\xf6\x6e\x98\xd0\xa7\xe4\x50\x09\x8b\x22\x68\x51\x7c\x02\xf6\x34\xbe\xc7\x83\x4c\x6a\xe1\x60\x5e\x76\x06\xf8\x7f\xc0\xb1\xf0\x6c\xd3\x35\xf6\xd5\x31\x26\x12\x6a\x49\xd2\xe0\xe6\x2d\x48\x81\xac\x28\xac\x8c\x08\x2e\x11\x1d\x4c\x41\x90\x04\xa7\xbb\x98\x9c\xfc\xc6\x62\x67\x96\x0b\x8b\xc8\xa4\x1b\xfc\xff\xba\xbc\xc7\xff\xa0\xef\x45\x7e\x60\xf1\xdd\x4f\x38\xce\x47\x18\xe6\x53\xc8\x24\x1c\x8f\xb7\x77\x2e\xb2\xa3\x2b\xf4\x2f\x14\x24\xcb\xd1\xec\xc4\x5f\x53\x39\x3a\x67\x08\x44\xb4\xfa\x64\x99\x37\xc8\x2b\x66\xdc\x7b\x20\xe2\x36\x8a\x7b\x65\xa2\x63\x29\x5f\xcc\xb0\x2d\x60\xa9\x66\xe0\x10\x24\x60\xdc\xd8\x9b\x0b\xd0\x96\xc9\xed\xc8\x8c\xb8\x09\xdc\xef\x53\xcb\x6c\x14\xd6\xb3\xce\xb7\xb8\x99\xa6\xf6\x30\xee\xe2\xaa\x28\x32\x1d\x4f\x73\x04\x50\xb0\x6b\x2f\xdf\xc6\x97\xe2\x46\x76\x7d\x44\x0e\x05\x33\xd7\x35\x94\x8a\x32\xc0\xc8\x18\x15\x7d\xf5\x5b\x45\x0d\xe5\xd6\xd6\x04\x69\x12\x62\xe9\x0f\x4b\x1b\x1d\x83\xb2\x79\x57\x85\x48\x31\x84\xc8\xf4\xe9\xc3\xbd\xe8\xc1\x3d\xc0\x6b\xfe\x41\x14\xf0\x58\x2a\x6d\xdc\x49\x57\x13\x03\x3e\x2c\x94\xf6\x38\xa7\x9d\x54\x67\x33\x51\x1a\x23\x69\x20\x86\x89\xf6\x8a\xe7\xa0\x1b\x49\xea\x6e\xf7\xbc\x5a\x8e\x9e\xf2\xc3\xd9\xdf\xbe\xa3\x42\xe1\xcb\x80\x70\x7d\xd4\x80\x76\xf1\x58\xb6\xed\xa1\x0b\x61\xc3\x69\xb7\xa4\x28\x71\x5a\xc2\x0c\x48\x26\x9c\x63\x2c\x69\xcc\x44\x6f\xe0\x07\x18\x74\x59\xfb\x92\x2b\xc3\x81\xc6\x49\x92\xf2\x07\xba\x22\x33\xe6\x08\x7c\x0f\x58\xd2\xa4\x31\x38\x63\x92\x3d\xb8\xa0\xd7\x40\x9d\xea\xd0\xa5\x9d\xe1\x96\xe9\xec\x5e\x51\xf0\xc7\x5d\x42\xe0\x50\x43\xd7\x07\x85\x8e\x98\x70\x1f\x94\x0b\x57\x0a\x3d\x3d\xc6\xff\x28\x5d\x1f\x3b\x63\xac\x19\x9f\xd6\x7a\x37\x66\x93\x88\xcb\x32\xbe\xe7\x38\x79\x7d\x7b\x30\x61\xea\x3d\x07\x62\x7c\x37\x03\xe2\xae\xa0\x70\x04\xd7\xcb\x37\x17\x60\x93\xa7\xc0\xf0\x03\x61\x52\x2b\x1b\xd0\xcf\x5d\x67\x2e\xa7\x9c\xfa\x74\xd4\xce\x53\xac\xa8\x34\x82\x6f\x51\xdb\x31\x66\xae\xec\x5c\xe0\x03\xeb\xfb\x38\xd8\x12\xe0\x1a\x15\x9b\x70\xef\xb1\x8d\x8a\x9d\xe7\x48\x75\xe1\x02\x55\xb9\x2d\xca\x24\xfc\xe6\x54\xf0\xb2\xda\xdc\x87\xb3\xb8\x2e\xcd\xdd\x4c\xd8\xee\x76\x71\x9b\x60\xd1\x95\x7a\xdc\xc4\x43\x16\x36\x66\x01\x0e\x8d\xd2\x40\x9a\x40\x49\x61\x82\xcd\x23\x1c\x76\x2e\xe0\x36\x10\x72\x8a\x42\x0d\x11\xa2\x7e\x06\x8a\x29\x2f\xd7\x3a\x09\x42\x71\xe2\xc2\x8d\x4e\x65\x81\x11\x4c\x46\x2b\x47\xf6\xe3\x74\xba\x25\x07\x96\x21\x58\x70\xe9\xf5\x60\x44\x4c\x4d\x18\xb8\x57\x49\xb3\xd6\x33\xec\x69\x0c\x6e\xb4\x22\xcd\xf4\x7c\xb9\x7b\xbb\xce\x3f\xe7\x94\xb9\xb1\xf5\xad\xf8\x4f\xa7\x17\x3f\x9e\xff\xf8\xe7\xf0\x5a\x25\xa6\xc3\xbc\x6a\x25\xf8\x1d\x6e\x5b\x10\x8d\x34\x2f\x6f\x08\x2a\xbc\x33\x29\xef\x5c\x68\x54\xec\xf0\x14\x51\x77\xc2\x99\xa6\x38\xb3\x4f\x63\x11\x79\x02\x8f\x4a\x66\xcf\xce\x2d\x75\x3f\x71\xe5\x3a\x9e\x13\x5d\x4f\xe7\xe1\x11\x64\x3c\xb9\x6d\x76\x6f\x24\xa5\xf0\xc7\x76\x95\xd2\xe4\xb3\x44\xc4\x26\x2a\x95\x9e\x9b\x6a\xb3\x4e\x05\x38\xfa\x46\x76\x55\x14\xf4\xd5\x98\x16\x82\x0d\x8f\x36\xdf\x90\x20\x39\x5b\xdf\x76\x86\xa3\xe8\x83\x30\xdc\xa7\x0f\xbb\xb7\x8a\x07\xc8\x49\x4d\x86\x9f\x43\x20\xc1\x5e\x71\x7d\x61\x53\x6b\x67\x20\x44\x70\x19\x86\x11\x07\xb2\x4c\xa7\xcd\x31\x04\xb4\x52\x1c\x56\x17\xc1\x6b\xc9\xa1\xb8\x33\x40\x92\xa2\x13\xdf\xe8\xcf\x01\x4a\xfd\xcd\x86\x9a\xba\x49\x26\x86\xca\xfd\x5a\xf0\x34\x62\x1c\xb1\x32\x5e\xc7\xb4\x1b\xcf\x31\x14\xb4\xe2\x66\x1d\x60\x84\x32\x0f\x17\x0a\x1d\x84\xde\x7c\xab\x91\x0f\x8d\xdb\xbb\x5e\x5b\xc0\x8e\xca\x27\xd3\xcf\xee\xb9\x72\x96\x1d\x6a\xa9\xce\x11\x0b\x54\x50\x96\xa1\x88\x94\x68\xf9\x9e\xb2\xba\x0d\x4d\xdf\x86\x06\x5f\x15\x99\x71\x4b\xf1\x67\x73\xb0\x26\x52\xca\x5f\x40\xb4\x35\xd0\x04\x8c\x54\x1d\x0e\x14\x5d\x08\x4d\x6b\xbb\x26\x73\x84\x09\x3c\x63\x8e\x32\xd7\xf1\x70\x32\x60\xd3\x98\x4a\x05\xcd\xcd\x87\x43\x4c\x20\x0a\x7e\xc0\xae\x68\x2a\xb7\x97\xad\x49\x11\x3c\x19\xe1\x88\x21\xc1\x94\x4f\x31\x19\xb2\xe3\xf6\x34\x67\x4a\xc0\x99\x9c\x91\xcc\xde\xe9\x1e\xba\x71\x72\xbe\xf0\x70\x3e\x45\xf8\x1a\x7e\xf4\x14\xf0\x48\x25\xbd\x95\xc6\x6d\xc3\xf9\x16\x2a\x24\x92\x6d\xf2\x4e\x74\x23\xaf\x8a\x68\x6c\x5f\x7e\x2c\x6c\x12\x45\x1b\x60\x4b\x1d\xba\x79\xb7\xc3\xb1\x60\xcb\x39\x98\x34\x39\x7e\x1f\x27\x92\xaf\x41\x8d\x99\x4b\x4c\x13\xef\x49\xe8\x29\xc4\xb6\xf0\x4d\xf7\xbb\xb9\x8f\xc3\x99\xea\x9c\x85\x29\x54\x0e\x81\x47\x7a\xcf\xd5\xf1\x38\x90\x5f\xc2\xd5\xb8\x68\x5a\x3b\x98\xa9\xa3\x37\xcc\xbd\x0d\xf3\x96\x1c\x67\xe4\xe1\x69\x6d\x0f\x0c\x4c\xee\xb2\x8c\x6f\xe0\x08\x91\xe4\x57\x4d\x1f\x04\x66\x5c\x63\x87\xb7\xcb\xb4\xec\x1d\xec\xb0\xae\xbc\x27\x7a\x08\x63\xa7\xab\x64\x2f\xa2\xfd\xe8\xbc\xd5\x65\x91\x72\xd2\x71\x5e\x4f\x54\x79\x21\x54\x4d\xd6\x04\xf3\x81\x64\xa2\xd4\x93\xb4\x32\x54\xd8\xa9\x88\x34\x50\x44\xc2\x5b\xd8\xe9\x51\xd5\x9c\x5c\x6c\xe5\x8b\x0a\xd1\x6d\x99\xd6\xd3\x15\xda\xa8\xad\xf7\x0b\x0b\xdd\xef\x17\x04\x16\xc8\x61\x72\xdc\x46\xc3\xc4\x65\x3d\x15\xb3\xd3\x46\xb0\x3c\x65\xc0\xce\xc2\xfd\x20\x1f\xc3\xa8\x30\x56\x05\x65\x3e\xe7\x83\xcf\x01\xee\x21\x9a\x12\x6b\x40\x49\x3a\x52\xf5\xda\xcd\x9d\x59\x17\x65\x22\x95\xec\xa9\x0a\xb6\x90\x01\xab\x20\x3d\x72\x61\x73\x4a\xb8\x8a\xf4\x9d\x5e\x07\x08\xc2\xa6\x84\x7f\xe7\x03\x19\x1b\xc5\x83\xf8\x19\x5a\x0e\x47\x18\x21\x50\xad\x77\x14\x3b\xca\xa2\xa8\xe7\xe1\x86\xd2\x44\x96\xe6\xcd\x58\x54\xb7\xc0\x98\x40\x48\x0a\xe6\x38\x39\x6b\x2c\x1e\xbd\xc6\xd1\x15\x0e\xd1\xd4\x93\x1f\x98\x20\xfc\xa4\x9a\x22\xb9\x6f\x47\x6b\x20\x1c\x14\xca\xeb\x88\x02\xbd\xe2\x08\x6d\xf6\x60\x46\x1f\xb0\x96\x58\x5f\x6c\x3d\x8d\x92\xc9\x49\x9e\x8c\xa9\xbd\x3c\xa8\x36\xe0\x2e\x8a\x7c\xe5\x53\xa3\x76\x1f\x56\xf0\x92\xa0\x3b\xb5\x66\x69\x51\x42\x90\x18\xac\xc4\x6a\x3e\xda\xd0\xcb\x82\xbc\x95\xda\x4d\x5c\xd6\x70\xa8\x66\x42\xc0\x0a\x39\xdf\xea\x35\x7c\x3a\xd1\xf9\xb8\xa9\xdf\x7e\xba\xb7\x95\x93\xdb\xae\x46\xef\x73\x4b\xc1\x86\x6e\x54\x94\x56\xd1\xbe\x59\x65\xe9\x7a\xa4\x78\x95\xb4\xb5\xf5\xe7\xe8\xeb\xc4\x98\x5b\x49\x1d\x0f\xb2\xaa\x29\xd2\x85\xb8\x2f\x30\x5e\x60\xa5\x94\xe0\x8d\x9c\x4a\xbe\xbf\xca\xdf\xc5\x92\x2f\xa3\xe6\xf7\x18\x6a\x1a\x22\xe1\x50\x1a\x1e\x7f\xbe\x7c\x42\x25\x3b\x24\x00\x14\x3d\x44\x59\x77\xf8\xf9\x4e\xbd\x3a\x92\xcf\xa5\xf7\x6b\x01\xe1\x45\x20\x72\xaa\x57\x0b\x56\xd4\xe4\x2f\xe9\x30\x29\x8b\xfd\x2b\xe5\x7e\xaa\x17\x45\x7e\x83\x22\x91\x18\x78\x5a\x20\x18\xbe\x14\x9a\x25\x3a\x38\xaf\x7f\x91\x34\xd1\xfe\x0c\x5d\x50\x76\x8e\x41\x49\xa5\x76\x96\xc6\x28\x5b\xea\x6a\x0f\xe2\xad\x1e\xf3\x3a\xf6\xd0\xa6\x88\x88\x7e\xbe\xb1\xbc\x37\x99\xc5\x0e\xd5\xb7\x1e\x44\x53\xfb\xe0\xaa\xae\xf7\x8a\xc4\x5b\x06\xcd\x69\x6f\xea\x05\xca\x61\x54\x14\xd4\x7d\xde\x66\x6a\x98\xc7\x32\x69\x1a\x05\xa5\xae\x16\xb3\xa9\x53\x6b\x76\xd6\x71\x22\xdb\x04\x51\x5f\x65\x36\xb1\xde\x9d\x39\x7e\xe7\x4e\xb2\xe8\x84\xee\xf7\xf2\xec\x4f\x1f\xfe\x1c\x6c\x06\xa5\xd6\xf3\x6c\xa0\xc9\x6a\x0b\xa7\x94\xe4\x85\xbc\xfd\x40\xfa\xd4\xc7\x7a\xde\x9b\x1e\x96\xe8\x0e\x66\x44\xda\xf5\x15\xcb\xf6\xb8\x0d\x05\x51\xe9\x73\xa6\xa7\xe6\x4a\x8f\xe4\x48\x88\x9a\x65\xd9\x5c\xc7\x1c\x45\xa3\xe9\xe2\xff\x87\x09\xab\xaf\x08\x03\x33\x98\x94\xfd\x98\x94\xb3\x06\x10\x18\xff\xae\xfb\x7c\x1c\xdc\x52\xe9\xa6\xb4\xff\xbc\x6f\x96\xf6\xbe\x01\x39\x26\x9f\x62\xe3\x83\x0f\x3f\xce\xff\xba\xa8\xd8\x21\x6c\x6d\xf6\x27\x47\x82\xe3\x62\x9e\x61\x1d\xa8\x66\xb7\xbb\xa7\x56\x0f\x0f\xcf\x94\x44\xf1\x19\x13\x32\xf0\xe6\x51\x74\xe5\xbb\xf2\xee\x57\xa5\x38\xf5\x79\x24\x01\x8e\x4b\x8f\xe1\x1d\x7b\x07\x8d\x4e\xdc\x1d\x0c\x05\x85\xa1\x12\xf2\x95\x97\x31\x48\xa7\xd4\xac\x73\x71\x81\x40\xfe\x3d\xdd\xab\x57\x53\x17\xc3\x85\x26\xc1\xdb\xa6\xe4\xe7\x08\xc0\x57\x52\x89\xf9\x3d\xab\xc2\x8f\x9e\xdf\x00\x44\x38\x0a\x55\x8d\xc1\x6d\x28\x09\x7d\x06\x0a\x24\x01\xbd\x6c\xc7\x72\x5a\x38\x10\x02\x71\x35\xcc\xd2\xe0\x0b\xb7\xd2\x4b\x5a\x8d\xc1\x59\x9d\x4b\xc9\xc8\x33\x6c\x4c\x69\x97\xb5\x53\xb8\xa3\xf7\x21\xb3\xa5\x6d\x4e\x63\x93\x68\x20\x51\x9a\xc4\x33\x3f\xf2\x3c\x39\x9e\x90\x7f\x2f\xdc\xe9\x7d\x0a\xda\x65\x53\xff\x9e\x16\x7f\xa4\x22\xcd\x0b\x53\x27\x1f\x57\xd8\x9c\xa3\xd9\x3b\x4c\x1f\xa6\x2f\x36\x04\xa8\x62\xc3\x21\xf1\xa8\x51\x37\x30\x93\x36\x32\x06\xd8\xe2\x2b\x5c\xb8\x8f\x8b\x60\xc9\x28\x66\xdf\xa9\xea\xdd\x3b\x96\x45\x68\xd8\xa0\x75\xb0\x9f\xb4\x1b\xb1\x09\xb4\x1f\xbe\xa9\xa7\xbe\xd2\x25\x85\x9a\xb6\xa6\x20\x2f\xff\x9c\xa2\xbf\x82\x44\xf7\xdb\xd3\xbe\x1b\xde\xfd\x40\x35\xb2\x65\x6f\xad\x36\xd2\x9a\x3a\xe6\x98\xc6\x7a\xaa\x2f\xce\xfe\xfb\xc3\xf9\xc5\x59\xf4\xd3\xf7\xe7\xef\x7f\x88\x4e\x3f\x5c\x7e\xef\x94\xe0\x30\xd8\xfe\xee\xd3\xef\xfe\x17\x19\xe3\x59\x11\xc6\x99\x00\x00")
 
 func wski18nResourcesEn_usAllJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -112,7 +112,7 @@ func wski18nResourcesEn_usAllJson() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "wski18n/resources/en_US.all.json", size: 21925, mode: os.FileMode(420), modTime: time.Unix(1580408211, 0)}
+	info := bindataFileInfo{name: "wski18n/resources/en_US.all.json", size: 39366, mode: os.FileMode(420), modTime: time.Unix(1792365589, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
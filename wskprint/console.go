@@ -43,7 +43,7 @@ var (
 func PrintOpenWhiskError(message string) {
 	outputStream := colorable.NewColorableStderr()
 	fmt.Fprintf(outputStream, clrError.Sprintf(STR_PREFIXED_MESSAGE,
-		wski18n.T(wski18n.ID_MSG_PREFIX_ERROR), message))
+		wski18n.T(wski18n.ID_MSG_PREFIX_ERROR), Redact(message)))
 }
 
 func PrintOpenWhiskFromError(err error) {
@@ -54,7 +54,7 @@ func PrintOpenWhiskWarning(message string) {
 	if DetectVerbose() {
 		outputStream := colorable.NewColorableStdout()
 		fmt.Fprintf(outputStream, clrWarning.Sprintf(STR_PREFIXED_MESSAGE,
-			wski18n.T(wski18n.ID_MSG_PREFIX_WARNING), message))
+			wski18n.T(wski18n.ID_MSG_PREFIX_WARNING), Redact(message)))
 	}
 }
 
@@ -67,7 +67,7 @@ func PrintlnOpenWhiskWarning(message string) {
 func PrintOpenWhiskSuccess(message string) {
 	outputStream := colorable.NewColorableStdout()
 	fmt.Fprintf(outputStream, clrSuccess.Sprintf(STR_PREFIXED_MESSAGE,
-		wski18n.T(wski18n.ID_MSG_PREFIX_SUCCESS), message))
+		wski18n.T(wski18n.ID_MSG_PREFIX_SUCCESS), Redact(message)))
 }
 
 func PrintlnOpenWhiskSuccess(message string) {
@@ -77,7 +77,7 @@ func PrintlnOpenWhiskSuccess(message string) {
 func PrintOpenWhiskInfo(message string) {
 	outputStream := colorable.NewColorableStdout()
 	fmt.Fprintf(outputStream, clrInfo.Sprintf(STR_PREFIXED_MESSAGE,
-		wski18n.T(wski18n.ID_MSG_PREFIX_INFO), message))
+		wski18n.T(wski18n.ID_MSG_PREFIX_INFO), Redact(message)))
 }
 
 func PrintlnOpenWhiskInfo(message string) {
@@ -87,11 +87,11 @@ func PrintlnOpenWhiskInfo(message string) {
 func PrintlnOpenWhiskInfoTitle(message string) {
 	outputStream := colorable.NewColorableStdout()
 	fmt.Fprintf(outputStream, clrTitleInfo.Sprintf(STR_PREFIXED_MESSAGE,
-		wski18n.T(wski18n.ID_MSG_PREFIX_INFO), message))
+		wski18n.T(wski18n.ID_MSG_PREFIX_INFO), Redact(message)))
 }
 
 func PrintlnOpenWhiskOutput(message string) {
-	fmt.Println(message)
+	fmt.Println(Redact(message))
 }
 
 func PrintOpenWhiskVerboseTitle(verbose bool, message string) {
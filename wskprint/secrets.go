@@ -0,0 +1,62 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package wskprint
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// SECRET_MASK replaces the values of secrets in all output
+const SECRET_MASK = "********"
+
+// shorter values are masked only where they are marked as secret, not wherever they appear
+const SECRET_MIN_LENGTH = 4
+
+var (
+	secretsMutex sync.Mutex
+	secrets      []string
+)
+
+// RegisterSecret redacts the value from all the messages printed afterwards
+func RegisterSecret(value string) {
+	if len(value) < SECRET_MIN_LENGTH {
+		return
+	}
+	secretsMutex.Lock()
+	defer secretsMutex.Unlock()
+	for _, secret := range secrets {
+		if secret == value {
+			return
+		}
+	}
+	secrets = append(secrets, value)
+	// longest first, so that a secret including another is masked as a whole
+	sort.Slice(secrets, func(i, j int) bool { return len(secrets[i]) > len(secrets[j]) })
+}
+
+// Redact returns the message with the registered secrets replaced by SECRET_MASK
+func Redact(message string) string {
+	secretsMutex.Lock()
+	defer secretsMutex.Unlock()
+	for _, secret := range secrets {
+		message = strings.Replace(message, secret, SECRET_MASK, -1)
+	}
+	return message
+}